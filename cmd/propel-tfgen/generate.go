@@ -0,0 +1,309 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// generator writes the configuration of Propel objects, reading them with the provider's resources so that the
+// configuration matches what the provider would import.
+type generator struct {
+	provider *schema.Provider
+	objects  []object
+	// labels maps each object's ID to the name of its resource in the configuration.
+	labels map[string]string
+	// byID maps each object's ID to the object, for the attributes referencing it.
+	byID map[string]object
+	// variables are the variables declared for the sensitive attributes, in the order they were found.
+	variables []string
+}
+
+func newGenerator(p *schema.Provider, objects []object) *generator {
+	g := &generator{
+		provider: p,
+		objects:  objects,
+		labels:   make(map[string]string, len(objects)),
+		byID:     make(map[string]object, len(objects)),
+	}
+
+	taken := map[string]bool{}
+	for _, o := range objects {
+		label := resourceLabel(o.uniqueName)
+		for i := 2; taken[o.resourceType+"."+label]; i++ {
+			label = fmt.Sprintf("%s_%d", resourceLabel(o.uniqueName), i)
+		}
+
+		taken[o.resourceType+"."+label] = true
+		g.labels[o.id] = label
+		g.byID[o.id] = o
+	}
+
+	return g
+}
+
+// resourceLabel turns a unique name into a valid resource name, e.g. "My Data Pool" into "my_data_pool".
+func resourceLabel(uniqueName string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(uniqueName) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' || r == '-' {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune('_')
+		}
+	}
+
+	label := strings.Trim(b.String(), "_")
+	if label == "" {
+		return "object"
+	}
+
+	if label[0] < 'a' || label[0] > 'z' {
+		label = "_" + label
+	}
+
+	return label
+}
+
+func (g *generator) address(o object) hcl.Traversal {
+	return hcl.Traversal{
+		hcl.TraverseRoot{Name: o.resourceType},
+		hcl.TraverseAttr{Name: g.labels[o.id]},
+	}
+}
+
+// imports returns an import block for each object.
+func (g *generator) imports() []byte {
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+
+	for i, o := range g.objects {
+		if i > 0 {
+			body.AppendNewline()
+		}
+
+		block := body.AppendNewBlock("import", nil)
+		block.Body().SetAttributeTraversal("to", g.address(o))
+		block.Body().SetAttributeValue("id", cty.StringVal(o.id))
+	}
+
+	return f.Bytes()
+}
+
+// resources reads every object and returns their resources, along with the variables for their sensitive
+// attributes, or nil if there are none.
+func (g *generator) resources(ctx context.Context) ([]byte, []byte, error) {
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+
+	for i, o := range g.objects {
+		r, ok := g.provider.ResourcesMap[o.resourceType]
+		if !ok {
+			return nil, nil, fmt.Errorf("unknown resource type %s", o.resourceType)
+		}
+
+		d := r.Data(nil)
+		d.SetId(o.id)
+
+		// A block with a placeholder password makes the resource read the connection settings. The password is written
+		// as a variable like the other sensitive attributes.
+		if o.settings != "" {
+			if err := d.Set(o.settings, []any{map[string]any{"password": "placeholder"}}); err != nil {
+				return nil, nil, fmt.Errorf("failed to read %s \"%s\": %w", o.resourceType, o.id, err)
+			}
+		}
+
+		if diags := r.ReadContext(ctx, d, g.provider.Meta()); diags.HasError() {
+			return nil, nil, fmt.Errorf("failed to read %s \"%s\": %s", o.resourceType, o.id, diags[0].Summary)
+		}
+
+		if d.Id() == "" {
+			return nil, nil, fmt.Errorf("%s \"%s\" was deleted while generating the configuration", o.resourceType, o.id)
+		}
+
+		if i > 0 {
+			body.AppendNewline()
+		}
+
+		label := g.labels[o.id]
+		block := body.AppendNewBlock("resource", []string{o.resourceType, label})
+		g.writeBody(block.Body(), r.Schema, func(k string) any { return d.Get(k) }, label)
+	}
+
+	if len(g.variables) == 0 {
+		return f.Bytes(), nil, nil
+	}
+
+	vf := hclwrite.NewEmptyFile()
+	for i, name := range g.variables {
+		if i > 0 {
+			vf.Body().AppendNewline()
+		}
+
+		block := vf.Body().AppendNewBlock("variable", []string{name})
+		block.Body().SetAttributeTraversal("type", hcl.Traversal{hcl.TraverseRoot{Name: "string"}})
+		block.Body().SetAttributeValue("sensitive", cty.True)
+	}
+
+	return f.Bytes(), vf.Bytes(), nil
+}
+
+// writeBody writes the configurable attributes of a resource or a nested block, as returned by get. The optional
+// attributes left to their zero value are omitted. Sensitive attributes are replaced with a variable named after
+// their path, and the IDs of the other generated objects with a reference to them.
+func (g *generator) writeBody(body *hclwrite.Body, s map[string]*schema.Schema, get func(k string) any, path string) {
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+
+	// Blocks go after the attributes, and both are sorted by name.
+	sort.Slice(keys, func(i, j int) bool {
+		if isBlock(s[keys[i]]) != isBlock(s[keys[j]]) {
+			return !isBlock(s[keys[i]])
+		}
+
+		return keys[i] < keys[j]
+	})
+
+	for _, k := range keys {
+		sch := s[k]
+
+		// Computed attributes are not configurable, and the deprecated ones are only written when they must be.
+		if (!sch.Required && !sch.Optional) || (sch.Deprecated != "" && sch.Computed) {
+			continue
+		}
+
+		v := get(k)
+
+		if isBlock(sch) {
+			elem := sch.Elem.(*schema.Resource)
+
+			for _, item := range listItems(v) {
+				values, _ := item.(map[string]any)
+				nested := body.AppendNewBlock(k, nil)
+				g.writeBody(nested.Body(), elem.Schema, func(k string) any { return values[k] }, path+"_"+k)
+			}
+
+			continue
+		}
+
+		if !sch.Required && isZero(v) && (sch.Default == nil || isZero(sch.Default)) {
+			continue
+		}
+
+		if sch.Sensitive {
+			name := path + "_" + k
+			g.variables = append(g.variables, name)
+			body.SetAttributeTraversal(k, hcl.Traversal{hcl.TraverseRoot{Name: "var"}, hcl.TraverseAttr{Name: name}})
+
+			continue
+		}
+
+		if id, ok := v.(string); ok {
+			if reference := g.reference(id); reference != nil {
+				body.SetAttributeTraversal(k, reference)
+				continue
+			}
+		}
+
+		body.SetAttributeValue(k, ctyValue(sch, v))
+	}
+}
+
+// reference returns a reference to the ID of the generated object with the given ID, or nil if there is none.
+func (g *generator) reference(id string) hcl.Traversal {
+	o, ok := g.byID[id]
+	if !ok {
+		return nil
+	}
+
+	return append(g.address(o), hcl.TraverseAttr{Name: "id"})
+}
+
+func isBlock(sch *schema.Schema) bool {
+	_, ok := sch.Elem.(*schema.Resource)
+	return ok && (sch.Type == schema.TypeList || sch.Type == schema.TypeSet)
+}
+
+func listItems(v any) []any {
+	switch items := v.(type) {
+	case []any:
+		return items
+	case *schema.Set:
+		return items.List()
+	default:
+		return nil
+	}
+}
+
+func isZero(v any) bool {
+	switch value := v.(type) {
+	case nil:
+		return true
+	case string:
+		return value == ""
+	case int:
+		return value == 0
+	case float64:
+		return value == 0
+	case bool:
+		return !value
+	case map[string]any:
+		return len(value) == 0
+	default:
+		return len(listItems(v)) == 0
+	}
+}
+
+// ctyValue converts a value read from a resource to its configuration value.
+func ctyValue(sch *schema.Schema, v any) cty.Value {
+	switch sch.Type {
+	case schema.TypeString:
+		s, _ := v.(string)
+		return cty.StringVal(s)
+	case schema.TypeInt:
+		i, _ := v.(int)
+		return cty.NumberIntVal(int64(i))
+	case schema.TypeFloat:
+		f, _ := v.(float64)
+		return cty.NumberFloatVal(f)
+	case schema.TypeBool:
+		b, _ := v.(bool)
+		return cty.BoolVal(b)
+	case schema.TypeMap:
+		values := map[string]cty.Value{}
+		for k, value := range v.(map[string]any) {
+			values[k] = cty.StringVal(fmt.Sprint(value))
+		}
+
+		if len(values) == 0 {
+			return cty.MapValEmpty(cty.String)
+		}
+
+		return cty.MapVal(values)
+	default:
+		elem, _ := sch.Elem.(*schema.Schema)
+		if elem == nil {
+			elem = &schema.Schema{Type: schema.TypeString}
+		}
+
+		items := listItems(v)
+		if len(items) == 0 {
+			return cty.ListValEmpty(cty.String)
+		}
+
+		values := make([]cty.Value, 0, len(items))
+		for _, item := range items {
+			values = append(values, ctyValue(elem, item))
+		}
+
+		return cty.ListVal(values)
+	}
+}
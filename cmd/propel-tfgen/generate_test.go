@@ -0,0 +1,212 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_resourceLabel(t *testing.T) {
+	tests := []struct {
+		name       string
+		uniqueName string
+		expected   string
+	}{
+		{name: "Valid name", uniqueName: "orders", expected: "orders"},
+		{name: "Spaces and capitals", uniqueName: "My Data Pool", expected: "my_data_pool"},
+		{name: "Leading digit", uniqueName: "2024 orders", expected: "_2024_orders"},
+		{name: "No valid characters", uniqueName: "!!!", expected: "object"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(st *testing.T) {
+			a := assert.New(st)
+
+			a.Equal(tt.expected, resourceLabel(tt.uniqueName))
+		})
+	}
+}
+
+func testProvider() *schema.Provider {
+	values := map[string]map[string]any{
+		"DSO00000000000000000000000000": {
+			"unique_name": "warehouse",
+			"password":    "secret",
+		},
+		"DSO00000000000000000000000001": {
+			"unique_name": "replica",
+		},
+		"DPO00000000000000000000000000": {
+			"unique_name": "orders",
+			"data_source": "DSO00000000000000000000000000",
+			"column": []any{
+				map[string]any{"name": "id", "nullable": false},
+			},
+		},
+		"DPO00000000000000000000000001": {
+			"unique_name": "orders",
+			"data_source": "DSO00000000000000000000000000",
+			"description": "Copy of the orders",
+		},
+	}
+
+	read := func(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+		for k, v := range values[d.Id()] {
+			if err := d.Set(k, v); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if err := d.Set("status", "LIVE"); err != nil {
+			return diag.FromErr(err)
+		}
+
+		// Like the Data Source resources, the connection settings are only read when the block is already set.
+		if settings, ok := d.GetOk("postgresql_connection_settings.0"); ok {
+			password := settings.(map[string]any)["password"]
+			if err := d.Set("postgresql_connection_settings", []any{map[string]any{"host": "db.example.com", "password": password}}); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		return nil
+	}
+
+	return &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"propel_data_source": {
+				ReadContext: read,
+				Schema: map[string]*schema.Schema{
+					"unique_name": {Type: schema.TypeString, Optional: true},
+					"password":    {Type: schema.TypeString, Optional: true, Sensitive: true},
+					"status":      {Type: schema.TypeString, Computed: true},
+					"postgresql_connection_settings": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"host":     {Type: schema.TypeString, Required: true},
+								"password": {Type: schema.TypeString, Required: true, Sensitive: true},
+							},
+						},
+					},
+				},
+			},
+			"propel_data_pool": {
+				ReadContext: read,
+				Schema: map[string]*schema.Schema{
+					"unique_name": {Type: schema.TypeString, Optional: true},
+					"description": {Type: schema.TypeString, Optional: true},
+					"data_source": {Type: schema.TypeString, Required: true},
+					"status":      {Type: schema.TypeString, Computed: true},
+					"column": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"name":     {Type: schema.TypeString, Required: true},
+								"nullable": {Type: schema.TypeBool, Optional: true},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func Test_generator(t *testing.T) {
+	a := assert.New(t)
+
+	g := newGenerator(testProvider(), []object{
+		{resourceType: "propel_data_source", id: "DSO00000000000000000000000000", uniqueName: "warehouse"},
+		{resourceType: "propel_data_pool", id: "DPO00000000000000000000000000", uniqueName: "orders"},
+		{resourceType: "propel_data_pool", id: "DPO00000000000000000000000001", uniqueName: "Orders"},
+	})
+
+	a.Equal(`import {
+  to = propel_data_source.warehouse
+  id = "DSO00000000000000000000000000"
+}
+
+import {
+  to = propel_data_pool.orders
+  id = "DPO00000000000000000000000000"
+}
+
+import {
+  to = propel_data_pool.orders_2
+  id = "DPO00000000000000000000000001"
+}
+`, string(g.imports()))
+
+	resources, variables, err := g.resources(context.Background())
+	a.NoError(err)
+
+	a.Equal(`resource "propel_data_source" "warehouse" {
+  password    = var.warehouse_password
+  unique_name = "warehouse"
+}
+
+resource "propel_data_pool" "orders" {
+  data_source = propel_data_source.warehouse.id
+  unique_name = "orders"
+  column {
+    name = "id"
+  }
+}
+
+resource "propel_data_pool" "orders_2" {
+  data_source = propel_data_source.warehouse.id
+  description = "Copy of the orders"
+  unique_name = "orders"
+}
+`, string(resources))
+
+	a.Equal(`variable "warehouse_password" {
+  type      = string
+  sensitive = true
+}
+`, string(variables))
+}
+
+func Test_generator_connectionSettings(t *testing.T) {
+	a := assert.New(t)
+
+	g := newGenerator(testProvider(), []object{
+		{resourceType: "propel_data_source", id: "DSO00000000000000000000000001", uniqueName: "replica", settings: "postgresql_connection_settings"},
+	})
+
+	resources, variables, err := g.resources(context.Background())
+	a.NoError(err)
+
+	a.Equal(`resource "propel_data_source" "replica" {
+  unique_name = "replica"
+  postgresql_connection_settings {
+    host     = "db.example.com"
+    password = var.replica_postgresql_connection_settings_password
+  }
+}
+`, string(resources))
+
+	a.Equal(`variable "replica_postgresql_connection_settings_password" {
+  type      = string
+  sensitive = true
+}
+`, string(variables))
+}
+
+func Test_generator_unknownResource(t *testing.T) {
+	a := assert.New(t)
+
+	g := newGenerator(testProvider(), []object{
+		{resourceType: "propel_metric", id: "MET00000000000000000000000000", uniqueName: "revenue"},
+	})
+
+	_, _, err := g.resources(context.Background())
+	a.EqualError(err, "unknown resource type propel_metric")
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+// pageSize is the number of objects requested per page.
+const pageSize = 100
+
+// object is a Propel object to generate the configuration of.
+type object struct {
+	resourceType string
+	id           string
+	uniqueName   string
+	// settings is the connection settings block of a generic propel_data_source, which its resource only reads when
+	// it is already set.
+	settings string
+}
+
+// typedDataSourceResources maps the Data Source types to their dedicated resource. The other types are managed with
+// the generic propel_data_source resource.
+var typedDataSourceResources = map[string]string{
+	"SNOWFLAKE":  "propel_snowflake_data_source",
+	"S3":         "propel_s3_data_source",
+	"KAFKA":      "propel_kafka_data_source",
+	"WEBHOOK":    "propel_webhook_data_source",
+	"HTTP":       "propel_http_data_source",
+	"CLICKHOUSE": "propel_clickhouse_data_source",
+}
+
+// genericDataSourceSettings maps the Data Source types without a dedicated resource to their connection settings
+// block in the propel_data_source resource.
+var genericDataSourceSettings = map[string]string{
+	"POSTGRESQL": "postgresql_connection_settings",
+}
+
+// listObjects lists the Data Sources, Data Pools and Metrics of the Environment, in the order they depend on each
+// other. The Data Pools created by Webhook Data Sources are left out, since they are managed along with them.
+func listObjects(ctx context.Context, c graphql.Client) ([]object, error) {
+	objects := make([]object, 0)
+	managed := map[string]bool{}
+
+	first := pageSize
+	var after *string
+
+	for {
+		response, err := pc.DataSources(ctx, c, &first, nil, after, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list Data Sources: %w", err)
+		}
+
+		for _, edge := range response.DataSources.Edges {
+			ds := edge.Node
+			dataSourceType := strings.ToUpper(string(ds.GetType()))
+
+			// Internal Data Sources are created by Propel, e.g. for Materialized Views.
+			if dataSourceType == string(pc.DataSourceTypeInternal) {
+				continue
+			}
+
+			resourceType, ok := typedDataSourceResources[dataSourceType]
+			if !ok {
+				resourceType = "propel_data_source"
+			}
+
+			settings := genericDataSourceSettings[dataSourceType]
+
+			if dataSourceType == string(pc.DataSourceTypeWebhook) && ds.GetDataPools() != nil {
+				for _, node := range ds.GetDataPools().Nodes {
+					managed[node.Id] = true
+				}
+			}
+
+			objects = append(objects, object{resourceType: resourceType, id: ds.GetId(), uniqueName: ds.GetUniqueName(), settings: settings})
+		}
+
+		pageInfo := response.DataSources.PageInfo
+		if pageInfo == nil || !pageInfo.GetHasNextPage() || pageInfo.GetEndCursor() == nil {
+			break
+		}
+
+		after = pageInfo.GetEndCursor()
+	}
+
+	after = nil
+
+	for {
+		response, err := pc.DataPools(ctx, c, &first, nil, after, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list Data Pools: %w", err)
+		}
+
+		for _, edge := range response.DataPools.Edges {
+			if managed[edge.Node.GetId()] {
+				continue
+			}
+
+			objects = append(objects, object{resourceType: "propel_data_pool", id: edge.Node.GetId(), uniqueName: edge.Node.GetUniqueName()})
+		}
+
+		pageInfo := response.DataPools.PageInfo
+		if pageInfo == nil || !pageInfo.GetHasNextPage() || pageInfo.GetEndCursor() == nil {
+			break
+		}
+
+		after = pageInfo.GetEndCursor()
+	}
+
+	after = nil
+
+	for {
+		response, err := pc.Metrics(ctx, c, &first, nil, after, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list Metrics: %w", err)
+		}
+
+		for _, node := range response.Metrics.Nodes {
+			objects = append(objects, object{resourceType: "propel_metric", id: node.GetId(), uniqueName: node.GetUniqueName()})
+		}
+
+		pageInfo := response.Metrics.PageInfo
+		if pageInfo == nil || !pageInfo.GetHasNextPage() || pageInfo.GetEndCursor() == nil {
+			break
+		}
+
+		after = pageInfo.GetEndCursor()
+	}
+
+	return objects, nil
+}
@@ -0,0 +1,82 @@
+// Command propel-tfgen writes the Terraform configuration of an existing Propel Environment: an `import` block and
+// a resource for each of its Data Sources, Data Pools and Metrics.
+//
+// It authenticates like the provider, with the PROPEL_CLIENT_ID and PROPEL_CLIENT_SECRET environment variables.
+// Running it again against a directory it previously generated, and comparing the output, shows the objects that
+// were added or changed outside of Terraform since then.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/propeldata/terraform-provider-propel/propel"
+)
+
+func main() {
+	var (
+		out         string
+		importsOnly bool
+	)
+
+	flag.StringVar(&out, "out", ".", "the directory to write the configuration to")
+	flag.BoolVar(&importsOnly, "imports-only", false, "only write the import blocks, to generate the resources with `terraform plan -generate-config-out` instead")
+	flag.Parse()
+
+	if err := run(context.Background(), out, importsOnly); err != nil {
+		fmt.Fprintln(os.Stderr, "propel-tfgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, out string, importsOnly bool) error {
+	p := propel.Provider()
+
+	if diags := p.Configure(ctx, terraform.NewResourceConfigRaw(nil)); diags.HasError() {
+		return fmt.Errorf("failed to configure the provider: %s", diags[0].Summary)
+	}
+
+	objects, err := listObjects(ctx, p.Meta().(graphql.Client))
+	if err != nil {
+		return err
+	}
+
+	g := newGenerator(p, objects)
+
+	files := map[string][]byte{
+		"imports.tf": g.imports(),
+	}
+
+	if !importsOnly {
+		resources, variables, err := g.resources(ctx)
+		if err != nil {
+			return err
+		}
+
+		files["resources.tf"] = resources
+
+		if variables != nil {
+			files["variables.tf"] = variables
+		}
+	}
+
+	if err := os.MkdirAll(out, 0o755); err != nil {
+		return err
+	}
+
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(out, name), content, 0o644); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("Wrote %d objects to %s\n", len(objects), out)
+
+	return nil
+}
@@ -0,0 +1,3 @@
+data "propel_application" "my_application" {
+  unique_name = "My Application"
+}
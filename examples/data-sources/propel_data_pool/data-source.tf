@@ -0,0 +1,9 @@
+data "propel_data_pool" "my_data_pool" {
+  unique_name = "My Data Pool"
+}
+
+resource "propel_metric" "my_metric" {
+  unique_name = "My Metric"
+  data_pool   = data.propel_data_pool.my_data_pool.id
+  type        = "COUNT"
+}
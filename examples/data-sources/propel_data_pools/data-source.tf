@@ -0,0 +1,12 @@
+data "propel_data_pools" "production" {
+  unique_name_prefix = "prod_"
+  status             = "LIVE"
+}
+
+resource "propel_data_pool_access_policy" "read_only" {
+  for_each = { for dp in data.propel_data_pools.production.data_pools : dp.unique_name => dp.id }
+
+  unique_name = "${each.key} read only"
+  data_pool   = each.value
+  columns     = ["*"]
+}
@@ -0,0 +1,7 @@
+data "propel_data_source" "my_data_source" {
+  unique_name = "My Data Source"
+}
+
+data "propel_data_source" "my_other_data_source" {
+  id = "DSO00000000000000000000000000"
+}
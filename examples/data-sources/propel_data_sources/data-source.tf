@@ -0,0 +1,8 @@
+data "propel_data_sources" "broken_webhooks" {
+  type   = "WEBHOOK"
+  status = "BROKEN"
+}
+
+output "broken_webhook_data_sources" {
+  value = data.propel_data_sources.broken_webhooks.data_sources[*].unique_name
+}
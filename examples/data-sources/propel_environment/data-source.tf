@@ -0,0 +1,3 @@
+data "propel_environment" "production" {
+    id = "ENV00000000000000000000000000"
+}
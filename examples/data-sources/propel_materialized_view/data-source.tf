@@ -0,0 +1,7 @@
+data "propel_materialized_view" "my_materialized_view" {
+  unique_name = "My Materialized View"
+}
+
+data "propel_data_pool" "my_materialized_view_destination" {
+  id = data.propel_materialized_view.my_materialized_view.destination
+}
@@ -0,0 +1,3 @@
+data "propel_metric" "my_metric" {
+  unique_name = "My Metric"
+}
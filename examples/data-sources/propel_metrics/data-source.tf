@@ -0,0 +1,8 @@
+data "propel_metrics" "revenue" {
+  unique_name_regex = "^revenue_(daily|monthly)$"
+  type              = "SUM"
+}
+
+output "revenue_metric_ids" {
+  value = data.propel_metrics.revenue.ids
+}
@@ -1 +1,5 @@
+# Import by ID
 terraform import propel_application.my_application APP00000000000000000000000000
+
+# Import by unique name
+terraform import propel_application.my_application name:my_application
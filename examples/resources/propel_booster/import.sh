@@ -0,0 +1 @@
+terraform import propel_booster.my_booster BST00000000000000000000000000
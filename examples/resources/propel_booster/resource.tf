@@ -0,0 +1,4 @@
+resource "propel_booster" "my_booster" {
+    metric     = propel_metric.my_metric.id
+    dimensions = ["account_id", "country"]
+}
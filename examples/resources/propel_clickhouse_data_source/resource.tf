@@ -0,0 +1,14 @@
+variable "clickhouse_password" {
+  type = string
+  sensitive = true
+}
+
+resource "propel_clickhouse_data_source" "my_data_source" {
+  unique_name = "My ClickHouse Data Source"
+  description = "This is an example of a ClickHouse Data Source"
+
+  url      = "http://127.0.0.1:8123"
+  user     = "user"
+  password = var.clickhouse_password
+  database = "sample"
+}
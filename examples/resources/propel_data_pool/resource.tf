@@ -21,5 +21,6 @@ resource "propel_data_pool" "my_data_pool" {
 
   syncing {
     interval = "EVERY_1_HOUR"
+    enabled  = true
   }
 }
\ No newline at end of file
@@ -15,9 +15,29 @@ resource "propel_data_pool_access_policy" "my_data_pool_access_policy" {
     column   = "country"
     operator = "EQUALS"
     value    = "bar"
-    or = jsonencode([{"column":"country","operator":"EQUALS","value":"baz"}])
+
+    or_filter {
+      column   = "country"
+      operator = "EQUALS"
+      value    = "baz"
+    }
   }
 
   applications = ["APP00000000000000000000000000"]
 
-}
\ No newline at end of file
+}
+
+resource "propel_data_pool_access_policy" "my_sql_data_pool_access_policy" {
+  unique_name = "My SQL Data Pool Access Policy"
+  description = "This is an example of a Data Pool Access Policy with a SQL filter"
+  data_pool   = propel_data_source.my_data_pool.id
+
+  columns = ["*"]
+
+  filter_sql = <<-SQL
+    product_name IN ('foo', 'bar')
+    AND (country = 'bar' OR country = 'baz')
+  SQL
+
+  applications = ["APP00000000000000000000000000"]
+}
@@ -0,0 +1,4 @@
+resource "propel_data_pool_deletion_job" "gdpr_request_1234" {
+  data_pool  = propel_data_pool.my_data_pool.id
+  filter_sql = "customer_id = 'a1b2c3'"
+}
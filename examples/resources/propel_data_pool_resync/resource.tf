@@ -0,0 +1,8 @@
+resource "propel_data_pool_resync" "my_data_pool_resync" {
+  data_pool = propel_data_pool.my_data_pool.id
+
+  # Changing any of these values re-syncs the Data Pool.
+  triggers = {
+    schema_version = "2024-01-01"
+  }
+}
@@ -0,0 +1,9 @@
+resource "propel_data_pool_update_records_job" "fix_currency" {
+  data_pool  = propel_data_pool.my_data_pool.id
+  filter_sql = "currency = 'usd'"
+
+  set {
+    column     = "currency"
+    expression = "'USD'"
+  }
+}
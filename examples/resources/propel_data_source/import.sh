@@ -1 +1,5 @@
+# Import by ID
 terraform import propel_data_source.my_data_source DSO00000000000000000000000000
+
+# Import by unique name
+terraform import propel_data_source.my_data_source name:my_data_source
@@ -97,3 +97,22 @@ resource "propel_data_source" "my_clickhouse_data_source" {
     database = "sample"
   }
 }
+
+variable "postgresql_password" {
+  type = string
+  sensitive = true
+}
+
+resource "propel_data_source" "my_postgresql_data_source" {
+  unique_name = "My PostgreSQL Data Source"
+  description = "This is an example of a PostgreSQL Data Source"
+  type        = "POSTGRESQL"
+  postgresql_connection_settings {
+    host = "postgresql.example.com"
+    port = 5432
+    database = "sample"
+    schema = "public"
+    user = "user"
+    password = var.postgresql_password
+  }
+}
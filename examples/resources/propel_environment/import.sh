@@ -0,0 +1 @@
+terraform import propel_environment.staging ENV00000000000000000000000000
@@ -0,0 +1,4 @@
+resource "propel_environment" "staging" {
+    unique_name = "staging"
+    description = "The staging Environment"
+}
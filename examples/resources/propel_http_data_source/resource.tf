@@ -0,0 +1,30 @@
+variable "http_basic_auth_password" {
+  type = string
+  sensitive = true
+}
+
+resource "propel_http_data_source" "my_data_source" {
+  unique_name = "My HTTP Data Source"
+  description = "This is an example of an HTTP Data Source"
+
+  basic_auth {
+    username = "foo"
+    password = var.http_basic_auth_password
+  }
+
+  table {
+    name = "events"
+
+    column {
+      name     = "timestamp"
+      type     = "TIMESTAMP"
+      nullable = false
+    }
+
+    column {
+      name     = "account_id"
+      type     = "STRING"
+      nullable = false
+    }
+  }
+}
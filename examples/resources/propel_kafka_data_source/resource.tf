@@ -0,0 +1,15 @@
+variable "kafka_password" {
+  type = string
+  sensitive = true
+}
+
+resource "propel_kafka_data_source" "my_data_source" {
+  unique_name = "My Kafka Data Source"
+  description = "This is an example of a Kafka Data Source"
+
+  auth              = "SCRAM-SHA-256"
+  user              = "user"
+  password          = var.kafka_password
+  tls               = true
+  bootstrap_servers = ["localhost:9092"]
+}
@@ -1 +1,5 @@
+# Import by ID
 terraform import propel_materialized_view.my_materialized_view MAT00000000000000000000000000
+
+# Import by unique name
+terraform import propel_materialized_view.my_materialized_view name:my_materialized_view
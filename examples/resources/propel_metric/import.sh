@@ -1 +1,5 @@
+# Import by ID
 terraform import propel_metric.my_metric MET00000000000000000000000000
+
+# Import by unique name
+terraform import propel_metric.my_metric name:my_metric
@@ -16,7 +16,12 @@ resource "propel_metric" "my_sum_metric" {
     column   = "country"
     operator = "EQUALS"
     value    = "bar"
-    or = jsonencode([{"column":"country","operator":"EQUALS","value":"baz"}])
+
+    or_filter {
+      column   = "country"
+      operator = "EQUALS"
+      value    = "baz"
+    }
   }
 
   dimensions = ["store"]
@@ -38,6 +43,18 @@ resource "propel_metric" "my_count_metric" {
   dimensions = ["store"]
 }
 
+resource "propel_metric" "my_sql_filtered_count_metric" {
+  unique_name = "my_sql_filtered_count_metric"
+  description = "This is an example of a Count Metric with a SQL filter"
+  data_pool   = propel_data_pool.my_data_pool.id
+
+  type = "COUNT"
+
+  filter_sql = "product_name IN ('foo', 'bar') AND (country = 'bar' OR country = 'baz')"
+
+  dimensions = ["store"]
+}
+
 resource "propel_metric" "my_count_distinct_metric" {
   unique_name = "my_count_distinct_metric"
   description = "This is an example of a Count Distinct Metric"
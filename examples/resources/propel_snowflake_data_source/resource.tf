@@ -0,0 +1,23 @@
+variable "snowflake_password" {
+  type = string
+  sensitive = true
+}
+
+resource "propel_snowflake_data_source" "my_data_source" {
+  unique_name = "My Snowflake Data Source"
+  description = "This is an example of a Snowflake Data Source"
+
+  snowflake_account = "Snowflake Account"
+  database          = "Snowflake Database"
+  warehouse         = "Snowflake Warehouse"
+  schema            = "Snowflake Schema"
+  role              = "Snowflake Role"
+  username          = "Snowflake Username"
+  password          = var.snowflake_password
+}
+
+# Moves an existing `propel_data_source` of type SNOWFLAKE to this resource without re-creating it.
+moved {
+  from = propel_data_source.my_data_source
+  to   = propel_snowflake_data_source.my_data_source
+}
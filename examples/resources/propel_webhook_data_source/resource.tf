@@ -0,0 +1,39 @@
+variable "http_basic_auth_password" {
+  type = string
+  sensitive = true
+}
+
+resource "propel_webhook_data_source" "my_data_source" {
+  unique_name = "My Webhook Data Source"
+  description = "This is an example of a Webhook Data Source"
+
+  timestamp = "event_timestamp"
+  unique_id = "event_id"
+  tenant    = "customer_id"
+
+  column {
+    name          = "event_id"
+    type          = "STRING"
+    nullable      = false
+    json_property = "event_id"
+  }
+
+  column {
+    name          = "customer_id"
+    type          = "STRING"
+    nullable      = false
+    json_property = "customer_id"
+  }
+
+  column {
+    name          = "event_timestamp"
+    type          = "TIMESTAMP"
+    nullable      = false
+    json_property = "event_timestamp"
+  }
+
+  basic_auth {
+    username = "foo"
+    password = var.http_basic_auth_password
+  }
+}
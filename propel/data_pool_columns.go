@@ -0,0 +1,367 @@
+package propel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/propeldata/terraform-provider-propel/propel/internal"
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+// dataPoolColumnChange is a change to an existing Data Pool column that the Propel API cannot apply in place.
+type dataPoolColumnChange struct {
+	name string
+	// attribute is the column's modified attribute. It is empty if the column was removed.
+	attribute string
+	// index is the column's position in the new configuration, or -1 if the column was removed.
+	index int
+}
+
+// key returns the attribute to flag as forcing a replacement, so the plan shows which change caused it.
+func (c dataPoolColumnChange) key() string {
+	if c.index < 0 {
+		return "column"
+	}
+
+	return fmt.Sprintf("column.%d.%s", c.index, c.attribute)
+}
+
+func (c dataPoolColumnChange) String() string {
+	if c.index < 0 {
+		return fmt.Sprintf(`column "%s" was removed`, c.name)
+	}
+
+	return fmt.Sprintf(`column "%s" changed its %s`, c.name, c.attribute)
+}
+
+// getReplacedDataPoolColumns returns the columns that were removed or whose type or nullability changed.
+func getReplacedDataPoolColumns(oldItemDef []any, newItemDef []any) []dataPoolColumnChange {
+	newColumns := make(map[string]int, len(newItemDef))
+	for i, rawColumn := range newItemDef {
+		newColumns[rawColumn.(map[string]any)["name"].(string)] = i
+	}
+
+	changes := make([]dataPoolColumnChange, 0)
+
+	for _, rawColumn := range oldItemDef {
+		column := rawColumn.(map[string]any)
+		name := column["name"].(string)
+
+		i, ok := newColumns[name]
+		if !ok {
+			changes = append(changes, dataPoolColumnChange{name: name, index: -1})
+			continue
+		}
+
+		newColumn := newItemDef[i].(map[string]any)
+		for _, attribute := range []string{"type", "nullable"} {
+			if column[attribute] != newColumn[attribute] {
+				changes = append(changes, dataPoolColumnChange{name: name, attribute: attribute, index: i})
+			}
+		}
+
+		// The ClickHouse type is computed for the other column types, so it only counts as a change when it is
+		// what defines the column's type.
+		if column["type"] == "CLICKHOUSE" && newColumn["type"] == "CLICKHOUSE" && newColumn["clickhouse_type"] != "" &&
+			column["clickhouse_type"] != newColumn["clickhouse_type"] {
+			changes = append(changes, dataPoolColumnChange{name: name, attribute: "clickhouse_type", index: i})
+		}
+	}
+
+	return changes
+}
+
+// clickHouseTypePattern matches a ClickHouse type name, optionally followed by its parameters, such as
+// `LowCardinality(String)` or `Decimal(18, 4)`.
+var clickHouseTypePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*(\(.*\))?$`)
+
+func isValidClickHouseType(clickHouseType string) bool {
+	if !clickHouseTypePattern.MatchString(clickHouseType) {
+		return false
+	}
+
+	depth := 0
+	for _, r := range clickHouseType {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return false
+			}
+		}
+	}
+
+	return depth == 0
+}
+
+// validateDataPoolColumns checks the columns of a Data Pool's configuration. Column names must be unique and the
+// ClickHouse types valid. When the columns are added to an existing Data Pool, oldItemDef holds its current
+// columns, and the columns that are added must be nullable.
+func validateDataPoolColumns(oldItemDef []any, newItemDef []any) error {
+	oldColumns := make(map[string]bool, len(oldItemDef))
+	for _, rawColumn := range oldItemDef {
+		oldColumns[rawColumn.(map[string]any)["name"].(string)] = true
+	}
+
+	names := make(map[string]bool, len(newItemDef))
+	errs := make([]error, 0)
+
+	for _, rawColumn := range newItemDef {
+		column := rawColumn.(map[string]any)
+		name := column["name"].(string)
+		clickHouseType, _ := column["clickhouse_type"].(string)
+
+		// The name is not known yet, e.g. when it is derived from another resource.
+		if name == "" {
+			continue
+		}
+
+		if names[name] {
+			errs = append(errs, fmt.Errorf(`column "%s" already exists`, name))
+		}
+		names[name] = true
+
+		if column["type"] == "CLICKHOUSE" && clickHouseType == "" {
+			errs = append(errs, fmt.Errorf(`column "%s" is of type CLICKHOUSE and must set clickhouse_type`, name))
+		}
+
+		if clickHouseType != "" && !isValidClickHouseType(clickHouseType) {
+			errs = append(errs, fmt.Errorf(`column "%s" has an invalid clickhouse_type "%s"`, name, clickHouseType))
+		}
+
+		if oldItemDef != nil && !oldColumns[name] && !column["nullable"].(bool) {
+			errs = append(errs, fmt.Errorf(`new column "%s" must be nullable`, name))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// customizeDiffDataPoolColumns validates the Data Pool's columns, and replaces the Data Pool when a column is
+// removed or its type or nullability changes, unless allow_column_recreate is set, in which case the update
+// recreates it. Recreation is refused while Metrics, Data Pool Access Policies or Materialized Views use the Data
+// Pool, since they would be left pointing at the deleted one.
+func customizeDiffDataPoolColumns(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.HasChange("column") {
+		return nil
+	}
+
+	oldItem, newItem := d.GetChange("column")
+
+	if d.Id() == "" {
+		return validateDataPoolColumns(nil, newItem.([]any))
+	}
+
+	changes := getReplacedDataPoolColumns(oldItem.([]any), newItem.([]any))
+	if len(changes) == 0 {
+		return validateDataPoolColumns(oldItem.([]any), newItem.([]any))
+	}
+
+	// The columns are created along with a new Data Pool, so they do not need to be nullable.
+	if err := validateDataPoolColumns(nil, newItem.([]any)); err != nil {
+		return err
+	}
+
+	if d.Get("allow_column_recreate").(bool) {
+		if d.Get("data_source").(string) != "" {
+			return errors.New("allow_column_recreate is not supported for Data Pools with a Data Source, remove it to replace the Data Pool instead")
+		}
+
+		return checkDataPoolRecreatable(ctx, meta.(graphql.Client), d.Id())
+	}
+
+	for _, change := range changes {
+		// A removed column shifts the index of the ones after it, so the change may not line up with its key.
+		key := change.key()
+		if !d.HasChange(key) {
+			key = "column"
+		}
+
+		if err := d.ForceNew(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dataPoolBackfillSQL returns the Materialized View SQL that copies the columns kept in the new configuration
+// from the previous Data Pool.
+func dataPoolBackfillSQL(dataPoolName string, oldItemDef []any, newItemDef []any) string {
+	oldColumns := make(map[string]bool, len(oldItemDef))
+	for _, rawColumn := range oldItemDef {
+		oldColumns[rawColumn.(map[string]any)["name"].(string)] = true
+	}
+
+	columns := make([]string, 0, len(newItemDef))
+	for _, rawColumn := range newItemDef {
+		name := rawColumn.(map[string]any)["name"].(string)
+
+		if oldColumns[name] {
+			columns = append(columns, fmt.Sprintf(`"%s"`, name))
+		}
+	}
+
+	return fmt.Sprintf(`SELECT %s FROM "%s"`, strings.Join(columns, ", "), dataPoolName)
+}
+
+// checkDataPoolRecreatable fails if Metrics, Data Pool Access Policies or Materialized Views use the Data Pool, since
+// recreating it would leave them pointing at a deleted Data Pool.
+func checkDataPoolRecreatable(ctx context.Context, c graphql.Client, id string) error {
+	dependents, err := internal.DataPoolDependents(ctx, c, id)
+	if err != nil {
+		return err
+	}
+
+	if len(dependents) > 0 {
+		return fmt.Errorf("the Data Pool cannot be recreated while it is used by %s, remove allow_column_recreate to replace the Data Pool and its dependents instead", strings.Join(dependents, ", "))
+	}
+
+	return nil
+}
+
+// maxDataPoolNameLength is the longest unique name the Propel API accepts for a Data Pool.
+const maxDataPoolNameLength = 64
+
+// temporaryDataPoolName returns the unique name of a Data Pool recreated from the one with the given name, until it
+// takes that name. The name is truncated to make room for a short suffix, so that it stays within the length limit.
+func temporaryDataPoolName(uniqueName string, now time.Time) string {
+	suffix := "_" + strconv.FormatInt(now.Unix(), 36)
+
+	if len(uniqueName)+len(suffix) > maxDataPoolNameLength {
+		uniqueName = uniqueName[:maxDataPoolNameLength-len(suffix)]
+	}
+
+	return uniqueName + suffix
+}
+
+// recreateDataPool creates a Data Pool with the new configuration, backfills it with the records of the current
+// one using a Materialized View, and deletes the current one. The resource's ID is set to the new Data Pool.
+// The current Data Pool is only deleted once nothing uses it and the new one holds all its records, otherwise the
+// new Data Pool is deleted instead.
+func recreateDataPool(ctx context.Context, d *schema.ResourceData, c graphql.Client) error {
+	previousId := d.Id()
+	previousName, uniqueName := d.GetChange("unique_name")
+	oldColumns, newColumns := d.GetChange("column")
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	// Dependents may have been created since the plan.
+	if err := checkDataPoolRecreatable(ctx, c, previousId); err != nil {
+		return err
+	}
+
+	input, err := expandCreateDataPoolInput(d)
+	if err != nil {
+		return err
+	}
+
+	// The unique name is still taken by the current Data Pool until it is deleted.
+	temporaryName := temporaryDataPoolName(uniqueName.(string), time.Now())
+	input.UniqueName = &temporaryName
+
+	response, err := pc.CreateDataPool(ctx, c, input)
+	if err != nil {
+		return err
+	}
+
+	id := response.CreateDataPoolV2.DataPool.Id
+
+	if err := internal.WaitForDataPoolLive(ctx, c, id, timeout); err != nil {
+		return err
+	}
+
+	sql := dataPoolBackfillSQL(previousName.(string), oldColumns.([]any), newColumns.([]any))
+	if err := backfillDataPool(ctx, c, id, previousId, sql, timeout); err != nil {
+		if _, deleteErr := pc.DeleteDataPool(ctx, c, id); deleteErr != nil {
+			return fmt.Errorf("%s, and the new Data Pool \"%s\" could not be deleted: %s", err, id, deleteErr)
+		}
+
+		return err
+	}
+
+	if _, err := pc.DeleteDataPool(ctx, c, previousId); err != nil {
+		return err
+	}
+
+	if err := internal.WaitForDataPoolDeletion(ctx, c, previousId, timeout); err != nil {
+		return err
+	}
+
+	d.SetId(id)
+
+	name := uniqueName.(string)
+	if _, err := pc.ModifyDataPool(ctx, c, &pc.ModifyDataPoolInput{
+		IdOrUniqueName: &pc.IdOrUniqueName{Id: &id},
+		UniqueName:     &name,
+	}); err != nil {
+		return err
+	}
+
+	if _, exists := d.GetOk("syncing"); exists && !d.Get("syncing.0.enabled").(bool) {
+		return internal.SetDataPoolSyncing(ctx, c, id, false)
+	}
+
+	return nil
+}
+
+// backfillDataPool copies the records of the previous Data Pool into the new one with a Materialized View, which is
+// deleted once both hold the same number of records. It then checks that the previous Data Pool can be deleted:
+// nothing started using it, and no records were ingested into it after the Materialized View was deleted.
+func backfillDataPool(ctx context.Context, c graphql.Client, id string, previousId string, sql string, timeout time.Duration) error {
+	backfill := true
+
+	response, err := pc.CreateMaterializedView(ctx, c, &pc.CreateMaterializedViewInput{
+		Sql: sql,
+		Destination: &pc.CreateMaterializedViewDestinationInput{
+			ExistingDataPool: &pc.DataPoolInput{Id: &id},
+		},
+		BackfillOptions: &pc.BackfillOptionsInput{
+			Backfill: &backfill,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error creating the Materialized View to backfill the Data Pool: %s", err)
+	}
+
+	mvId := response.CreateMaterializedView.MaterializedView.Id
+
+	waitErr := internal.WaitForDataPoolBackfill(ctx, c, id, previousId, mvId, timeout)
+
+	if _, err := pc.DeleteMaterializedView(ctx, c, mvId); err != nil && waitErr == nil {
+		return err
+	}
+
+	if waitErr != nil {
+		return waitErr
+	}
+
+	if err := checkDataPoolRecreatable(ctx, c, previousId); err != nil {
+		return err
+	}
+
+	records, err := internal.DataPoolRecordCount(ctx, c, previousId)
+	if err != nil {
+		return err
+	}
+
+	count, err := internal.DataPoolRecordCount(ctx, c, id)
+	if err != nil {
+		return err
+	}
+
+	if count < records {
+		return fmt.Errorf("the previous Data Pool holds %d records but only %d were backfilled, records were ingested into it during the backfill", records, count)
+	}
+
+	return nil
+}
@@ -0,0 +1,222 @@
+package propel
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_getReplacedDataPoolColumns(t *testing.T) {
+	tests := []struct {
+		name       string
+		oldItemDef []any
+		newItemDef []any
+		expected   []string
+		keys       []string
+	}{
+		{
+			name: "New columns only",
+			oldItemDef: []any{
+				map[string]any{"name": "COLUMN_A", "type": "STRING", "nullable": false, "clickhouse_type": ""},
+			},
+			newItemDef: []any{
+				map[string]any{"name": "COLUMN_A", "type": "STRING", "nullable": false, "clickhouse_type": ""},
+				map[string]any{"name": "COLUMN_B", "type": "INT64", "nullable": true, "clickhouse_type": ""},
+			},
+			expected: []string{},
+			keys:     []string{},
+		},
+		{
+			name: "Modified column type and nullability",
+			oldItemDef: []any{
+				map[string]any{"name": "COLUMN_A", "type": "STRING", "nullable": false, "clickhouse_type": ""},
+				map[string]any{"name": "COLUMN_B", "type": "INT64", "nullable": false, "clickhouse_type": ""},
+			},
+			newItemDef: []any{
+				map[string]any{"name": "COLUMN_A", "type": "STRING", "nullable": false, "clickhouse_type": ""},
+				map[string]any{"name": "COLUMN_B", "type": "FLOAT", "nullable": true, "clickhouse_type": ""},
+			},
+			expected: []string{`column "COLUMN_B" changed its type`, `column "COLUMN_B" changed its nullable`},
+			keys:     []string{"column.1.type", "column.1.nullable"},
+		},
+		{
+			name: "Removed column",
+			oldItemDef: []any{
+				map[string]any{"name": "COLUMN_A", "type": "STRING", "nullable": false, "clickhouse_type": ""},
+				map[string]any{"name": "COLUMN_B", "type": "INT64", "nullable": false, "clickhouse_type": ""},
+			},
+			newItemDef: []any{
+				map[string]any{"name": "COLUMN_B", "type": "INT64", "nullable": false, "clickhouse_type": ""},
+			},
+			expected: []string{`column "COLUMN_A" was removed`},
+			keys:     []string{"column"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(st *testing.T) {
+			a := assert.New(st)
+
+			changes := getReplacedDataPoolColumns(tt.oldItemDef, tt.newItemDef)
+
+			reasons := make([]string, 0, len(changes))
+			keys := make([]string, 0, len(changes))
+			for _, change := range changes {
+				reasons = append(reasons, change.String())
+				keys = append(keys, change.key())
+			}
+
+			a.Equal(tt.expected, reasons)
+			a.Equal(tt.keys, keys)
+		})
+	}
+}
+
+func Test_dataPoolBackfillSQL(t *testing.T) {
+	a := assert.New(t)
+
+	oldItemDef := []any{
+		map[string]any{"name": "timestamp_tz", "type": "TIMESTAMP", "nullable": false},
+		map[string]any{"name": "account_id", "type": "STRING", "nullable": false},
+		map[string]any{"name": "value", "type": "INT32", "nullable": false},
+	}
+	newItemDef := []any{
+		map[string]any{"name": "timestamp_tz", "type": "TIMESTAMP", "nullable": false},
+		map[string]any{"name": "value", "type": "INT64", "nullable": false},
+		map[string]any{"name": "product_id", "type": "STRING", "nullable": true},
+	}
+
+	a.Equal(`SELECT "timestamp_tz", "value" FROM "my_data_pool"`, dataPoolBackfillSQL("my_data_pool", oldItemDef, newItemDef))
+}
+
+func Test_validateDataPoolColumns(t *testing.T) {
+	tests := []struct {
+		name          string
+		oldItemDef    []any
+		newItemDef    []any
+		expectedError string
+	}{
+		{
+			name: "Valid new Data Pool",
+			newItemDef: []any{
+				map[string]any{"name": "COLUMN_A", "type": "STRING", "nullable": false, "clickhouse_type": ""},
+				map[string]any{"name": "COLUMN_B", "type": "CLICKHOUSE", "nullable": false, "clickhouse_type": "LowCardinality(String)"},
+			},
+		},
+		{
+			name: "Repeated column names",
+			newItemDef: []any{
+				map[string]any{"name": "COLUMN_A", "type": "STRING", "nullable": false, "clickhouse_type": ""},
+				map[string]any{"name": "COLUMN_A", "type": "INT64", "nullable": false, "clickhouse_type": ""},
+			},
+			expectedError: `column "COLUMN_A" already exists`,
+		},
+		{
+			name: "Missing ClickHouse type",
+			newItemDef: []any{
+				map[string]any{"name": "COLUMN_A", "type": "CLICKHOUSE", "nullable": false, "clickhouse_type": ""},
+			},
+			expectedError: `column "COLUMN_A" is of type CLICKHOUSE and must set clickhouse_type`,
+		},
+		{
+			name: "Invalid ClickHouse type",
+			newItemDef: []any{
+				map[string]any{"name": "COLUMN_A", "type": "CLICKHOUSE", "nullable": false, "clickhouse_type": "Nullable(String"},
+			},
+			expectedError: `column "COLUMN_A" has an invalid clickhouse_type "Nullable(String"`,
+		},
+		{
+			name: "Nullable column added",
+			oldItemDef: []any{
+				map[string]any{"name": "COLUMN_A", "type": "STRING", "nullable": false, "clickhouse_type": "String"},
+			},
+			newItemDef: []any{
+				map[string]any{"name": "COLUMN_A", "type": "STRING", "nullable": false, "clickhouse_type": "String"},
+				map[string]any{"name": "COLUMN_B", "type": "INT64", "nullable": true, "clickhouse_type": ""},
+			},
+		},
+		{
+			name: "Non-nullable column added",
+			oldItemDef: []any{
+				map[string]any{"name": "COLUMN_A", "type": "STRING", "nullable": false, "clickhouse_type": "String"},
+			},
+			newItemDef: []any{
+				map[string]any{"name": "COLUMN_A", "type": "STRING", "nullable": false, "clickhouse_type": "String"},
+				map[string]any{"name": "COLUMN_B", "type": "INT64", "nullable": false, "clickhouse_type": ""},
+			},
+			expectedError: `new column "COLUMN_B" must be nullable`,
+		},
+		{
+			name: "Several errors",
+			oldItemDef: []any{
+				map[string]any{"name": "COLUMN_A", "type": "STRING", "nullable": false, "clickhouse_type": "String"},
+			},
+			newItemDef: []any{
+				map[string]any{"name": "COLUMN_A", "type": "STRING", "nullable": false, "clickhouse_type": "String"},
+				map[string]any{"name": "COLUMN_B", "type": "INT64", "nullable": false, "clickhouse_type": ""},
+				map[string]any{"name": "COLUMN_C", "type": "CLICKHOUSE", "nullable": true, "clickhouse_type": ""},
+			},
+			expectedError: "new column \"COLUMN_B\" must be nullable\ncolumn \"COLUMN_C\" is of type CLICKHOUSE and must set clickhouse_type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(st *testing.T) {
+			a := assert.New(st)
+
+			err := validateDataPoolColumns(tt.oldItemDef, tt.newItemDef)
+			if tt.expectedError != "" {
+				a.EqualError(err, tt.expectedError)
+				return
+			}
+
+			a.NoError(err)
+		})
+	}
+}
+
+func Test_isValidClickHouseType(t *testing.T) {
+	a := assert.New(t)
+
+	for _, valid := range []string{"String", "Nullable(Int64)", "Decimal(18, 4)", "Map(String, Array(Nullable(String)))", "DateTime64(3, 'UTC')"} {
+		a.True(isValidClickHouseType(valid), valid)
+	}
+
+	for _, invalid := range []string{"", "(String)", "Nullable(String", "String)", "Nullable(String))(", "1Int"} {
+		a.False(isValidClickHouseType(invalid), invalid)
+	}
+}
+
+func Test_temporaryDataPoolName(t *testing.T) {
+	now := time.Unix(1760745600, 0)
+	long := strings.Repeat("a", maxDataPoolNameLength)
+
+	tests := []struct {
+		name       string
+		uniqueName string
+		expected   string
+	}{
+		{
+			name:       "Short name",
+			uniqueName: "orders",
+			expected:   "orders_t4aw00",
+		},
+		{
+			name:       "Name at the length limit",
+			uniqueName: long,
+			expected:   long[:maxDataPoolNameLength-7] + "_t4aw00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(st *testing.T) {
+			a := assert.New(st)
+
+			got := temporaryDataPoolName(tt.uniqueName, now)
+			a.Equal(tt.expected, got)
+			a.LessOrEqual(len(got), maxDataPoolNameLength)
+		})
+	}
+}
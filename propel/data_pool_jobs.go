@@ -0,0 +1,83 @@
+package propel
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+// jobSchema returns the computed attributes that track the progress and outcome of a Job, shared by the resources
+// that run Jobs on a Data Pool.
+func jobSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The Job's status.",
+		},
+		"progress": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "The Job's progress, from 0.0 to 1.0.",
+		},
+		"error": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The error that occurred while running the Job, if any.",
+		},
+		"started_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date and time in UTC when the Job started.",
+		},
+		"succeeded_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date and time in UTC when the Job succeeded.",
+		},
+		"failed_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date and time in UTC when the Job failed.",
+		},
+	}
+}
+
+// jobAttributes holds the attributes of jobSchema, whatever the kind of Job.
+type jobAttributes struct {
+	status      pc.JobStatus
+	progress    float64
+	error       string
+	startedAt   *time.Time
+	succeededAt *time.Time
+	failedAt    *time.Time
+}
+
+func (j jobAttributes) set(d *schema.ResourceData) error {
+	attributes := map[string]any{
+		"status":       j.status,
+		"progress":     j.progress,
+		"error":        j.error,
+		"started_at":   formatJobTime(j.startedAt),
+		"succeeded_at": formatJobTime(j.succeededAt),
+		"failed_at":    formatJobTime(j.failedAt),
+	}
+
+	for k, v := range attributes {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func formatJobTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
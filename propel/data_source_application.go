@@ -0,0 +1,48 @@
+package propel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+func dataSourceApplication() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceApplicationRead,
+		Description: "Provides a Propel Application data source. This can be used to look up an existing Propel Application by its ID or unique name.",
+		Schema:      lookupDataSourceSchema(resourceApplication().Schema, "Application"),
+	}
+}
+
+func dataSourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(graphql.Client)
+
+	id, err := lookupID(ctx, d, c, lookupApplicationID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+	// The object must exist, so it is not removed from the state if it is missing.
+	d.MarkNewResource()
+
+	return resourceApplicationRead(ctx, d, meta)
+}
+
+func lookupApplicationID(ctx context.Context, c graphql.Client, uniqueName string) (string, error) {
+	response, err := pc.ApplicationByName(ctx, c, uniqueName)
+	if err != nil {
+		return "", err
+	}
+
+	if response.Application == nil {
+		return "", fmt.Errorf("Application \"%s\" %w", uniqueName, pc.ErrNotFound)
+	}
+
+	return response.Application.Id, nil
+}
@@ -0,0 +1,53 @@
+package propel
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPropelApplicationDataSource(t *testing.T) {
+	t.Parallel()
+
+	ctx := map[string]any{
+		"unique_name": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckPropelApplicationDestroy,
+		Steps: []resource.TestStep{
+			// should look up the Application by ID and unique name
+			{
+				Config: testAccCheckPropelApplicationDataSourceConfig(ctx),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.propel_application.by_id", "id", "propel_application.test", "id"),
+					resource.TestCheckResourceAttrPair("data.propel_application.by_name", "id", "propel_application.test", "id"),
+					resource.TestCheckResourceAttr("data.propel_application.by_name", "propeller", "P1_SMALL"),
+					resource.TestCheckResourceAttrPair("data.propel_application.by_name", "client_id", "propel_application.test", "client_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPropelApplicationDataSourceConfig(ctx map[string]any) string {
+	// language=hcl-terraform
+	return Nprintf(`
+		resource "propel_application" "test" {
+			unique_name = "%{unique_name}"
+			scopes = ["METRIC_QUERY"]
+			propeller = "P1_SMALL"
+		}
+
+		data "propel_application" "by_id" {
+			id = propel_application.test.id
+		}
+
+		data "propel_application" "by_name" {
+			unique_name = propel_application.test.unique_name
+		}
+	`, ctx)
+}
@@ -0,0 +1,157 @@
+package propel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+// planCheckTimeout bounds how long a plan waits for the checks of a Data Source to complete.
+const planCheckTimeout = 5 * time.Minute
+
+// dataSourceChecksError reports a Data Source that failed to connect, along with the checks that failed.
+type dataSourceChecksError struct {
+	status  string
+	message string
+	failed  []*pc.DataSourceDataChecksDataSourceCheck
+}
+
+func (e *dataSourceChecksError) Error() string {
+	if len(e.failed) == 0 {
+		return fmt.Sprintf("Data Source is %s: %s", e.status, e.message)
+	}
+
+	failed := make([]string, 0, len(e.failed))
+	for _, check := range e.failed {
+		failed = append(failed, fmt.Sprintf("Data Source check \"%s\" failed: %s", check.Name, dataSourceCheckMessage(check)))
+	}
+
+	return strings.Join(failed, "\n")
+}
+
+// diagnostics returns one diagnostic per failed check.
+func (e *dataSourceChecksError) diagnostics() diag.Diagnostics {
+	if len(e.failed) == 0 {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Data Source is %s", e.status),
+			Detail:   e.message,
+		}}
+	}
+
+	diags := make(diag.Diagnostics, 0, len(e.failed))
+	for _, check := range e.failed {
+		detail := dataSourceCheckMessage(check)
+		if check.Description != nil && *check.Description != "" {
+			detail = fmt.Sprintf("%s\n\n%s", *check.Description, detail)
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Data Source check \"%s\" failed", check.Name),
+			Detail:   detail,
+		})
+	}
+
+	return diags
+}
+
+// failedDataSourceChecks returns a dataSourceChecksError if the Data Source is BROKEN or any of its checks
+// performed since the given time failed, or nil otherwise. Older checks are ignored, since they belong to a
+// previous connection attempt.
+func failedDataSourceChecks(ds *pc.DataSourceData, since time.Time) error {
+	var failed, recent []*pc.DataSourceDataChecksDataSourceCheck
+	for _, check := range ds.Checks {
+		if check.Status != pc.DataSourceCheckStatusFailed {
+			continue
+		}
+
+		failed = append(failed, check)
+
+		if check.CheckedAt == nil || !check.CheckedAt.Before(since) {
+			recent = append(recent, check)
+		}
+	}
+
+	if ds.Status != pc.DataSourceStatusBroken {
+		if len(recent) == 0 {
+			return nil
+		}
+
+		failed = recent
+	}
+
+	message := "unknown error"
+	if ds.Error != nil && ds.Error.Message != "" {
+		message = ds.Error.Message
+	}
+
+	return &dataSourceChecksError{
+		status:  string(ds.Status),
+		message: message,
+		failed:  failed,
+	}
+}
+
+func dataSourceCheckMessage(check *pc.DataSourceDataChecksDataSourceCheck) string {
+	if check.Error == nil || check.Error.Message == "" {
+		return "no error message was provided"
+	}
+
+	return check.Error.Message
+}
+
+// dataSourceDiagnostics converts an error from a Data Source operation into diagnostics, with one diagnostic
+// per failed check if the Data Source failed to connect.
+func dataSourceDiagnostics(err error) diag.Diagnostics {
+	var checksErr *dataSourceChecksError
+	if errors.As(err, &checksErr) {
+		return checksErr.diagnostics()
+	}
+
+	return diag.FromErr(err)
+}
+
+// dataSourceRunChecksOnPlanSchema is the attribute for opting into running a Data Source's checks during plan.
+func dataSourceRunChecksOnPlanSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Whether to test the connection of an existing Data Source during plan. If enabled, the plan fails when any of the Data Source's checks fail. Testing the connection updates the Data Source's status.",
+	}
+}
+
+// customizeDiffDataSourceChecks tests the connection of an existing Data Source during plan when
+// `run_checks_on_plan` is enabled.
+func customizeDiffDataSourceChecks(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() == "" || !d.Get("run_checks_on_plan").(bool) {
+		return nil
+	}
+
+	c := meta.(graphql.Client)
+	since := time.Now()
+
+	response, err := pc.TestDataSource(ctx, c, d.Id())
+	if err != nil {
+		return fmt.Errorf("failed to test Data Source: %w", err)
+	}
+
+	if response.TestDataSource == nil {
+		return errors.New("failed to test Data Source: received an empty response")
+	}
+
+	if r, ok := (*response.TestDataSource).(*pc.TestDataSourceTestDataSourceFailureResponse); ok {
+		return fmt.Errorf("failed to test Data Source: %s", r.GetError().GetMessage())
+	}
+
+	return waitForDataSourceChecks(ctx, c, d.Id(), planCheckTimeout, since)
+}
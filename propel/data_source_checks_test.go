@@ -0,0 +1,101 @@
+package propel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+func Test_failedDataSourceChecks(t *testing.T) {
+	since := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	before := since.Add(-time.Hour)
+	after := since.Add(time.Minute)
+
+	check := func(name string, status pc.DataSourceCheckStatus, checkedAt *time.Time) *pc.DataSourceDataChecksDataSourceCheck {
+		c := &pc.DataSourceDataChecksDataSourceCheck{Name: name, Status: status, CheckedAt: checkedAt}
+		if status == pc.DataSourceCheckStatusFailed {
+			c.Error = &pc.DataSourceDataChecksDataSourceCheckError{}
+			c.Error.Message = name + " is broken"
+		}
+		return c
+	}
+
+	tests := []struct {
+		name       string
+		status     pc.DataSourceStatus
+		checks     []*pc.DataSourceDataChecksDataSourceCheck
+		wantFailed []string
+		wantErr    bool
+	}{
+		{
+			name:   "Connecting without failed checks",
+			status: pc.DataSourceStatusConnecting,
+			checks: []*pc.DataSourceDataChecksDataSourceCheck{
+				check("connect", pc.DataSourceCheckStatusSucceeded, &after),
+				check("permissions", pc.DataSourceCheckStatusNotStarted, nil),
+			},
+		},
+		{
+			name:   "Connecting with a recently failed check",
+			status: pc.DataSourceStatusConnecting,
+			checks: []*pc.DataSourceDataChecksDataSourceCheck{
+				check("connect", pc.DataSourceCheckStatusSucceeded, &after),
+				check("permissions", pc.DataSourceCheckStatusFailed, &after),
+			},
+			wantFailed: []string{"permissions"},
+			wantErr:    true,
+		},
+		{
+			name:   "Connecting with a check that failed in a previous attempt",
+			status: pc.DataSourceStatusConnecting,
+			checks: []*pc.DataSourceDataChecksDataSourceCheck{
+				check("connect", pc.DataSourceCheckStatusFailed, &before),
+			},
+		},
+		{
+			name:   "Broken with checks that failed in a previous attempt",
+			status: pc.DataSourceStatusBroken,
+			checks: []*pc.DataSourceDataChecksDataSourceCheck{
+				check("connect", pc.DataSourceCheckStatusFailed, &before),
+				check("permissions", pc.DataSourceCheckStatusFailed, &after),
+			},
+			wantFailed: []string{"connect", "permissions"},
+			wantErr:    true,
+		},
+		{
+			name:    "Broken without failed checks",
+			status:  pc.DataSourceStatusBroken,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(st *testing.T) {
+			a := assert.New(st)
+
+			err := failedDataSourceChecks(&pc.DataSourceData{Status: tt.status, Checks: tt.checks}, since)
+			if !tt.wantErr {
+				a.NoError(err)
+				return
+			}
+
+			a.Error(err)
+
+			diags := dataSourceDiagnostics(err)
+			if len(tt.wantFailed) == 0 {
+				a.Len(diags, 1)
+				a.Equal("Data Source is BROKEN", diags[0].Summary)
+				return
+			}
+
+			a.Len(diags, len(tt.wantFailed))
+			for i, name := range tt.wantFailed {
+				a.Equal(`Data Source check "`+name+`" failed`, diags[i].Summary)
+				a.Equal(name+" is broken", diags[i].Detail)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package propel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+func dataSourceDataPool() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDataPoolRead,
+		Description: "Provides a Propel Data Pool data source. This can be used to look up an existing Propel Data Pool by its ID or unique name.",
+		Schema:      lookupDataSourceSchema(resourceDataPool().Schema, "Data Pool", "allow_column_recreate"),
+	}
+}
+
+func dataSourceDataPoolRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(graphql.Client)
+
+	id, err := lookupID(ctx, d, c, lookupDataPoolID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+	// The object must exist, so it is not removed from the state if it is missing.
+	d.MarkNewResource()
+
+	return resourceDataPoolRead(ctx, d, meta)
+}
+
+func lookupDataPoolID(ctx context.Context, c graphql.Client, uniqueName string) (string, error) {
+	response, err := pc.DataPoolByName(ctx, c, uniqueName)
+	if err != nil {
+		return "", err
+	}
+
+	if response.DataPool == nil {
+		return "", fmt.Errorf("Data Pool \"%s\" %w", uniqueName, pc.ErrNotFound)
+	}
+
+	return response.DataPool.Id, nil
+}
@@ -0,0 +1,61 @@
+package propel
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPropelDataPoolDataSource(t *testing.T) {
+	ctx := map[string]any{
+		"unique_name": acctest.RandString(12),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckPropelDataPoolDestroy,
+		Steps: []resource.TestStep{
+			// should look up the Data Pool by ID and unique name
+			{
+				Config: testAccCheckPropelDataPoolDataSourceConfig(ctx),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.propel_data_pool.by_id", "id", "propel_data_pool.foo", "id"),
+					resource.TestCheckResourceAttrPair("data.propel_data_pool.by_name", "id", "propel_data_pool.foo", "id"),
+					resource.TestCheckResourceAttr("data.propel_data_pool.by_name", "status", "LIVE"),
+					resource.TestCheckResourceAttr("data.propel_data_pool.by_name", "timestamp", "timestamp_tz"),
+					resource.TestCheckResourceAttr("data.propel_data_pool.by_name", "column.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPropelDataPoolDataSourceConfig(ctx map[string]any) string {
+	// language=hcl-terraform
+	return Nprintf(`
+	resource "propel_data_pool" "foo" {
+		unique_name = "%{unique_name}"
+
+		column {
+			name = "timestamp_tz"
+			type = "TIMESTAMP"
+			nullable = false
+		}
+		column {
+			name = "account_id"
+			type = "STRING"
+			nullable = false
+		}
+		timestamp = "timestamp_tz"
+	}
+
+	data "propel_data_pool" "by_id" {
+		id = propel_data_pool.foo.id
+	}
+
+	data "propel_data_pool" "by_name" {
+		unique_name = propel_data_pool.foo.unique_name
+	}`, ctx)
+}
@@ -0,0 +1,190 @@
+package propel
+
+import (
+	"context"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+func dataSourceDataPools() *schema.Resource {
+	s := listFilterSchema("Data Pools")
+
+	s["status"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(pc.DataPoolStatusCreated),
+			string(pc.DataPoolStatusPending),
+			string(pc.DataPoolStatusLive),
+			string(pc.DataPoolStatusSetupFailed),
+			string(pc.DataPoolStatusConnecting),
+			string(pc.DataPoolStatusConnected),
+			string(pc.DataPoolStatusBroken),
+			string(pc.DataPoolStatusPausing),
+			string(pc.DataPoolStatusPaused),
+			string(pc.DataPoolStatusDeleting),
+		}, true),
+		Description: "Only include Data Pools with this status.",
+	}
+
+	s["ids"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The IDs of the matching Data Pools.",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+
+	s["data_pools"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The matching Data Pools.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The Data Pool's ID.",
+				},
+				"unique_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The Data Pool's name.",
+				},
+				"description": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The Data Pool's description.",
+				},
+				"status": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The Data Pool's status.",
+				},
+				"data_source": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The Data Source that the Data Pool belongs to.",
+				},
+				"table": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the Data Pool's table.",
+				},
+				"timestamp": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The Data Pool's timestamp column.",
+				},
+				"access_control_enabled": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Whether the Data Pool has access control enabled or not.",
+				},
+				"account": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The Account that the Data Pool belongs to.",
+				},
+				"environment": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The Environment that the Data Pool belongs to.",
+				},
+				"created_at": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The date and time of when the Data Pool was created.",
+				},
+				"modified_at": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The date and time of when the Data Pool was modified.",
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceDataPoolsRead,
+		Description: "Provides a list of Propel Data Pools. Every Data Pool in the Environment is fetched and then filtered by the given criteria.",
+		Schema:      s,
+	}
+}
+
+func dataSourceDataPoolsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(graphql.Client)
+
+	filter, err := expandListFilter(d, false, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := make([]string, 0)
+	dataPools := make([]map[string]any, 0)
+
+	first := listPageSize
+	var after *string
+
+	for {
+		response, err := pc.DataPools(ctx, c, &first, nil, after, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, edge := range response.DataPools.Edges {
+			dp := edge.Node
+
+			if !filter.matches(dp.GetUniqueName(), "", string(dp.GetStatus())) {
+				continue
+			}
+
+			dataPool := map[string]any{
+				"id":                     dp.GetId(),
+				"unique_name":            dp.GetUniqueName(),
+				"description":            dp.GetDescription(),
+				"status":                 dp.GetStatus(),
+				"table":                  dp.GetTable(),
+				"access_control_enabled": dp.GetAccessControlEnabled(),
+				"account":                dp.GetAccount().Id,
+				"environment":            dp.GetEnvironment().Id,
+				"created_at":             dp.GetCreatedAt().String(),
+				"modified_at":            dp.GetModifiedAt().String(),
+			}
+
+			if dp.GetDataSource() != nil {
+				dataPool["data_source"] = dp.GetDataSource().Id
+			}
+
+			if dp.GetTimestamp() != nil {
+				dataPool["timestamp"] = dp.GetTimestamp().ColumnName
+			}
+
+			ids = append(ids, dp.GetId())
+			dataPools = append(dataPools, dataPool)
+		}
+
+		pageInfo := response.DataPools.PageInfo
+		if pageInfo == nil || !pageInfo.GetHasNextPage() || pageInfo.GetEndCursor() == nil {
+			break
+		}
+
+		after = pageInfo.GetEndCursor()
+	}
+
+	d.SetId(filter.id())
+
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("data_pools", dataPools); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
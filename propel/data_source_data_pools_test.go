@@ -0,0 +1,71 @@
+package propel
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPropelDataPoolsDataSource(t *testing.T) {
+	ctx := map[string]any{
+		"prefix": acctest.RandString(8),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckPropelDataPoolDestroy,
+		Steps: []resource.TestStep{
+			// should list the Data Pools matching the filters
+			{
+				Config: testAccCheckPropelDataPoolsDataSourceConfig(ctx),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.propel_data_pools.by_prefix", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.propel_data_pools.by_regex", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.propel_data_pools.by_regex", "data_pools.0.id", "propel_data_pool.bar", "id"),
+					resource.TestCheckResourceAttr("data.propel_data_pools.by_regex", "data_pools.0.status", "LIVE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPropelDataPoolsDataSourceConfig(ctx map[string]any) string {
+	// language=hcl-terraform
+	return Nprintf(`
+	resource "propel_data_pool" "foo" {
+		unique_name = "%{prefix}_foo"
+
+		column {
+			name = "timestamp_tz"
+			type = "TIMESTAMP"
+			nullable = false
+		}
+		timestamp = "timestamp_tz"
+	}
+
+	resource "propel_data_pool" "bar" {
+		unique_name = "%{prefix}_bar"
+
+		column {
+			name = "timestamp_tz"
+			type = "TIMESTAMP"
+			nullable = false
+		}
+		timestamp = "timestamp_tz"
+	}
+
+	data "propel_data_pools" "by_prefix" {
+		unique_name_prefix = "%{prefix}_"
+
+		depends_on = [propel_data_pool.foo, propel_data_pool.bar]
+	}
+
+	data "propel_data_pools" "by_regex" {
+		unique_name_regex = "^%{prefix}_b"
+		status            = "LIVE"
+
+		depends_on = [propel_data_pool.foo, propel_data_pool.bar]
+	}`, ctx)
+}
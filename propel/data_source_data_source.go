@@ -0,0 +1,60 @@
+package propel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+func dataSourceDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDataSourceRead,
+		Description: "Provides a Propel Data Source data source. This can be used to look up an existing Propel Data Source by its ID or unique name.",
+		Schema: lookupDataSourceSchema(
+			resourceDataSource().Schema,
+			"Data Source",
+			"snowflake_connection_settings",
+			"http_connection_settings",
+			"s3_connection_settings",
+			"webhook_connection_settings",
+			"kafka_connection_settings",
+			"clickhouse_connection_settings",
+			"postgresql_connection_settings",
+			"table",
+			"run_checks_on_plan",
+		),
+	}
+}
+
+func dataSourceDataSourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(graphql.Client)
+
+	id, err := lookupID(ctx, d, c, lookupDataSourceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+	// The object must exist, so it is not removed from the state if it is missing.
+	d.MarkNewResource()
+
+	return resourceDataSourceRead(ctx, d, meta)
+}
+
+func lookupDataSourceID(ctx context.Context, c graphql.Client, uniqueName string) (string, error) {
+	response, err := pc.DataSourceByName(ctx, c, uniqueName)
+	if err != nil {
+		return "", err
+	}
+
+	if response.DataSource == nil {
+		return "", fmt.Errorf("Data Source \"%s\" %w", uniqueName, pc.ErrNotFound)
+	}
+
+	return response.DataSource.Id, nil
+}
@@ -0,0 +1,61 @@
+package propel
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPropelDataSourceDataSource(t *testing.T) {
+	t.Parallel()
+
+	ctx := map[string]any{
+		"unique_name": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckPropelDataSourceDestroy,
+		Steps: []resource.TestStep{
+			// should look up the Data Source by ID and unique name
+			{
+				Config: testAccCheckPropelDataSourceDataSourceConfig(ctx),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.propel_data_source.by_id", "id", "propel_data_source.foo", "id"),
+					resource.TestCheckResourceAttrPair("data.propel_data_source.by_name", "id", "propel_data_source.foo", "id"),
+					resource.TestCheckResourceAttr("data.propel_data_source.by_name", "type", "HTTP"),
+					resource.TestCheckResourceAttr("data.propel_data_source.by_name", "status", "CONNECTED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPropelDataSourceDataSourceConfig(ctx map[string]any) string {
+	// language=hcl-terraform
+	return Nprintf(`
+	resource "propel_data_source" "foo" {
+		unique_name = "%{unique_name}"
+		type = "HTTP"
+
+		table {
+			name = "events"
+
+			column {
+				name = "timestamp_tz"
+				type = "TIMESTAMP"
+				nullable = false
+			}
+		}
+	}
+
+	data "propel_data_source" "by_id" {
+		id = propel_data_source.foo.id
+	}
+
+	data "propel_data_source" "by_name" {
+		unique_name = propel_data_source.foo.unique_name
+	}`, ctx)
+}
@@ -0,0 +1,175 @@
+package propel
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+func dataSourceDataSources() *schema.Resource {
+	s := listFilterSchema("Data Sources")
+
+	s["type"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			"SNOWFLAKE",
+			"S3",
+			"HTTP",
+			"WEBHOOK",
+			"KAFKA",
+			"CLICKHOUSE",
+			"POSTGRESQL",
+		}, true),
+		Description: "Only include Data Sources of this type. The valid values are `SNOWFLAKE`, `S3`, `HTTP`, `WEBHOOK`, `KAFKA`, `CLICKHOUSE` and `POSTGRESQL`.",
+	}
+
+	s["status"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(pc.DataSourceStatusCreated),
+			string(pc.DataSourceStatusConnecting),
+			string(pc.DataSourceStatusConnected),
+			string(pc.DataSourceStatusBroken),
+			string(pc.DataSourceStatusDeleting),
+		}, true),
+		Description: "Only include Data Sources with this status.",
+	}
+
+	s["ids"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The IDs of the matching Data Sources.",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+
+	s["data_sources"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The matching Data Sources.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The Data Source's ID.",
+				},
+				"unique_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The Data Source's name.",
+				},
+				"description": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The Data Source's description.",
+				},
+				"type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The Data Source's type.",
+				},
+				"status": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The Data Source's status.",
+				},
+				"account": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The Account that the Data Source belongs to.",
+				},
+				"environment": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The Environment that the Data Source belongs to.",
+				},
+				"created_at": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The date and time of when the Data Source was created.",
+				},
+				"modified_at": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The date and time of when the Data Source was modified.",
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceDataSourcesRead,
+		Description: "Provides a list of Propel Data Sources. Every Data Source in the Environment is fetched and then filtered by the given criteria.",
+		Schema:      s,
+	}
+}
+
+func dataSourceDataSourcesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(graphql.Client)
+
+	filter, err := expandListFilter(d, true, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := make([]string, 0)
+	dataSources := make([]map[string]any, 0)
+
+	first := listPageSize
+	var after *string
+
+	for {
+		response, err := pc.DataSources(ctx, c, &first, nil, after, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, edge := range response.DataSources.Edges {
+			ds := edge.Node
+
+			if !filter.matches(ds.GetUniqueName(), string(ds.GetType()), string(ds.GetStatus())) {
+				continue
+			}
+
+			ids = append(ids, ds.GetId())
+			dataSources = append(dataSources, map[string]any{
+				"id":          ds.GetId(),
+				"unique_name": ds.GetUniqueName(),
+				"description": ds.GetDescription(),
+				"type":        strings.ToUpper(string(ds.GetType())),
+				"status":      ds.GetStatus(),
+				"account":     ds.GetAccount().Id,
+				"environment": ds.GetEnvironment().Id,
+				"created_at":  ds.GetCreatedAt().String(),
+				"modified_at": ds.GetModifiedAt().String(),
+			})
+		}
+
+		pageInfo := response.DataSources.PageInfo
+		if pageInfo == nil || !pageInfo.GetHasNextPage() || pageInfo.GetEndCursor() == nil {
+			break
+		}
+
+		after = pageInfo.GetEndCursor()
+	}
+
+	d.SetId(filter.id())
+
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("data_sources", dataSources); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
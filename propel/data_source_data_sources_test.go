@@ -0,0 +1,93 @@
+package propel
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPropelDataSourcesDataSource(t *testing.T) {
+	ctx := map[string]any{
+		"prefix": acctest.RandString(8),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckPropelDataSourceDestroy,
+		Steps: []resource.TestStep{
+			// should list the Data Sources matching the filters
+			{
+				Config: testAccCheckPropelDataSourcesDataSourceConfig(ctx),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.propel_data_sources.by_prefix", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.propel_data_sources.by_regex", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.propel_data_sources.by_regex", "data_sources.0.id", "propel_data_source.bar", "id"),
+					resource.TestCheckResourceAttr("data.propel_data_sources.by_regex", "data_sources.0.type", "HTTP"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPropelDataSourcesDataSourceConfig(ctx map[string]any) string {
+	// language=hcl-terraform
+	return Nprintf(`
+	resource "propel_data_source" "foo" {
+		unique_name = "%{prefix}_foo"
+		type = "HTTP"
+
+		http_connection_settings {
+			basic_auth {
+				username = "foo"
+				password = "bar"
+			}
+		}
+
+		table {
+			name = "events"
+
+			column {
+				name = "timestamp_tz"
+				type = "TIMESTAMP"
+				nullable = false
+			}
+		}
+	}
+
+	resource "propel_data_source" "bar" {
+		unique_name = "%{prefix}_bar"
+		type = "HTTP"
+
+		http_connection_settings {
+			basic_auth {
+				username = "foo"
+				password = "bar"
+			}
+		}
+
+		table {
+			name = "events"
+
+			column {
+				name = "timestamp_tz"
+				type = "TIMESTAMP"
+				nullable = false
+			}
+		}
+	}
+
+	data "propel_data_sources" "by_prefix" {
+		unique_name_prefix = "%{prefix}_"
+
+		depends_on = [propel_data_source.foo, propel_data_source.bar]
+	}
+
+	data "propel_data_sources" "by_regex" {
+		unique_name_regex = "^%{prefix}_b"
+		type              = "HTTP"
+
+		depends_on = [propel_data_source.foo, propel_data_source.bar]
+	}`, ctx)
+}
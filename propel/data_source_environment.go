@@ -0,0 +1,71 @@
+package propel
+
+import (
+	"context"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var _ datasource.DataSourceWithConfigure = &environmentDataSource{}
+
+// environmentDataSource is the propel_environment data source, built on terraform-plugin-framework.
+type environmentDataSource struct {
+	client graphql.Client
+}
+
+func newEnvironmentDataSource() datasource.DataSource {
+	return &environmentDataSource{}
+}
+
+func (d *environmentDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment"
+}
+
+func (d *environmentDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides a Propel Environment data source. This can be used to look up an existing Propel Environment by its ID.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "The Environment's ID.",
+			},
+			"unique_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Environment's unique name.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Environment's description.",
+			},
+			"account": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Account that the Environment belongs to.",
+			},
+		},
+	}
+}
+
+func (d *environmentDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	// The provider data is not set until the provider is configured.
+	if c, ok := req.ProviderData.(graphql.Client); ok {
+		d.client = c
+	}
+}
+
+func (d *environmentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config environmentModel
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &config)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The Environment must exist, so an Environment that is not found is an error.
+	state, err := readEnvironment(ctx, d.client, config.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read Environment", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
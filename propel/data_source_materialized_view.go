@@ -0,0 +1,48 @@
+package propel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+func dataSourceMaterializedView() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMaterializedViewRead,
+		Description: "Provides a Propel Materialized View data source. This can be used to look up an existing Propel Materialized View by its ID or unique name.",
+		Schema:      lookupDataSourceSchema(resourceMaterializedView().Schema, "Materialized View", "existing_data_pool", "new_data_pool", "backfill"),
+	}
+}
+
+func dataSourceMaterializedViewRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(graphql.Client)
+
+	id, err := lookupID(ctx, d, c, lookupMaterializedViewID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+	// The object must exist, so it is not removed from the state if it is missing.
+	d.MarkNewResource()
+
+	return resourceMaterializedViewRead(ctx, d, meta)
+}
+
+func lookupMaterializedViewID(ctx context.Context, c graphql.Client, uniqueName string) (string, error) {
+	response, err := pc.MaterializedViewByName(ctx, c, uniqueName)
+	if err != nil {
+		return "", err
+	}
+
+	if response.MaterializedView == nil {
+		return "", fmt.Errorf("Materialized View \"%s\" %w", uniqueName, pc.ErrNotFound)
+	}
+
+	return response.MaterializedView.Id, nil
+}
@@ -0,0 +1,48 @@
+package propel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+func dataSourceMetric() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMetricRead,
+		Description: "Provides a Propel Metric data source. This can be used to look up an existing Propel Metric by its ID or unique name.",
+		Schema:      lookupDataSourceSchema(resourceMetric().Schema, "Metric"),
+	}
+}
+
+func dataSourceMetricRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(graphql.Client)
+
+	id, err := lookupID(ctx, d, c, lookupMetricID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+	// The object must exist, so it is not removed from the state if it is missing.
+	d.MarkNewResource()
+
+	return resourceMetricRead(ctx, d, meta)
+}
+
+func lookupMetricID(ctx context.Context, c graphql.Client, uniqueName string) (string, error) {
+	response, err := pc.MetricByName(ctx, c, uniqueName)
+	if err != nil {
+		return "", err
+	}
+
+	if response.Metric == nil {
+		return "", fmt.Errorf("Metric \"%s\" %w", uniqueName, pc.ErrNotFound)
+	}
+
+	return response.Metric.Id, nil
+}
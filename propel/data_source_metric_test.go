@@ -0,0 +1,68 @@
+package propel
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPropelMetricDataSource(t *testing.T) {
+	ctx := map[string]any{
+		"unique_name": acctest.RandString(12),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckPropelMetricDestroy,
+		Steps: []resource.TestStep{
+			// should look up the Metric by ID and unique name
+			{
+				Config: testAccCheckPropelMetricDataSourceConfig(ctx),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.propel_metric.by_id", "id", "propel_metric.baz", "id"),
+					resource.TestCheckResourceAttrPair("data.propel_metric.by_name", "id", "propel_metric.baz", "id"),
+					resource.TestCheckResourceAttr("data.propel_metric.by_name", "type", "SUM"),
+					resource.TestCheckResourceAttr("data.propel_metric.by_name", "measure", "value"),
+					resource.TestCheckResourceAttrPair("data.propel_metric.by_name", "data_pool", "propel_data_pool.bar", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPropelMetricDataSourceConfig(ctx map[string]any) string {
+	// language=hcl-terraform
+	return Nprintf(`
+	resource "propel_data_pool" "bar" {
+		unique_name = "%{unique_name}"
+
+		column {
+			name = "timestamp_tz"
+			type = "TIMESTAMP"
+			nullable = false
+		}
+		column {
+			name = "value"
+			type = "INT64"
+			nullable = false
+		}
+		timestamp = "timestamp_tz"
+	}
+
+	resource "propel_metric" "baz" {
+		unique_name = "%{unique_name}"
+		data_pool   = propel_data_pool.bar.id
+		type        = "SUM"
+		measure     = "value"
+	}
+
+	data "propel_metric" "by_id" {
+		id = propel_metric.baz.id
+	}
+
+	data "propel_metric" "by_name" {
+		unique_name = propel_metric.baz.unique_name
+	}`, ctx)
+}
@@ -0,0 +1,176 @@
+package propel
+
+import (
+	"context"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+func dataSourceMetrics() *schema.Resource {
+	s := listFilterSchema("Metrics")
+
+	s["type"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			"SUM",
+			"COUNT",
+			"COUNT_DISTINCT",
+			"AVERAGE",
+			"MIN",
+			"MAX",
+			"CUSTOM",
+		}, false),
+		Description: "Only include Metrics of this type.",
+	}
+
+	s["ids"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The IDs of the matching Metrics.",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+
+	s["metrics"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The matching Metrics.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The Metric's ID.",
+				},
+				"unique_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The Metric's name.",
+				},
+				"description": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The Metric's description.",
+				},
+				"type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The Metric type.",
+				},
+				"data_pool": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The Data Pool that powers the Metric.",
+				},
+				"dimensions": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "The Metric's Dimensions.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"account": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The Account that the Metric belongs to.",
+				},
+				"environment": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The Environment that the Metric belongs to.",
+				},
+				"created_at": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The date and time of when the Metric was created.",
+				},
+				"modified_at": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The date and time of when the Metric was modified.",
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceMetricsRead,
+		Description: "Provides a list of Propel Metrics. Every Metric in the Environment is fetched and then filtered by the given criteria.",
+		Schema:      s,
+	}
+}
+
+func dataSourceMetricsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(graphql.Client)
+
+	filter, err := expandListFilter(d, true, false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := make([]string, 0)
+	metrics := make([]map[string]any, 0)
+
+	first := listPageSize
+	var after *string
+
+	for {
+		response, err := pc.Metrics(ctx, c, &first, nil, after, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, m := range response.Metrics.Nodes {
+			if !filter.matches(m.GetUniqueName(), string(m.GetType()), "") {
+				continue
+			}
+
+			dimensions := make([]string, 0, len(m.GetDimensions()))
+			for _, dimension := range m.GetDimensions() {
+				dimensions = append(dimensions, dimension.ColumnName)
+			}
+
+			metric := map[string]any{
+				"id":          m.GetId(),
+				"unique_name": m.GetUniqueName(),
+				"description": m.GetDescription(),
+				"type":        m.GetType(),
+				"dimensions":  dimensions,
+				"account":     m.GetAccount().Id,
+				"environment": m.GetEnvironment().Id,
+				"created_at":  m.GetCreatedAt().String(),
+				"modified_at": m.GetModifiedAt().String(),
+			}
+
+			if m.GetDataPool() != nil {
+				metric["data_pool"] = m.GetDataPool().Id
+			}
+
+			ids = append(ids, m.GetId())
+			metrics = append(metrics, metric)
+		}
+
+		pageInfo := response.Metrics.PageInfo
+		if pageInfo == nil || !pageInfo.GetHasNextPage() || pageInfo.GetEndCursor() == nil {
+			break
+		}
+
+		after = pageInfo.GetEndCursor()
+	}
+
+	d.SetId(filter.id())
+
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("metrics", metrics); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package propel
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPropelMetricsDataSource(t *testing.T) {
+	ctx := map[string]any{
+		"prefix": acctest.RandString(8),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckPropelMetricDestroy,
+		Steps: []resource.TestStep{
+			// should list the Metrics matching the filters
+			{
+				Config: testAccCheckPropelMetricsDataSourceConfig(ctx),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.propel_metrics.by_prefix", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.propel_metrics.by_regex", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.propel_metrics.by_regex", "metrics.0.id", "propel_metric.bar", "id"),
+					resource.TestCheckResourceAttrPair("data.propel_metrics.by_regex", "metrics.0.data_pool", "propel_data_pool.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPropelMetricsDataSourceConfig(ctx map[string]any) string {
+	// language=hcl-terraform
+	return Nprintf(`
+	resource "propel_data_pool" "foo" {
+		unique_name = "%{prefix}_foo"
+
+		column {
+			name = "timestamp_tz"
+			type = "TIMESTAMP"
+			nullable = false
+		}
+		column {
+			name = "amount"
+			type = "INT64"
+			nullable = false
+		}
+		timestamp = "timestamp_tz"
+	}
+
+	resource "propel_metric" "foo" {
+		unique_name = "%{prefix}_foo"
+		data_pool   = propel_data_pool.foo.id
+		type        = "COUNT"
+	}
+
+	resource "propel_metric" "bar" {
+		unique_name = "%{prefix}_bar"
+		data_pool   = propel_data_pool.foo.id
+		type        = "SUM"
+		measure     = "amount"
+	}
+
+	data "propel_metrics" "by_prefix" {
+		unique_name_prefix = "%{prefix}_"
+
+		depends_on = [propel_metric.foo, propel_metric.bar]
+	}
+
+	data "propel_metrics" "by_regex" {
+		unique_name_regex = "^%{prefix}_b"
+		type              = "SUM"
+
+		depends_on = [propel_metric.foo, propel_metric.bar]
+	}`, ctx)
+}
@@ -0,0 +1,113 @@
+package propel
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+// normalizeSQL returns a SQL filter with its insignificant whitespace removed: runs of whitespace are collapsed into
+// a single space, and whitespace is trimmed from both ends and inside parentheses. Quoted strings and identifiers are
+// left as they are.
+func normalizeSQL(sql string) string {
+	var b strings.Builder
+
+	var quote, last rune
+	space := false
+
+	for _, r := range strings.TrimSpace(sql) {
+		if quote != 0 {
+			last = r
+			b.WriteRune(r)
+
+			if r == quote {
+				quote = 0
+			}
+
+			continue
+		}
+
+		switch {
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			space = true
+			continue
+		case r == '\'' || r == '"' || r == '`':
+			quote = r
+		}
+
+		if space && r != ')' && last != '(' {
+			b.WriteRune(' ')
+		}
+
+		space = false
+		last = r
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+// suppressEquivalentSQL suppresses the diff between two SQL filters that only differ by whitespace.
+func suppressEquivalentSQL(_, old, new string, _ *schema.ResourceData) bool {
+	return normalizeSQL(old) == normalizeSQL(new)
+}
+
+// expandFilters returns the filters of a resource that can be defined either with filter blocks, or with SQL in the
+// `filter_sql` attribute. When switching from one form to the other, the form that is no longer used is returned empty
+// to clear it.
+func expandFilters(d *schema.ResourceData, key string) ([]*pc.FilterInput, *string, diag.Diagnostics) {
+	if sql := d.Get("filter_sql").(string); sql != "" {
+		if !d.IsNewResource() && d.HasChange(key) {
+			return make([]*pc.FilterInput, 0), &sql, nil
+		}
+
+		return nil, &sql, nil
+	}
+
+	filters := make([]*pc.FilterInput, 0)
+	if def, ok := d.Get(key).([]any); ok && len(def) > 0 {
+		var diags diag.Diagnostics
+		if filters, diags = expandMetricFilters(def); diags != nil {
+			return nil, nil, diags
+		}
+	}
+
+	if !d.IsNewResource() && d.HasChange("filter_sql") {
+		sql := ""
+		return filters, &sql, nil
+	}
+
+	return filters, nil, nil
+}
+
+// setFilters sets the filters of a resource that can be defined either with filter blocks, or with SQL in the
+// `filter_sql` attribute. The API may return a filter defined one way in both forms, so only the form the resource is
+// managed with is kept. Imported resources with a SQL filter are managed with SQL.
+func setFilters(d *schema.ResourceData, key string, filters []*pc.FilterInput, filterSQL *string) error {
+	sql := ""
+	if filterSQL != nil {
+		sql = *filterSQL
+	}
+
+	current := d.Get(key).([]any)
+
+	if len(current) == 0 && sql != "" {
+		filters = nil
+	} else if len(filters) > 0 {
+		sql = ""
+	}
+
+	flattened, err := flattenFilters(filters, current)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set(key, flattened); err != nil {
+		return err
+	}
+
+	return d.Set("filter_sql", sql)
+}
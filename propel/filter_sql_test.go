@@ -0,0 +1,129 @@
+package propel
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+func Test_normalizeSQL(t *testing.T) {
+	tests := []struct {
+		name     string
+		sql      string
+		expected string
+	}{
+		{
+			name:     "Already normalized",
+			sql:      "status = 'paid'",
+			expected: "status = 'paid'",
+		},
+		{
+			name:     "Line breaks and indentation",
+			sql:      "\n  status IN ('paid', 'shipped')\n  AND country = 'US'\n",
+			expected: "status IN ('paid', 'shipped') AND country = 'US'",
+		},
+		{
+			name:     "Whitespace inside parentheses",
+			sql:      "( country = 'US' OR country = 'CA' )",
+			expected: "(country = 'US' OR country = 'CA')",
+		},
+		{
+			name:     "Quoted strings are kept",
+			sql:      `name = 'a  b' AND "my  column" = 1`,
+			expected: `name = 'a  b' AND "my  column" = 1`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(st *testing.T) {
+			a := assert.New(st)
+
+			a.Equal(tt.expected, normalizeSQL(tt.sql))
+		})
+	}
+}
+
+func Test_setFilters(t *testing.T) {
+	paid := "paid"
+	filters := []*pc.FilterInput{{Column: "status", Operator: pc.FilterOperatorEquals, Value: &paid}}
+	filterSQL := "status = 'paid'"
+
+	tests := []struct {
+		name              string
+		state             map[string]any
+		filters           []*pc.FilterInput
+		filterSQL         *string
+		expectedFilters   int
+		expectedFilterSQL string
+	}{
+		{
+			name:              "Managed with filter blocks",
+			state:             map[string]any{"filter": []any{map[string]any{"column": "status", "operator": "EQUALS", "value": "paid"}}},
+			filters:           filters,
+			filterSQL:         &filterSQL,
+			expectedFilters:   1,
+			expectedFilterSQL: "",
+		},
+		{
+			name:              "Managed with SQL",
+			state:             map[string]any{"filter_sql": filterSQL},
+			filters:           filters,
+			filterSQL:         &filterSQL,
+			expectedFilters:   0,
+			expectedFilterSQL: filterSQL,
+		},
+		{
+			name:              "Imported with filters only",
+			state:             map[string]any{},
+			filters:           filters,
+			expectedFilters:   1,
+			expectedFilterSQL: "",
+		},
+		{
+			name:              "Imported with SQL",
+			state:             map[string]any{},
+			filterSQL:         &filterSQL,
+			expectedFilters:   0,
+			expectedFilterSQL: filterSQL,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(st *testing.T) {
+			a := assert.New(st)
+
+			d := schema.TestResourceDataRaw(st, resourceMetric().Schema, tt.state)
+
+			a.NoError(setFilters(d, "filter", tt.filters, tt.filterSQL))
+			a.Len(d.Get("filter"), tt.expectedFilters)
+			a.Equal(tt.expectedFilterSQL, d.Get("filter_sql"))
+		})
+	}
+}
+
+func Test_expandFilters(t *testing.T) {
+	a := assert.New(t)
+
+	d := schema.TestResourceDataRaw(t, resourceDataPoolAccessPolicy().Schema, map[string]any{
+		"filter_sql": "account_id IN ('a', 'b')",
+	})
+	d.MarkNewResource()
+
+	rows, filterSQL, diags := expandFilters(d, "row")
+	a.Nil(diags)
+	a.Nil(rows)
+	a.Equal("account_id IN ('a', 'b')", *filterSQL)
+
+	d = schema.TestResourceDataRaw(t, resourceDataPoolAccessPolicy().Schema, map[string]any{
+		"row": []any{map[string]any{"column": "account_id", "operator": "IS_NOT_NULL"}},
+	})
+	d.MarkNewResource()
+
+	rows, filterSQL, diags = expandFilters(d, "row")
+	a.Nil(diags)
+	a.Len(rows, 1)
+	a.Nil(filterSQL)
+}
@@ -0,0 +1,275 @@
+package propel
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/propeldata/terraform-provider-propel/propel/internal/utils"
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+// maxFilterDepth is how many levels of `and_filter` and `or_filter` blocks can be nested in a filter. It matches the
+// depth read by the FilterData fragment.
+const maxFilterDepth = 2
+
+type MetricFilter interface {
+	GetColumn() string
+	GetOperator() pc.FilterOperator
+	GetValue() *string
+	GetAnd() []*pc.FilterDataAndFilter
+	GetOr() []*pc.FilterDataOrFilter
+}
+
+// filterSchema returns the schema of a filter block, with its nested `and_filter` and `or_filter` blocks. The
+// deprecated `and` and `or` JSON strings are only available on the top-level filters.
+func filterSchema() map[string]*schema.Schema {
+	s := nestedFilterSchema(maxFilterDepth)
+
+	s["and"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Additional filters to AND with this one. AND takes precedence over OR. It is defined as a JSON string value.",
+		Deprecated:   "Use `and_filter` blocks instead. This attribute will be removed in the next major version of the provider.",
+		ValidateFunc: validation.StringIsJSON,
+		StateFunc: func(v any) string {
+			nJSON, _ := structure.NormalizeJsonString(v)
+			return nJSON
+		},
+	}
+
+	s["or"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Additional filters to OR with this one. AND takes precedence over OR. It is defined as a JSON string value.",
+		Deprecated:   "Use `or_filter` blocks instead. This attribute will be removed in the next major version of the provider.",
+		ValidateFunc: validation.StringIsJSON,
+		StateFunc: func(v any) string {
+			nJSON, _ := structure.NormalizeJsonString(v)
+			return nJSON
+		},
+	}
+
+	return s
+}
+
+func nestedFilterSchema(depth int) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"column": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of the column to filter on.",
+		},
+		"operator": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "The operation to perform when comparing the column and filter values.",
+			ValidateFunc: utils.IsValidOperator,
+		},
+		"value": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The value to compare the column to.",
+		},
+	}
+
+	if depth == 0 {
+		return s
+	}
+
+	s["and_filter"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: fmt.Sprintf("Additional filters to AND with this one. AND takes precedence over OR. Filters can be nested up to %d levels deep.", maxFilterDepth),
+		Elem:        &schema.Resource{Schema: nestedFilterSchema(depth - 1)},
+	}
+
+	s["or_filter"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: fmt.Sprintf("Additional filters to OR with this one. AND takes precedence over OR. Filters can be nested up to %d levels deep.", maxFilterDepth),
+		Elem:        &schema.Resource{Schema: nestedFilterSchema(depth - 1)},
+	}
+
+	return s
+}
+
+// expandMetricFilters converts filter blocks into filter inputs. The nested filters of a block are either its
+// `and_filter` and `or_filter` blocks, or the deprecated `and` and `or` JSON strings.
+func expandMetricFilters(def []any) ([]*pc.FilterInput, diag.Diagnostics) {
+	filters := make([]*pc.FilterInput, 0, len(def))
+
+	for _, rawFilter := range def {
+		filter := rawFilter.(map[string]any)
+
+		f := &pc.FilterInput{
+			Column:   filter["column"].(string),
+			Operator: pc.FilterOperator(filter["operator"].(string)),
+		}
+
+		if def, ok := filter["value"]; ok {
+			value := def.(string)
+			f.Value = &value
+		}
+
+		for _, nested := range []struct {
+			key      string
+			blockKey string
+			inputs   *[]*pc.FilterInput
+		}{
+			{key: "and", blockKey: "and_filter", inputs: &f.And},
+			{key: "or", blockKey: "or_filter", inputs: &f.Or},
+		} {
+			blocks, _ := filter[nested.blockKey].([]any)
+
+			if def, ok := filter[nested.key]; ok && def != "" {
+				if len(blocks) > 0 {
+					return nil, diag.Errorf("the filter on column \"%s\" cannot set both `%s` and `%s`", f.Column, nested.key, nested.blockKey)
+				}
+
+				if err := json.Unmarshal([]byte(def.(string)), nested.inputs); err != nil {
+					return nil, diag.FromErr(err)
+				}
+
+				continue
+			}
+
+			if len(blocks) > 0 {
+				inputs, diags := expandMetricFilters(blocks)
+				if diags != nil {
+					return nil, diags
+				}
+
+				*nested.inputs = inputs
+			}
+		}
+
+		filters = append(filters, f)
+	}
+
+	return filters, nil
+}
+
+// parseMetricFilters converts the filters returned by the API into filter inputs, which have the same fields.
+func parseMetricFilters[T MetricFilter](filters []T) ([]*pc.FilterInput, error) {
+	data, err := json.Marshal(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedFilters := make([]*pc.FilterInput, 0, len(filters))
+	if err := json.Unmarshal(data, &parsedFilters); err != nil {
+		return nil, err
+	}
+
+	return parsedFilters, nil
+}
+
+// flattenFilters converts filters into filter blocks. The nested filters are set as `and_filter` and `or_filter`
+// blocks, unless the matching block of current is configured with the deprecated `and` and `or` JSON strings.
+func flattenFilters(filters []*pc.FilterInput, current []any) ([]map[string]any, error) {
+	flattened := make([]map[string]any, len(filters))
+
+	for i, f := range filters {
+		var legacy map[string]any
+		if i < len(current) {
+			legacy, _ = current[i].(map[string]any)
+		}
+
+		filter := flattenNestedFilter(f, maxFilterDepth)
+
+		for _, nested := range []struct {
+			key      string
+			blockKey string
+			inputs   []*pc.FilterInput
+		}{
+			{key: "and", blockKey: "and_filter", inputs: f.And},
+			{key: "or", blockKey: "or_filter", inputs: f.Or},
+		} {
+			if s, _ := legacy[nested.key].(string); s == "" || len(nested.inputs) == 0 {
+				continue
+			}
+
+			s, err := legacyFilterJSON(nested.inputs)
+			if err != nil {
+				return nil, err
+			}
+
+			filter[nested.key] = s
+			delete(filter, nested.blockKey)
+		}
+
+		flattened[i] = filter
+	}
+
+	return flattened, nil
+}
+
+func flattenNestedFilter(f *pc.FilterInput, depth int) map[string]any {
+	value := ""
+	if f.Value != nil {
+		value = *f.Value
+	}
+
+	filter := map[string]any{
+		"column":   f.Column,
+		"operator": string(f.Operator),
+		"value":    value,
+	}
+
+	if depth == 0 {
+		return filter
+	}
+
+	and := make([]any, 0, len(f.And))
+	for _, nested := range f.And {
+		and = append(and, flattenNestedFilter(nested, depth-1))
+	}
+
+	or := make([]any, 0, len(f.Or))
+	for _, nested := range f.Or {
+		or = append(or, flattenNestedFilter(nested, depth-1))
+	}
+
+	filter["and_filter"] = and
+	filter["or_filter"] = or
+
+	return filter
+}
+
+// legacyFilter is the JSON form of the filters in the deprecated `and` and `or` strings.
+type legacyFilter struct {
+	Column   string            `json:"column"`
+	Operator pc.FilterOperator `json:"operator"`
+	Value    *string           `json:"value"`
+	And      []*legacyFilter   `json:"and,omitempty"`
+	Or       []*legacyFilter   `json:"or,omitempty"`
+}
+
+func legacyFilterJSON(filters []*pc.FilterInput) (string, error) {
+	data, err := json.Marshal(toLegacyFilters(filters))
+	if err != nil {
+		return "", err
+	}
+
+	return structure.NormalizeJsonString(string(data))
+}
+
+func toLegacyFilters(filters []*pc.FilterInput) []*legacyFilter {
+	legacy := make([]*legacyFilter, len(filters))
+	for i, f := range filters {
+		legacy[i] = &legacyFilter{
+			Column:   f.Column,
+			Operator: f.Operator,
+			Value:    f.Value,
+			And:      toLegacyFilters(f.And),
+			Or:       toLegacyFilters(f.Or),
+		}
+	}
+
+	return legacy
+}
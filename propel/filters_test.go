@@ -0,0 +1,115 @@
+package propel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+func Test_flattenFilters(t *testing.T) {
+	a := assert.New(t)
+
+	us, ca := "US", "CA"
+	filters := []*pc.FilterInput{
+		{
+			Column:   "country",
+			Operator: pc.FilterOperatorEquals,
+			Value:    &us,
+			Or:       []*pc.FilterInput{{Column: "country", Operator: pc.FilterOperatorEquals, Value: &ca}},
+		},
+		{
+			Column:   "country",
+			Operator: pc.FilterOperatorEquals,
+			Value:    &us,
+			Or:       []*pc.FilterInput{{Column: "country", Operator: pc.FilterOperatorEquals, Value: &ca}},
+		},
+	}
+
+	// The second filter is configured with the deprecated JSON strings.
+	current := []any{
+		map[string]any{"column": "country", "or": ""},
+		map[string]any{"column": "country", "or": `[{"column":"country","operator":"EQUALS","value":"CA"}]`},
+	}
+
+	flattened, err := flattenFilters(filters, current)
+	a.NoError(err)
+	a.Len(flattened, 2)
+
+	a.Equal([]any{map[string]any{
+		"column":     "country",
+		"operator":   "EQUALS",
+		"value":      "CA",
+		"and_filter": []any{},
+		"or_filter":  []any{},
+	}}, flattened[0]["or_filter"])
+	a.NotContains(flattened[0], "or")
+
+	a.Equal(`[{"column":"country","operator":"EQUALS","value":"CA"}]`, flattened[1]["or"])
+	a.NotContains(flattened[1], "or_filter")
+	a.Equal([]any{}, flattened[1]["and_filter"])
+}
+
+// Test_legacyFilterStrings checks that the filters configured with the deprecated JSON strings plan no changes once
+// they are read.
+func Test_legacyFilterStrings(t *testing.T) {
+	a := assert.New(t)
+
+	r := resourceDataPoolAccessPolicy()
+	or := `[{"column":"country","operator":"EQUALS","value":"CA"}]`
+
+	rawState := map[string]any{
+		"id":          "POL00000000000000000000000000",
+		"unique_name": "policy",
+		"description": "",
+		"data_pool":   "DPO00000000000000000000000000",
+		"account":     "ACC00000000000000000000000000",
+		"environment": "ENV00000000000000000000000000",
+		"columns":     []any{"*"},
+		"row": []any{
+			map[string]any{
+				"column":   "country",
+				"operator": "EQUALS",
+				"value":    "US",
+				"and":      "",
+				"or":       or,
+			},
+		},
+	}
+
+	value, err := schema.JSONMapToStateValue(rawState, r.CoreConfigSchema())
+	a.NoError(err)
+
+	state, err := r.ShimInstanceStateFromValue(value)
+	a.NoError(err)
+
+	// Read sets the filters returned by the API.
+	d := r.Data(state)
+	filters, _, diags := expandFilters(d, "row")
+	a.Nil(diags)
+	a.NoError(setFilters(d, "row", filters, nil))
+	a.Equal(or, d.Get("row.0.or"))
+
+	// The configuration still sets the deprecated JSON strings.
+	config := terraform.NewResourceConfigRaw(map[string]any{
+		"unique_name": "policy",
+		"data_pool":   "DPO00000000000000000000000000",
+		"columns":     []any{"*"},
+		"row": []any{
+			map[string]any{
+				"column":   "country",
+				"operator": "EQUALS",
+				"value":    "US",
+				"or":       or,
+			},
+		},
+	})
+
+	diff, err := r.SimpleDiff(context.Background(), d.State(), config, nil)
+	a.NoError(err)
+	a.True(diff == nil || diff.Empty(), "the plan should not have changes: %v", diff)
+}
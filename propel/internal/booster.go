@@ -0,0 +1,90 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+// WaitForBoosterLive waits for a Booster to be LIVE, failing early if it FAILED.
+func WaitForBoosterLive(ctx context.Context, client graphql.Client, id string, timeout time.Duration) error {
+	waiter := &Waiter[*pc.BoosterData]{
+		Name: "Booster",
+		Pending: []string{
+			string(pc.BoosterStatusCreated),
+			string(pc.BoosterStatusOptimizing),
+		},
+		Target: []string{
+			string(pc.BoosterStatusLive),
+		},
+		Failed: []string{
+			string(pc.BoosterStatusFailed),
+		},
+		Refresh: func(ctx context.Context) (Observation[*pc.BoosterData], error) {
+			resp, err := pc.Booster(ctx, client, id)
+			if err != nil {
+				return Observation[*pc.BoosterData]{}, fmt.Errorf("error trying to read Booster status: %w", err)
+			}
+
+			if resp.Booster == nil {
+				return Observation[*pc.BoosterData]{}, fmt.Errorf("Booster \"%s\" not found", id)
+			}
+
+			observation := Observation[*pc.BoosterData]{
+				Value: &resp.Booster.BoosterData,
+				State: string(resp.Booster.Status),
+			}
+			if resp.Booster.Error != nil {
+				observation.Message = resp.Booster.Error.Message
+			}
+
+			return observation, nil
+		},
+		Timeout:      WaitTimeout(timeout),
+		PollInterval: PollInterval(client),
+	}
+
+	_, err := waiter.Wait(ctx)
+	return err
+}
+
+// WaitForBoosterDeletion waits for a Booster to no longer be found.
+func WaitForBoosterDeletion(ctx context.Context, client graphql.Client, id string, timeout time.Duration) error {
+	waiter := &Waiter[*pc.BoosterData]{
+		Name: "Booster deletion",
+		Pending: []string{
+			string(pc.BoosterStatusCreated),
+			string(pc.BoosterStatusOptimizing),
+			string(pc.BoosterStatusLive),
+			string(pc.BoosterStatusFailed),
+			string(pc.BoosterStatusDeleting),
+		},
+		Target: []string{
+			StateDeleted,
+		},
+		Refresh: func(ctx context.Context) (Observation[*pc.BoosterData], error) {
+			resp, err := pc.Booster(ctx, client, id)
+			if pc.IsNotFound(err) || (err == nil && resp.Booster == nil) {
+				return Observation[*pc.BoosterData]{State: StateDeleted}, nil
+			}
+
+			if err != nil {
+				return Observation[*pc.BoosterData]{}, fmt.Errorf("error trying to read Booster status: %w", err)
+			}
+
+			return Observation[*pc.BoosterData]{
+				Value: &resp.Booster.BoosterData,
+				State: string(resp.Booster.Status),
+			}, nil
+		},
+		Timeout:      WaitTimeout(timeout),
+		PollInterval: PollInterval(client),
+	}
+
+	_, err := waiter.Wait(ctx)
+	return err
+}
@@ -15,7 +15,7 @@ func ClickHouseDataSourceSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:          schema.TypeList,
 		Optional:      true,
-		ConflictsWith: []string{"snowflake_connection_settings", "http_connection_settings", "s3_connection_settings", "webhook_connection_settings", "kafka_connection_settings"},
+		ConflictsWith: []string{"snowflake_connection_settings", "http_connection_settings", "s3_connection_settings", "webhook_connection_settings", "kafka_connection_settings", "postgresql_connection_settings"},
 		MaxItems:      1,
 		Elem: &schema.Resource{
 			Description: "The connection settings for a ClickHouse Data Source.",
@@ -51,7 +51,7 @@ func ClickHouseDataSourceSchema() *schema.Schema {
 	}
 }
 
-func ClickHouseDataSourceCreate(ctx context.Context, d *schema.ResourceData, c graphql.Client) (string, error) {
+func ClickHouseDataSourceCreate(ctx context.Context, d *schema.ResourceData, c graphql.Client, conn ConnectionSettings) (string, error) {
 	input := &pc.CreateClickHouseDataSourceInput{}
 
 	if v, ok := d.GetOk("unique_name"); ok && v.(string) != "" {
@@ -64,8 +64,7 @@ func ClickHouseDataSourceCreate(ctx context.Context, d *schema.ResourceData, c g
 		input.Description = &description
 	}
 
-	if v, ok := d.GetOk("clickhouse_connection_settings.0"); ok {
-		connectionSettings := v.(map[string]any)
+	if connectionSettings, ok := conn.Get(d); ok {
 		input.ConnectionSettings = &pc.ClickHouseConnectionSettingsInput{
 			Url:      connectionSettings["url"].(string),
 			Database: connectionSettings["database"].(string),
@@ -82,7 +81,7 @@ func ClickHouseDataSourceCreate(ctx context.Context, d *schema.ResourceData, c g
 	return response.CreateClickHouseDataSource.DataSource.Id, nil
 }
 
-func ClickHouseDataSourceUpdate(ctx context.Context, d *schema.ResourceData, c graphql.Client) error {
+func ClickHouseDataSourceUpdate(ctx context.Context, d *schema.ResourceData, c graphql.Client, conn ConnectionSettings) error {
 	id := d.Id()
 	input := &pc.ModifyClickHouseDataSourceInput{
 		IdOrUniqueName: &pc.IdOrUniqueName{Id: &id},
@@ -96,8 +95,8 @@ func ClickHouseDataSourceUpdate(ctx context.Context, d *schema.ResourceData, c g
 		input.Description = &description
 	}
 
-	if d.HasChanges("clickhouse_connection_settings") {
-		connectionSettings := d.Get("clickhouse_connection_settings.0").(map[string]any)
+	if conn.HasChange(d) {
+		connectionSettings, _ := conn.Get(d)
 		partialInput := &pc.PartialClickHouseConnectionSettingsInput{}
 
 		if v, ok := connectionSettings["url"]; ok && v.(string) != "" {
@@ -130,8 +129,8 @@ func ClickHouseDataSourceUpdate(ctx context.Context, d *schema.ResourceData, c g
 	return nil
 }
 
-func HandleClickHouseConnectionSettings(response *pc.DataSourceResponse, d *schema.ResourceData) error {
-	if _, exists := d.GetOk("clickhouse_connection_settings.0"); !exists {
+func HandleClickHouseConnectionSettings(response *pc.DataSourceResponse, d *schema.ResourceData, conn ConnectionSettings) error {
+	if _, exists := conn.Get(d); !exists {
 		return nil
 	}
 
@@ -145,7 +144,7 @@ func HandleClickHouseConnectionSettings(response *pc.DataSourceResponse, d *sche
 			"readonly": s.GetReadonly(),
 		}
 
-		if err := d.Set("clickhouse_connection_settings", []map[string]any{settings}); err != nil {
+		if err := conn.Set(d, settings); err != nil {
 			return err
 		}
 	default:
@@ -0,0 +1,129 @@
+package internal
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceData is implemented by both schema.ResourceData and schema.ResourceDiff.
+type resourceData interface {
+	Get(key string) any
+	GetOk(key string) (any, bool)
+	GetChange(key string) (any, any)
+	HasChange(key string) bool
+}
+
+// ConnectionSettings locates the connection settings of a Data Source in a resource. They are nested in a block in
+// `propel_data_source`, and are top-level attributes of the dedicated Data Source resources.
+type ConnectionSettings struct {
+	// Key is the block holding the settings. It is empty when the settings are top-level attributes.
+	Key string
+	// Attributes maps the name of each setting to the top-level attribute holding it.
+	Attributes map[string]string
+}
+
+// NestedSettings returns the connection settings held by the given block.
+func NestedSettings(key string) ConnectionSettings {
+	return ConnectionSettings{Key: key}
+}
+
+// FlatSettings returns the connection settings of the given block schema as top-level attributes. They keep their
+// name, unless it is renamed to avoid a conflict with another attribute.
+func FlatSettings(settingsSchema *schema.Schema, renamed map[string]string) ConnectionSettings {
+	elem := settingsSchema.Elem.(*schema.Resource)
+	attributes := make(map[string]string, len(elem.Schema))
+
+	for name := range elem.Schema {
+		attributes[name] = name
+		if attribute, ok := renamed[name]; ok {
+			attributes[name] = attribute
+		}
+	}
+
+	return ConnectionSettings{Attributes: attributes}
+}
+
+// Attribute returns the key of the given setting.
+func (cs ConnectionSettings) Attribute(name string) string {
+	if cs.Key != "" {
+		return cs.Key + ".0." + name
+	}
+
+	return cs.Attributes[name]
+}
+
+// Get returns the settings, and whether they are set. Top-level settings are always set.
+func (cs ConnectionSettings) Get(d resourceData) (map[string]any, bool) {
+	if cs.Key != "" {
+		v, ok := d.GetOk(cs.Key + ".0")
+		if !ok {
+			return nil, false
+		}
+
+		settings, ok := v.(map[string]any)
+		return settings, ok
+	}
+
+	settings := make(map[string]any, len(cs.Attributes))
+	for name, attribute := range cs.Attributes {
+		settings[name] = d.Get(attribute)
+	}
+
+	return settings, true
+}
+
+// GetChange returns the previous and the new settings. Either is nil when the settings are not set.
+func (cs ConnectionSettings) GetChange(d resourceData) (map[string]any, map[string]any) {
+	if cs.Key != "" {
+		oldItem, newItem := d.GetChange(cs.Key)
+		return firstSettings(oldItem), firstSettings(newItem)
+	}
+
+	oldSettings := make(map[string]any, len(cs.Attributes))
+	newSettings := make(map[string]any, len(cs.Attributes))
+
+	for name, attribute := range cs.Attributes {
+		oldSettings[name], newSettings[name] = d.GetChange(attribute)
+	}
+
+	return oldSettings, newSettings
+}
+
+// HasChange reports whether any of the settings changed.
+func (cs ConnectionSettings) HasChange(d resourceData) bool {
+	if cs.Key != "" {
+		return d.HasChange(cs.Key)
+	}
+
+	for _, attribute := range cs.Attributes {
+		if d.HasChange(attribute) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Set stores the settings. The settings missing from the given map are cleared.
+func (cs ConnectionSettings) Set(d *schema.ResourceData, settings map[string]any) error {
+	if cs.Key != "" {
+		return d.Set(cs.Key, []map[string]any{settings})
+	}
+
+	for name, attribute := range cs.Attributes {
+		if err := d.Set(attribute, settings[name]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func firstSettings(item any) map[string]any {
+	def, ok := item.([]any)
+	if !ok || len(def) == 0 {
+		return nil
+	}
+
+	settings, _ := def[0].(map[string]any)
+	return settings
+}
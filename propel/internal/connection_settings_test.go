@@ -0,0 +1,75 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ConnectionSettings(t *testing.T) {
+	settingsSchema := SnowflakeDataSourceSchema()
+	settings := map[string]any{
+		"account":   "znXXXXX.us-east-2.aws",
+		"database":  "DB",
+		"warehouse": "PROPELLING",
+		"schema":    "PUBLIC",
+		"role":      "PROPELLER",
+		"username":  "PROPEL",
+		"password":  "secret",
+	}
+
+	flat := FlatSettings(settingsSchema, map[string]string{"account": "snowflake_account"})
+	flatSchema := map[string]*schema.Schema{}
+	for name, attr := range settingsSchema.Elem.(*schema.Resource).Schema {
+		flatSchema[flat.Attribute(name)] = attr
+	}
+
+	tests := []struct {
+		name   string
+		conn   ConnectionSettings
+		schema map[string]*schema.Schema
+		raw    map[string]any
+	}{
+		{
+			name:   "Nested in a block",
+			conn:   NestedSettings("snowflake_connection_settings"),
+			schema: map[string]*schema.Schema{"snowflake_connection_settings": settingsSchema},
+			raw:    map[string]any{"snowflake_connection_settings": []any{settings}},
+		},
+		{
+			name:   "Top-level attributes",
+			conn:   flat,
+			schema: flatSchema,
+			raw: map[string]any{
+				"snowflake_account": "znXXXXX.us-east-2.aws",
+				"database":          "DB",
+				"warehouse":         "PROPELLING",
+				"schema":            "PUBLIC",
+				"role":              "PROPELLER",
+				"username":          "PROPEL",
+				"password":          "secret",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(st *testing.T) {
+			a := assert.New(st)
+
+			d := schema.TestResourceDataRaw(st, tt.schema, tt.raw)
+
+			got, ok := tt.conn.Get(d)
+			a.True(ok)
+			a.Equal(settings, got)
+			a.True(tt.conn.HasChange(d))
+
+			a.NoError(tt.conn.Set(d, map[string]any{"account": "ffXXXXX.us-central1.gcp", "database": "OTHER"}))
+
+			got, _ = tt.conn.Get(d)
+			a.Equal("ffXXXXX.us-central1.gcp", got["account"])
+			a.Equal("OTHER", got["database"])
+			a.Equal("", got["warehouse"], "the settings missing from the map should be cleared")
+		})
+	}
+}
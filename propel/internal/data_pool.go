@@ -3,17 +3,18 @@ package internal
 import (
 	"context"
 	"fmt"
-	"strings"
+	"strconv"
 	"time"
 
 	"github.com/Khan/genqlient/graphql"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 
 	pc "github.com/propeldata/terraform-provider-propel/propel_client"
 )
 
+// WaitForDataPoolLive waits for a Data Pool to be LIVE, failing early if its setup failed.
 func WaitForDataPoolLive(ctx context.Context, client graphql.Client, id string, timeout time.Duration) error {
-	createStateConf := &retry.StateChangeConf{
+	waiter := &Waiter[*pc.DataPoolData]{
+		Name: "Data Pool",
 		Pending: []string{
 			string(pc.DataPoolStatusCreated),
 			string(pc.DataPoolStatusPending),
@@ -21,52 +22,281 @@ func WaitForDataPoolLive(ctx context.Context, client graphql.Client, id string,
 		Target: []string{
 			string(pc.DataPoolStatusLive),
 		},
-		Refresh: func() (any, string, error) {
+		Failed: []string{
+			string(pc.DataPoolStatusSetupFailed),
+		},
+		Refresh: func(ctx context.Context) (Observation[*pc.DataPoolData], error) {
 			resp, err := pc.DataPool(ctx, client, id)
 			if err != nil {
-				return 0, "", fmt.Errorf("error trying to read Data Pool status: %s", err)
+				return Observation[*pc.DataPoolData]{}, fmt.Errorf("error trying to read Data Pool status: %w", err)
+			}
+
+			if resp.DataPool == nil {
+				return Observation[*pc.DataPoolData]{}, fmt.Errorf("Data Pool \"%s\" not found", id)
+			}
+
+			observation := Observation[*pc.DataPoolData]{
+				Value: &resp.DataPool.DataPoolData,
+				State: string(resp.DataPool.Status),
+			}
+			if resp.DataPool.Error != nil {
+				observation.Message = resp.DataPool.Error.Message
 			}
 
-			return resp, string(resp.DataPool.Status), nil
+			return observation, nil
 		},
-		Timeout:                   timeout - time.Minute,
-		Delay:                     10 * time.Second,
-		MinTimeout:                5 * time.Second,
-		ContinuousTargetOccurence: 3,
+		// The Data Pool may briefly be reported LIVE while it is still being set up.
+		Confirmations: 3,
+		Timeout:       WaitTimeout(timeout),
+		PollInterval:  PollInterval(client),
 	}
 
-	_, err := createStateConf.WaitForStateContext(ctx)
-	if err != nil {
-		return fmt.Errorf("error waiting for Data Pool to be LIVE: %s", err)
+	_, err := waiter.Wait(ctx)
+	return err
+}
+
+// WaitForDataPoolDeletion waits for a Data Pool to no longer be found.
+func WaitForDataPoolDeletion(ctx context.Context, client graphql.Client, id string, timeout time.Duration) error {
+	waiter := &Waiter[*pc.DataPoolData]{
+		Name: "Data Pool deletion",
+		Pending: []string{
+			string(pc.DataPoolStatusCreated),
+			string(pc.DataPoolStatusPending),
+			string(pc.DataPoolStatusLive),
+			string(pc.DataPoolStatusSetupFailed),
+			string(pc.DataPoolStatusConnecting),
+			string(pc.DataPoolStatusConnected),
+			string(pc.DataPoolStatusBroken),
+			string(pc.DataPoolStatusPausing),
+			string(pc.DataPoolStatusPaused),
+			string(pc.DataPoolStatusDeleting),
+		},
+		Target: []string{
+			StateDeleted,
+		},
+		Refresh: func(ctx context.Context) (Observation[*pc.DataPoolData], error) {
+			resp, err := pc.DataPool(ctx, client, id)
+			if pc.IsNotFound(err) || (err == nil && resp.DataPool == nil) {
+				return Observation[*pc.DataPoolData]{State: StateDeleted}, nil
+			}
+
+			if err != nil {
+				return Observation[*pc.DataPoolData]{}, fmt.Errorf("error trying to read Data Pool: %w", err)
+			}
+
+			return Observation[*pc.DataPoolData]{
+				Value: &resp.DataPool.DataPoolData,
+				State: string(resp.DataPool.Status),
+			}, nil
+		},
+		Timeout:      WaitTimeout(timeout),
+		PollInterval: PollInterval(client),
 	}
 
-	return nil
+	_, err := waiter.Wait(ctx)
+	return err
 }
 
-func WaitForDataPoolDeletion(ctx context.Context, client graphql.Client, id string, timeout time.Duration) error {
-	tickerInterval := 10 // 10s
-	timeoutSeconds := int(timeout.Seconds())
-	n := 0
-
-	ticker := time.NewTicker(time.Duration(tickerInterval) * time.Second)
-	for range ticker.C {
-		if n*tickerInterval > timeoutSeconds {
-			ticker.Stop()
+// WaitForDataPoolBackfill waits until the Data Pool holds as many records as the Data Pool that the given Materialized
+// View backfills it from. Both are counted on every attempt, so the records ingested into the source while the
+// backfill runs are waited for too. It fails early if the Materialized View is deleted or the Data Pool is no longer
+// LIVE, since the backfill would then never complete.
+func WaitForDataPoolBackfill(ctx context.Context, client graphql.Client, id string, sourceId string, materializedViewId string, timeout time.Duration) error {
+	waiter := &Waiter[int64]{
+		Name: "Data Pool backfill",
+		Pending: []string{
+			"BACKFILLING",
+		},
+		Target: []string{
+			"BACKFILLED",
+		},
+		Failed: []string{
+			string(pc.DataPoolStatusSetupFailed),
+			string(pc.DataPoolStatusDeleting),
+		},
+		Refresh: func(ctx context.Context) (Observation[int64], error) {
+			mv, err := pc.MaterializedView(ctx, client, materializedViewId)
+			if err != nil && !pc.IsNotFound(err) {
+				return Observation[int64]{}, fmt.Errorf("error trying to read the Materialized View backfilling the Data Pool: %w", err)
+			}
+
+			if err != nil || mv.MaterializedView == nil {
+				return Observation[int64]{}, fmt.Errorf("the Materialized View \"%s\" backfilling the Data Pool was deleted", materializedViewId)
+			}
+
+			resp, err := pc.DataPool(ctx, client, id)
+			if err != nil {
+				return Observation[int64]{}, fmt.Errorf("error trying to read Data Pool record count: %w", err)
+			}
+
+			if resp.DataPool == nil {
+				return Observation[int64]{}, fmt.Errorf("Data Pool \"%s\" %w", id, pc.ErrNotFound)
+			}
+
+			if resp.DataPool.Status != pc.DataPoolStatusLive {
+				observation := Observation[int64]{State: string(resp.DataPool.Status)}
+				if resp.DataPool.Error != nil {
+					observation.Message = resp.DataPool.Error.Message
+				}
+
+				return observation, nil
+			}
+
+			count, err := parseRecordCount(resp.DataPool.RecordCount)
+			if err != nil {
+				return Observation[int64]{}, err
+			}
+
+			records, err := DataPoolRecordCount(ctx, client, sourceId)
+			if err != nil {
+				return Observation[int64]{}, err
+			}
+
+			if count < records {
+				return Observation[int64]{Value: count, State: "BACKFILLING"}, nil
+			}
+
+			return Observation[int64]{Value: count, State: "BACKFILLED"}, nil
+		},
+		Timeout:      WaitTimeout(timeout),
+		PollInterval: PollInterval(client),
+	}
+
+	_, err := waiter.Wait(ctx)
+	return err
+}
+
+// dependentsPageSize is how many Metrics, Data Pool Access Policies and Materialized Views are read at a time when
+// looking for the dependents of a Data Pool.
+const dependentsPageSize = 100
+
+// DataPoolDependents returns the Metrics powered by the Data Pool, its Data Pool Access Policies and the Materialized
+// Views that read from or write into it, described by their kind and unique name.
+func DataPoolDependents(ctx context.Context, client graphql.Client, id string) ([]string, error) {
+	dependents := make([]string, 0)
+	first := dependentsPageSize
+	var after *string
+
+	for {
+		resp, err := pc.DataPoolMetrics(ctx, client, id, &first, after)
+		if err != nil {
+			return nil, fmt.Errorf("error trying to read Data Pool Metrics: %w", err)
+		}
+
+		if resp.DataPool == nil {
+			return nil, fmt.Errorf("Data Pool \"%s\" %w", id, pc.ErrNotFound)
+		}
+
+		if resp.DataPool.Metrics == nil {
 			break
 		}
 
-		_, err := pc.DataPool(ctx, client, id)
+		for _, metric := range resp.DataPool.Metrics.Nodes {
+			dependents = append(dependents, fmt.Sprintf(`Metric "%s"`, metric.UniqueName))
+		}
+
+		pageInfo := resp.DataPool.Metrics.PageInfo
+		if pageInfo == nil || !pageInfo.GetHasNextPage() || pageInfo.GetEndCursor() == nil {
+			break
+		}
+
+		after = pageInfo.GetEndCursor()
+	}
+
+	after = nil
+
+	for {
+		resp, err := pc.DataPoolAccessPolicies(ctx, client, id, &first, after)
 		if err != nil {
-			ticker.Stop()
+			return nil, fmt.Errorf("error trying to read Data Pool Access Policies: %w", err)
+		}
 
-			if strings.Contains(err.Error(), "not found") {
-				return nil
+		if resp.DataPool == nil {
+			return nil, fmt.Errorf("Data Pool \"%s\" %w", id, pc.ErrNotFound)
+		}
+
+		if resp.DataPool.DataPoolAccessPolicies == nil {
+			break
+		}
+
+		for _, policy := range resp.DataPool.DataPoolAccessPolicies.Nodes {
+			dependents = append(dependents, fmt.Sprintf(`Data Pool Access Policy "%s"`, policy.UniqueName))
+		}
+
+		pageInfo := resp.DataPool.DataPoolAccessPolicies.PageInfo
+		if pageInfo == nil || !pageInfo.GetHasNextPage() || pageInfo.GetEndCursor() == nil {
+			break
+		}
+
+		after = pageInfo.GetEndCursor()
+	}
+
+	after = nil
+
+	for {
+		resp, err := pc.MaterializedViews(ctx, client, &first, nil, after, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error trying to read Materialized Views: %w", err)
+		}
+
+		for _, mv := range resp.MaterializedViews.Nodes {
+			if usesDataPool(&mv.MaterializedViewData, id) {
+				dependents = append(dependents, fmt.Sprintf(`Materialized View "%s"`, mv.UniqueName))
 			}
+		}
+
+		pageInfo := resp.MaterializedViews.PageInfo
+		if pageInfo == nil || !pageInfo.GetHasNextPage() || pageInfo.GetEndCursor() == nil {
+			break
+		}
+
+		after = pageInfo.GetEndCursor()
+	}
+
+	return dependents, nil
+}
+
+func usesDataPool(mv *pc.MaterializedViewData, id string) bool {
+	if mv.Destination != nil && mv.Destination.Id == id {
+		return true
+	}
+
+	if mv.Source != nil && mv.Source.Id == id {
+		return true
+	}
 
-			return fmt.Errorf("error trying to fetch Data Pool: %s", err)
+	for _, other := range mv.Others {
+		if other != nil && other.Id == id {
+			return true
 		}
+	}
+
+	return false
+}
+
+// DataPoolRecordCount returns the number of records in the Data Pool.
+func DataPoolRecordCount(ctx context.Context, client graphql.Client, id string) (int64, error) {
+	resp, err := pc.DataPool(ctx, client, id)
+	if err != nil {
+		return 0, fmt.Errorf("error trying to read Data Pool record count: %w", err)
+	}
+
+	if resp.DataPool == nil {
+		return 0, pc.ErrNotFound
+	}
+
+	return parseRecordCount(resp.DataPool.RecordCount)
+}
 
-		n++
+func parseRecordCount(recordCount *string) (int64, error) {
+	if recordCount == nil || *recordCount == "" {
+		return 0, nil
 	}
-	return nil
+
+	count, err := strconv.ParseInt(*recordCount, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid Data Pool record count %q: %s", *recordCount, err)
+	}
+
+	return count, nil
 }
@@ -0,0 +1,146 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	backfilledDataPoolId = "DPO00000000000000000000000001"
+	sourceDataPoolId     = "DPO00000000000000000000000002"
+	backfillMVId         = "MAT00000000000000000000000001"
+)
+
+// fakeBackfillClient backfills a Data Pool by one record every time it is read, while one record is ingested into
+// its source every other time the source is read, up to the given number of records.
+type fakeBackfillClient struct {
+	records   int
+	ingested  int
+	status    string
+	mvDeleted bool
+
+	reads  int
+	copied int
+}
+
+func (c *fakeBackfillClient) PollInterval() time.Duration {
+	return time.Millisecond
+}
+
+func (c *fakeBackfillClient) MakeRequest(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+	var data string
+
+	switch req.OpName {
+	case "MaterializedView":
+		data = fmt.Sprintf(`{"materializedView": {"id": "%s"}}`, backfillMVId)
+		if c.mvDeleted {
+			data = `{"materializedView": null}`
+		}
+	case "DataPool":
+		id := req.Variables.(interface{ GetId() string }).GetId()
+
+		if id == backfilledDataPoolId {
+			c.copied = min(c.copied+1, c.records)
+		} else {
+			c.reads++
+			if c.ingested > 0 && c.reads%2 == 0 {
+				c.ingested--
+				c.records++
+			}
+		}
+
+		count := c.records
+		if id == backfilledDataPoolId {
+			count = c.copied
+		}
+
+		data = fmt.Sprintf(`{"dataPool": {"id": "%s", "status": "%s", "recordCount": "%d"}}`, id, c.status, count)
+	default:
+		return fmt.Errorf("unexpected operation %s", req.OpName)
+	}
+
+	return json.Unmarshal([]byte(data), resp.Data)
+}
+
+func Test_WaitForDataPoolBackfill(t *testing.T) {
+	tests := []struct {
+		name          string
+		client        *fakeBackfillClient
+		expectedCount int
+		expectedError string
+	}{
+		{
+			name:          "Backfilled",
+			client:        &fakeBackfillClient{records: 3, status: "LIVE"},
+			expectedCount: 3,
+		},
+		{
+			name:          "Records ingested during the backfill",
+			client:        &fakeBackfillClient{records: 3, ingested: 2, status: "LIVE"},
+			expectedCount: 5,
+		},
+		{
+			name:          "Materialized View deleted",
+			client:        &fakeBackfillClient{records: 3, status: "LIVE", mvDeleted: true},
+			expectedError: fmt.Sprintf(`the Materialized View "%s" backfilling the Data Pool was deleted`, backfillMVId),
+		},
+		{
+			name:          "Data Pool not live",
+			client:        &fakeBackfillClient{records: 3, status: "SETUP_FAILED"},
+			expectedError: "Data Pool backfill is SETUP_FAILED: unknown error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(st *testing.T) {
+			a := assert.New(st)
+
+			err := WaitForDataPoolBackfill(context.Background(), tt.client, backfilledDataPoolId, sourceDataPoolId, backfillMVId, 2*time.Minute)
+			if tt.expectedError != "" {
+				a.EqualError(err, tt.expectedError)
+				return
+			}
+
+			a.NoError(err)
+			a.Equal(tt.expectedCount, tt.client.copied)
+		})
+	}
+}
+
+// fakeDependentsClient serves a Data Pool with a Metric and an Access Policy, and two Materialized Views, one of which
+// reads from the Data Pool.
+type fakeDependentsClient struct{}
+
+func (c *fakeDependentsClient) MakeRequest(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+	var data string
+
+	switch req.OpName {
+	case "DataPoolMetrics":
+		data = fmt.Sprintf(`{"dataPool": {"id": "%s", "metrics": {"pageInfo": {"hasNextPage": false}, "nodes": [{"id": "MET00000000000000000000000001", "uniqueName": "revenue"}]}}}`, sourceDataPoolId)
+	case "DataPoolAccessPolicies":
+		data = fmt.Sprintf(`{"dataPool": {"id": "%s", "dataPoolAccessPolicies": {"pageInfo": {"hasNextPage": false}, "nodes": [{"id": "POL00000000000000000000000001", "uniqueName": "tenant"}]}}}`, sourceDataPoolId)
+	case "MaterializedViews":
+		data = fmt.Sprintf(`{"materializedViews": {"pageInfo": {"hasNextPage": false}, "nodes": [
+			{"id": "MAT00000000000000000000000002", "uniqueName": "daily", "destination": {"id": "%s"}, "source": {"id": "%s"}, "others": []},
+			{"id": "MAT00000000000000000000000003", "uniqueName": "other", "destination": {"id": "%s"}, "source": {"id": "%s"}, "others": []}
+		]}}`, backfilledDataPoolId, sourceDataPoolId, backfilledDataPoolId, "DPO00000000000000000000000003")
+	default:
+		return fmt.Errorf("unexpected operation %s", req.OpName)
+	}
+
+	return json.Unmarshal([]byte(data), resp.Data)
+}
+
+func Test_DataPoolDependents(t *testing.T) {
+	a := assert.New(t)
+
+	dependents, err := DataPoolDependents(context.Background(), &fakeDependentsClient{}, sourceDataPoolId)
+	a.NoError(err)
+	a.Equal([]string{`Metric "revenue"`, `Data Pool Access Policy "tenant"`, `Materialized View "daily"`}, dependents)
+}
@@ -15,7 +15,7 @@ func HttpDataSourceSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:          schema.TypeList,
 		Optional:      true,
-		ConflictsWith: []string{"snowflake_connection_settings", "s3_connection_settings", "webhook_connection_settings", "kafka_connection_settings", "clickhouse_connection_settings"},
+		ConflictsWith: []string{"snowflake_connection_settings", "s3_connection_settings", "webhook_connection_settings", "kafka_connection_settings", "clickhouse_connection_settings", "postgresql_connection_settings"},
 		MaxItems:      1,
 		Elem: &schema.Resource{
 			Description: "HTTP connection settings. Specify these for HTTP Data Sources.",
@@ -26,7 +26,7 @@ func HttpDataSourceSchema() *schema.Schema {
 	}
 }
 
-func HttpDataSourceCreate(ctx context.Context, d *schema.ResourceData, c graphql.Client) (string, error) {
+func HttpDataSourceCreate(ctx context.Context, d *schema.ResourceData, c graphql.Client, conn ConnectionSettings) (string, error) {
 	input := &pc.CreateHttpDataSourceInput{}
 
 	if v, ok := d.GetOk("unique_name"); ok && v.(string) != "" {
@@ -45,8 +45,7 @@ func HttpDataSourceCreate(ctx context.Context, d *schema.ResourceData, c graphql
 		connectionSettingsInput.Tables = expandHttpTables(def.([]any))
 	}
 
-	if v, ok := d.GetOk("http_connection_settings.0"); ok {
-		connectionSettings := v.(map[string]any)
+	if connectionSettings, ok := conn.Get(d); ok {
 
 		if def, ok := connectionSettings["basic_auth"]; ok && len(def.([]any)) > 0 {
 			connectionSettingsInput.BasicAuth = expandBasicAuth(def.([]any))
@@ -63,7 +62,7 @@ func HttpDataSourceCreate(ctx context.Context, d *schema.ResourceData, c graphql
 	return response.CreateHttpDataSource.DataSource.Id, nil
 }
 
-func HttpDataSourceUpdate(ctx context.Context, d *schema.ResourceData, c graphql.Client) error {
+func HttpDataSourceUpdate(ctx context.Context, d *schema.ResourceData, c graphql.Client, conn ConnectionSettings) error {
 	id := d.Id()
 	input := &pc.ModifyHttpDataSourceInput{
 		IdOrUniqueName: &pc.IdOrUniqueName{Id: &id},
@@ -77,8 +76,8 @@ func HttpDataSourceUpdate(ctx context.Context, d *schema.ResourceData, c graphql
 		input.Description = &description
 	}
 
-	if d.HasChanges("table", "http_connection_settings") {
-		connectionSettings := d.Get("http_connection_settings.0").(map[string]any)
+	if d.HasChange("table") || conn.HasChange(d) {
+		connectionSettings, _ := conn.Get(d)
 
 		var basicAuth *pc.HttpBasicAuthInput
 		if def, ok := connectionSettings["basic_auth"]; ok {
@@ -103,8 +102,8 @@ func HttpDataSourceUpdate(ctx context.Context, d *schema.ResourceData, c graphql
 	return nil
 }
 
-func HandleHttpConnectionSettings(response *pc.DataSourceResponse, d *schema.ResourceData) error {
-	if _, exists := d.GetOk("http_connection_settings.0"); !exists {
+func HandleHttpConnectionSettings(response *pc.DataSourceResponse, d *schema.ResourceData, conn ConnectionSettings) error {
+	if _, exists := conn.Get(d); !exists {
 		return nil
 	}
 
@@ -123,7 +122,7 @@ func HandleHttpConnectionSettings(response *pc.DataSourceResponse, d *schema.Res
 			}
 		}
 
-		if err := d.Set("http_connection_settings", []map[string]any{settings}); err != nil {
+		if err := conn.Set(d, settings); err != nil {
 			return err
 		}
 
@@ -2,48 +2,294 @@ package internal
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/Khan/genqlient/graphql"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 
 	pc "github.com/propeldata/terraform-provider-propel/propel_client"
 )
 
-func WaitForAddColumnJobSucceeded(ctx context.Context, client graphql.Client, id string, timeout time.Duration) error {
-	createStateConf := &retry.StateChangeConf{
+// DefaultMaxParallelJobs is how many Jobs run at once when the client does not set a limit.
+const DefaultMaxParallelJobs = 4
+
+// jobsPageSize is the number of Jobs fetched per page when listing the pending Jobs.
+const jobsPageSize = 100
+
+// MaxParallelJobs returns how many Jobs run at once with the given client. The provider's client sets it with
+// the `max_parallel_jobs` setting.
+func MaxParallelJobs(client graphql.Client) int {
+	if c, ok := client.(interface{ MaxParallelJobs() int }); ok && c.MaxParallelJobs() > 0 {
+		return c.MaxParallelJobs()
+	}
+
+	return DefaultMaxParallelJobs
+}
+
+// AddColumnsError lists the columns that could not be added to a Data Pool, along with the reason why.
+type AddColumnsError struct {
+	// Failed maps each column that could not be added to its Job's error.
+	Failed map[string]string
+}
+
+func (e *AddColumnsError) Error() string {
+	columns := make([]string, 0, len(e.Failed))
+	for column := range e.Failed {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+
+	if len(columns) == 1 {
+		return fmt.Sprintf(`failed to add column "%s": %s`, columns[0], e.Failed[columns[0]])
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "failed to add %d columns:", len(columns))
+	for _, column := range columns {
+		fmt.Fprintf(&b, "\n  - \"%s\": %s", column, e.Failed[column])
+	}
+
+	return b.String()
+}
+
+// AddColumnsToDataPool adds columns to a Data Pool with one AddColumnToDataPoolJob per column. Up to
+// MaxParallelJobs Jobs run at once, and they are all tracked together until they complete or the timeout expires.
+// The columns that could not be added are reported together in an AddColumnsError.
+func AddColumnsToDataPool(ctx context.Context, client graphql.Client, inputs []*pc.CreateAddColumnToDataPoolJobInput, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	parallelism := MaxParallelJobs(client)
+	queue := inputs
+	running := map[string]string{}
+	failed := map[string]string{}
+
+	for len(queue) > 0 || len(running) > 0 {
+		n := min(parallelism-len(running), len(queue))
+
+		for _, job := range submitAddColumnJobs(ctx, client, queue[:n]) {
+			if job.err != nil {
+				failed[job.column] = job.err.Error()
+				continue
+			}
+
+			running[job.id] = job.column
+		}
+
+		queue = queue[n:]
+
+		if len(running) == 0 {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			for _, column := range running {
+				failed[column] = "the Job did not complete before the timeout"
+			}
+
+			for _, input := range queue {
+				failed[input.ColumnName] = "the Job was not started before the timeout"
+			}
+
+			return &AddColumnsError{Failed: failed}
+		case <-time.After(PollInterval(client)):
+		}
+
+		completed, err := completedAddColumnJobs(ctx, client, running)
+		if err != nil {
+			return err
+		}
+
+		for id, message := range completed {
+			if message != "" {
+				failed[running[id]] = message
+			}
+
+			delete(running, id)
+		}
+	}
+
+	if len(failed) > 0 {
+		return &AddColumnsError{Failed: failed}
+	}
+
+	return nil
+}
+
+type submittedJob struct {
+	column string
+	id     string
+	err    error
+}
+
+// submitAddColumnJobs creates the AddColumnToDataPoolJobs concurrently.
+func submitAddColumnJobs(ctx context.Context, client graphql.Client, inputs []*pc.CreateAddColumnToDataPoolJobInput) []submittedJob {
+	jobs := make([]submittedJob, len(inputs))
+
+	var wg sync.WaitGroup
+	for i, input := range inputs {
+		wg.Add(1)
+
+		go func(i int, input *pc.CreateAddColumnToDataPoolJobInput) {
+			defer wg.Done()
+
+			jobs[i].column = input.ColumnName
+
+			response, err := pc.CreateAddColumnToDataPoolJob(ctx, client, input)
+			if err != nil {
+				jobs[i].err = err
+				return
+			}
+
+			jobs[i].id = response.CreateAddColumnToDataPoolJob.Job.Id
+		}(i, input)
+	}
+
+	wg.Wait()
+
+	return jobs
+}
+
+// completedAddColumnJobs returns which of the running Jobs completed, mapped to their error, or to an empty string
+// if they succeeded. The pending Jobs are listed by status, so only the Jobs that are no longer pending are read
+// one by one.
+func completedAddColumnJobs(ctx context.Context, client graphql.Client, running map[string]string) (map[string]string, error) {
+	pending := map[string]bool{}
+
+	for _, status := range []pc.JobStatus{pc.JobStatusCreated, pc.JobStatusInProgress} {
+		first := jobsPageSize
+		var after *string
+
+		for {
+			response, err := pc.AddColumnToDataPoolJobByStatus(ctx, client, status, &first, after)
+			if err != nil {
+				return nil, fmt.Errorf("error trying to list %s Add Column Jobs: %s", status, err)
+			}
+
+			for _, node := range response.AddColumnToDataPoolJobByStatus.Nodes {
+				pending[node.Id] = true
+			}
+
+			pageInfo := response.AddColumnToDataPoolJobByStatus.PageInfo
+			if pageInfo == nil || !pageInfo.GetHasNextPage() || pageInfo.GetEndCursor() == nil {
+				break
+			}
+
+			after = pageInfo.GetEndCursor()
+		}
+	}
+
+	completed := map[string]string{}
+
+	for id := range running {
+		if pending[id] {
+			continue
+		}
+
+		response, err := pc.AddColumnToDataPoolJob(ctx, client, id)
+		if err != nil {
+			return nil, fmt.Errorf("error trying to read Add Column Job status: %s", err)
+		}
+
+		job := response.AddColumnToDataPoolJob
+		if job == nil {
+			return nil, errors.New("Add Column Job \"" + id + "\" not found")
+		}
+
+		switch job.Status {
+		case pc.JobStatusSucceeded:
+			completed[id] = ""
+		case pc.JobStatusFailed:
+			completed[id] = "unknown error"
+			if job.Error != nil {
+				completed[id] = job.Error.Message
+			}
+		}
+	}
+
+	return completed, nil
+}
+
+// JobState is the state of a Job, whatever its kind.
+type JobState struct {
+	Status pc.JobStatus
+	// Error is the Job's error message, set when it failed.
+	Error string
+}
+
+// WaitForJob waits for a Job to succeed, reading it with the read function. The name of the kind of Job, e.g.
+// "Deletion Job", is used in the errors. It returns the Job as last read, along with an error if it failed.
+func WaitForJob[T any](ctx context.Context, client graphql.Client, name string, read func(ctx context.Context) (T, JobState, error), timeout time.Duration) (T, error) {
+	waiter := &Waiter[T]{
+		Name: name,
 		Pending: []string{
 			string(pc.JobStatusCreated),
 			string(pc.JobStatusInProgress),
 		},
 		Target: []string{
 			string(pc.JobStatusSucceeded),
+		},
+		Failed: []string{
 			string(pc.JobStatusFailed),
 		},
-		Refresh: func() (any, string, error) {
-			resp, err := pc.AddColumnToDataPoolJob(ctx, client, id)
+		Refresh: func(ctx context.Context) (Observation[T], error) {
+			job, state, err := read(ctx)
 			if err != nil {
-				return 0, "", fmt.Errorf("error trying to read Add Column Job status: %s", err)
+				return Observation[T]{}, fmt.Errorf("error trying to read %s status: %w", name, err)
 			}
 
-			return resp, string(resp.AddColumnToDataPoolJob.Status), nil
+			return Observation[T]{Value: job, State: string(state.Status), Message: state.Error}, nil
 		},
-		Timeout:                   timeout - time.Minute,
-		Delay:                     10 * time.Second,
-		MinTimeout:                5 * time.Second,
-		ContinuousTargetOccurence: 3,
+		Timeout:      WaitTimeout(timeout),
+		PollInterval: PollInterval(client),
 	}
 
-	resp, err := createStateConf.WaitForStateContext(ctx)
-	if err != nil {
-		return fmt.Errorf("error waiting for Add Column Job to succeed: %s", err)
-	}
+	return waiter.Wait(ctx)
+}
 
-	addColumnJobResponse, ok := resp.(*pc.AddColumnToDataPoolJobResponse)
-	if !ok || addColumnJobResponse.AddColumnToDataPoolJob.Status == pc.JobStatusFailed {
-		return fmt.Errorf("add column job failed: %s", addColumnJobResponse.AddColumnToDataPoolJob.Error.Message)
-	}
+// WaitForDeletionJob waits for a Deletion Job to complete.
+func WaitForDeletionJob(ctx context.Context, client graphql.Client, id string, timeout time.Duration) (*pc.DeletionJobData, error) {
+	return WaitForJob(ctx, client, "Deletion Job", func(ctx context.Context) (*pc.DeletionJobData, JobState, error) {
+		resp, err := pc.DeletionJob(ctx, client, id)
+		if err != nil {
+			return nil, JobState{}, err
+		}
 
-	return nil
+		if resp.DeletionJob == nil {
+			return nil, JobState{}, fmt.Errorf("Deletion Job \"%s\" not found", id)
+		}
+
+		state := JobState{Status: resp.DeletionJob.Status}
+		if resp.DeletionJob.Error != nil {
+			state.Error = resp.DeletionJob.Error.Message
+		}
+
+		return &resp.DeletionJob.DeletionJobData, state, nil
+	}, timeout)
+}
+
+// WaitForUpdateDataPoolRecordsJob waits for an Update Data Pool Records Job to complete.
+func WaitForUpdateDataPoolRecordsJob(ctx context.Context, client graphql.Client, id string, timeout time.Duration) (*pc.UpdateDataPoolRecordsJobData, error) {
+	return WaitForJob(ctx, client, "Update Data Pool Records Job", func(ctx context.Context) (*pc.UpdateDataPoolRecordsJobData, JobState, error) {
+		resp, err := pc.UpdateDataPoolRecordsJob(ctx, client, id)
+		if err != nil {
+			return nil, JobState{}, err
+		}
+
+		if resp.UpdateDataPoolRecordsJob == nil {
+			return nil, JobState{}, fmt.Errorf("Update Data Pool Records Job \"%s\" not found", id)
+		}
+
+		state := JobState{Status: resp.UpdateDataPoolRecordsJob.Status}
+		if resp.UpdateDataPoolRecordsJob.Error != nil {
+			state.Error = resp.UpdateDataPoolRecordsJob.Error.Message
+		}
+
+		return &resp.UpdateDataPoolRecordsJob.UpdateDataPoolRecordsJobData, state, nil
+	}, timeout)
 }
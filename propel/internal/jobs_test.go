@@ -0,0 +1,152 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/stretchr/testify/assert"
+
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+// fakeJobsClient runs Add Column Jobs that complete after being listed as in progress once. The Jobs of the
+// columns prefixed with "bad_" fail.
+type fakeJobsClient struct {
+	parallelism  int
+	pollInterval time.Duration
+
+	mu         sync.Mutex
+	jobs       map[string]string
+	done       map[string]bool
+	maxRunning int
+}
+
+func (c *fakeJobsClient) MaxParallelJobs() int {
+	return c.parallelism
+}
+
+func (c *fakeJobsClient) PollInterval() time.Duration {
+	return c.pollInterval
+}
+
+func (c *fakeJobsClient) MakeRequest(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var data string
+
+	switch req.OpName {
+	case "CreateAddColumnToDataPoolJob":
+		input := req.Variables.(interface {
+			GetInput() *pc.CreateAddColumnToDataPoolJobInput
+		}).GetInput()
+
+		id := fmt.Sprintf("JOB%d", len(c.jobs))
+		c.jobs[id] = input.ColumnName
+
+		running := 0
+		for id := range c.jobs {
+			if !c.done[id] {
+				running++
+			}
+		}
+		c.maxRunning = max(c.maxRunning, running)
+
+		data = fmt.Sprintf(`{"createAddColumnToDataPoolJob": {"job": {"id": "%s", "status": "CREATED"}}}`, id)
+	case "AddColumnToDataPoolJobByStatus":
+		nodes := make([]string, 0)
+
+		if req.Variables.(interface{ GetStatus() pc.JobStatus }).GetStatus() == pc.JobStatusInProgress {
+			for id := range c.jobs {
+				if !c.done[id] {
+					nodes = append(nodes, fmt.Sprintf(`{"id": "%s"}`, id))
+					c.done[id] = true
+				}
+			}
+		}
+
+		data = fmt.Sprintf(`{"addColumnToDataPoolJobByStatus": {"pageInfo": {"hasNextPage": false}, "nodes": [%s]}}`, strings.Join(nodes, ", "))
+	case "AddColumnToDataPoolJob":
+		id := req.Variables.(interface{ GetId() string }).GetId()
+
+		status, jobErr := "SUCCEEDED", "null"
+		if strings.HasPrefix(c.jobs[id], "bad_") {
+			status, jobErr = "FAILED", `{"code": 400, "message": "invalid column type"}`
+		}
+
+		data = fmt.Sprintf(`{"addColumnToDataPoolJob": {"id": "%s", "status": "%s", "error": %s}}`, id, status, jobErr)
+	default:
+		return fmt.Errorf("unexpected operation %s", req.OpName)
+	}
+
+	return json.Unmarshal([]byte(data), resp.Data)
+}
+
+func addColumnInputs(columns ...string) []*pc.CreateAddColumnToDataPoolJobInput {
+	inputs := make([]*pc.CreateAddColumnToDataPoolJobInput, len(columns))
+	for i, column := range columns {
+		inputs[i] = &pc.CreateAddColumnToDataPoolJobInput{DataPool: "DPO00000000000000000000000000", ColumnName: column, ColumnType: pc.ColumnTypeString}
+	}
+
+	return inputs
+}
+
+func Test_AddColumnsToDataPool(t *testing.T) {
+	tests := []struct {
+		name          string
+		parallelism   int
+		columns       []string
+		expectedError string
+	}{
+		{
+			name:        "All columns added",
+			parallelism: 2,
+			columns:     []string{"a", "b", "c", "d", "e"},
+		},
+		{
+			name:          "One failed column",
+			parallelism:   4,
+			columns:       []string{"a", "bad_b", "c"},
+			expectedError: `failed to add column "bad_b": invalid column type`,
+		},
+		{
+			name:          "Several failed columns",
+			parallelism:   1,
+			columns:       []string{"bad_c", "a", "bad_b"},
+			expectedError: "failed to add 2 columns:\n  - \"bad_b\": invalid column type\n  - \"bad_c\": invalid column type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(st *testing.T) {
+			a := assert.New(st)
+
+			client := &fakeJobsClient{parallelism: tt.parallelism, pollInterval: time.Millisecond, jobs: map[string]string{}, done: map[string]bool{}}
+
+			err := AddColumnsToDataPool(context.Background(), client, addColumnInputs(tt.columns...), time.Minute)
+			if tt.expectedError != "" {
+				a.EqualError(err, tt.expectedError)
+			} else {
+				a.NoError(err)
+			}
+
+			a.Len(client.jobs, len(tt.columns))
+			a.LessOrEqual(client.maxRunning, tt.parallelism)
+		})
+	}
+}
+
+func Test_AddColumnsToDataPool_timeout(t *testing.T) {
+	a := assert.New(t)
+
+	client := &fakeJobsClient{parallelism: 1, pollInterval: time.Hour, jobs: map[string]string{}, done: map[string]bool{}}
+
+	err := AddColumnsToDataPool(context.Background(), client, addColumnInputs("a", "b"), time.Millisecond)
+	a.EqualError(err, "failed to add 2 columns:\n  - \"a\": the Job did not complete before the timeout\n  - \"b\": the Job was not started before the timeout")
+}
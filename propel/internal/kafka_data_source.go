@@ -16,7 +16,7 @@ func KafkaDataSourceSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:          schema.TypeList,
 		Optional:      true,
-		ConflictsWith: []string{"snowflake_connection_settings", "http_connection_settings", "s3_connection_settings", "webhook_connection_settings", "clickhouse_connection_settings"},
+		ConflictsWith: []string{"snowflake_connection_settings", "http_connection_settings", "s3_connection_settings", "webhook_connection_settings", "clickhouse_connection_settings", "postgresql_connection_settings"},
 		MaxItems:      1,
 		Elem: &schema.Resource{
 			Description: "The connection settings for a Kafka Data Source.",
@@ -59,7 +59,7 @@ func KafkaDataSourceSchema() *schema.Schema {
 	}
 }
 
-func KafkaDataSourceCreate(ctx context.Context, d *schema.ResourceData, c graphql.Client) (string, error) {
+func KafkaDataSourceCreate(ctx context.Context, d *schema.ResourceData, c graphql.Client, conn ConnectionSettings) (string, error) {
 	input := &pc.CreateKafkaDataSourceInput{}
 
 	if v, ok := d.GetOk("unique_name"); ok && v.(string) != "" {
@@ -72,8 +72,7 @@ func KafkaDataSourceCreate(ctx context.Context, d *schema.ResourceData, c graphq
 		input.Description = &description
 	}
 
-	if v, ok := d.GetOk("kafka_connection_settings.0"); ok {
-		connectionSettings := v.(map[string]any)
+	if connectionSettings, ok := conn.Get(d); ok {
 		tls := false
 
 		bootstrapServers := make([]string, 0)
@@ -104,7 +103,7 @@ func KafkaDataSourceCreate(ctx context.Context, d *schema.ResourceData, c graphq
 	return response.CreateKafkaDataSource.DataSource.Id, nil
 }
 
-func KafkaDataSourceUpdate(ctx context.Context, d *schema.ResourceData, c graphql.Client) error {
+func KafkaDataSourceUpdate(ctx context.Context, d *schema.ResourceData, c graphql.Client, conn ConnectionSettings) error {
 	id := d.Id()
 	input := &pc.ModifyKafkaDataSourceInput{
 		IdOrUniqueName: &pc.IdOrUniqueName{Id: &id},
@@ -118,8 +117,8 @@ func KafkaDataSourceUpdate(ctx context.Context, d *schema.ResourceData, c graphq
 		input.Description = &description
 	}
 
-	if d.HasChanges("kafka_connection_settings") {
-		connectionSettings := d.Get("kafka_connection_settings.0").(map[string]any)
+	if conn.HasChange(d) {
+		connectionSettings, _ := conn.Get(d)
 
 		bootstrapServers := make([]string, 0)
 		if v, exists := connectionSettings["bootstrap_servers"]; exists {
@@ -162,8 +161,8 @@ func KafkaDataSourceUpdate(ctx context.Context, d *schema.ResourceData, c graphq
 	return nil
 }
 
-func HandleKafkaConnectionSettings(response *pc.DataSourceResponse, d *schema.ResourceData) error {
-	if _, exists := d.GetOk("kafka_connection_settings.0"); !exists {
+func HandleKafkaConnectionSettings(response *pc.DataSourceResponse, d *schema.ResourceData, conn ConnectionSettings) error {
+	if _, exists := conn.Get(d); !exists {
 		return nil
 	}
 
@@ -177,7 +176,7 @@ func HandleKafkaConnectionSettings(response *pc.DataSourceResponse, d *schema.Re
 			"bootstrap_servers": s.GetBootstrapServers(),
 		}
 
-		if err := d.Set("kafka_connection_settings", []map[string]any{settings}); err != nil {
+		if err := conn.Set(d, settings); err != nil {
 			return err
 		}
 	default:
@@ -0,0 +1,183 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+func PostgreSqlDataSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		ConflictsWith: []string{"snowflake_connection_settings", "http_connection_settings", "s3_connection_settings", "webhook_connection_settings", "kafka_connection_settings", "clickhouse_connection_settings"},
+		MaxItems:      1,
+		Elem: &schema.Resource{
+			Description: "The connection settings for a PostgreSQL Data Source.",
+			Schema: map[string]*schema.Schema{
+				"host": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The host where PostgreSQL is listening.",
+				},
+				"port": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      5432,
+					Description:  "The port where PostgreSQL is listening.",
+					ValidateFunc: validation.IsPortNumber,
+				},
+				"database": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Which database to connect to.",
+				},
+				"schema": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "public",
+					Description: "Which schema to use.",
+				},
+				"user": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The user for authenticating against PostgreSQL.",
+				},
+				"password": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Sensitive:   true,
+					Description: "The password for the provided user.",
+				},
+			},
+		},
+	}
+}
+
+func PostgreSqlDataSourceCreate(ctx context.Context, d *schema.ResourceData, c graphql.Client, conn ConnectionSettings) (string, error) {
+	input := &pc.CreatePostgreSqlDataSourceInput{}
+
+	if v, ok := d.GetOk("unique_name"); ok && v.(string) != "" {
+		uniqueName := v.(string)
+		input.UniqueName = &uniqueName
+	}
+
+	if v, ok := d.GetOk("description"); ok && v.(string) != "" {
+		description := v.(string)
+		input.Description = &description
+	}
+
+	if connectionSettings, ok := conn.Get(d); ok {
+		port := connectionSettings["port"].(int)
+		database := connectionSettings["database"].(string)
+		schemaName := connectionSettings["schema"].(string)
+
+		input.ConnectionSettings = &pc.PostgreSqlConnectionSettingsInput{
+			Host:     connectionSettings["host"].(string),
+			Port:     &port,
+			Database: &database,
+			Schema:   &schemaName,
+			User:     connectionSettings["user"].(string),
+			Password: connectionSettings["password"].(string),
+		}
+	}
+
+	response, err := pc.CreatePostgreSqlDataSource(ctx, c, input)
+	if err != nil {
+		return "", fmt.Errorf("failed to create PostgreSQL Data Source: %w", err)
+	}
+
+	return response.CreatePostgreSqlDataSource.DataSource.Id, nil
+}
+
+func PostgreSqlDataSourceUpdate(ctx context.Context, d *schema.ResourceData, c graphql.Client, conn ConnectionSettings) error {
+	id := d.Id()
+	input := &pc.ModifyPostgreSqlDataSourceInput{
+		IdOrUniqueName: &pc.IdOrUniqueName{Id: &id},
+	}
+
+	if d.HasChanges("unique_name", "description") {
+		uniqueName := d.Get("unique_name").(string)
+		description := d.Get("description").(string)
+
+		input.UniqueName = &uniqueName
+		input.Description = &description
+	}
+
+	if conn.HasChange(d) {
+		connectionSettings, _ := conn.Get(d)
+		partialInput := &pc.PartialPostgreSqlConnectionSettingsInput{}
+
+		if v, ok := connectionSettings["host"]; ok && v.(string) != "" {
+			host := v.(string)
+			partialInput.Host = &host
+		}
+
+		if v, ok := connectionSettings["port"]; ok && v.(int) != 0 {
+			port := v.(int)
+			partialInput.Port = &port
+		}
+
+		if v, ok := connectionSettings["database"]; ok && v.(string) != "" {
+			database := v.(string)
+			partialInput.Database = &database
+		}
+
+		if v, ok := connectionSettings["schema"]; ok && v.(string) != "" {
+			schemaName := v.(string)
+			partialInput.Schema = &schemaName
+		}
+
+		if v, ok := connectionSettings["user"]; ok && v.(string) != "" {
+			user := v.(string)
+			partialInput.User = &user
+		}
+
+		if v, ok := connectionSettings["password"]; ok && v.(string) != "" {
+			password := v.(string)
+			partialInput.Password = &password
+		}
+
+		input.ConnectionSettings = partialInput
+	}
+
+	if _, err := pc.ModifyPostgreSqlDataSource(ctx, c, input); err != nil {
+		return fmt.Errorf("failed to modify PostgreSQL Data Source: %w", err)
+	}
+
+	return nil
+}
+
+func HandlePostgreSqlConnectionSettings(response *pc.DataSourceResponse, d *schema.ResourceData, conn ConnectionSettings) error {
+	if _, exists := conn.Get(d); !exists {
+		return nil
+	}
+
+	cs, _ := conn.Get(d)
+	settings := map[string]any{
+		"password": cs["password"],
+	}
+
+	switch s := response.DataSource.GetConnectionSettings().(type) {
+	case *pc.DataSourceDataConnectionSettingsPostgreSqlConnectionSettings:
+		settings["host"] = s.GetHost()
+		settings["port"] = s.GetPort()
+		settings["database"] = s.GetPostgreSqlDatabase()
+		settings["schema"] = s.GetPostgreSqlSchema()
+		settings["user"] = s.GetUser()
+
+		if err := conn.Set(d, settings); err != nil {
+			return err
+		}
+	default:
+		return errors.New("missing PostgreSqlConnectionSettings")
+	}
+
+	return nil
+}
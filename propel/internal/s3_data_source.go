@@ -15,7 +15,7 @@ func S3DataSourceSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:          schema.TypeList,
 		Optional:      true,
-		ConflictsWith: []string{"snowflake_connection_settings", "http_connection_settings", "webhook_connection_settings", "kafka_connection_settings", "clickhouse_connection_settings"},
+		ConflictsWith: []string{"snowflake_connection_settings", "http_connection_settings", "webhook_connection_settings", "kafka_connection_settings", "clickhouse_connection_settings", "postgresql_connection_settings"},
 		MaxItems:      1,
 		Elem: &schema.Resource{
 			Description: "The connection settings for an S3 Data Source. These include the S3 bucket name, the AWS access key ID, the AWS secret access key, and the tables (along with their paths).",
@@ -41,7 +41,7 @@ func S3DataSourceSchema() *schema.Schema {
 	}
 }
 
-func S3DataSourceCreate(ctx context.Context, d *schema.ResourceData, c graphql.Client) (string, error) {
+func S3DataSourceCreate(ctx context.Context, d *schema.ResourceData, c graphql.Client, conn ConnectionSettings) (string, error) {
 	input := &pc.CreateS3DataSourceInput{}
 
 	if v, ok := d.GetOk("unique_name"); ok && v.(string) != "" {
@@ -59,8 +59,7 @@ func S3DataSourceCreate(ctx context.Context, d *schema.ResourceData, c graphql.C
 		tables = expandS3Tables(def)
 	}
 
-	if v, ok := d.GetOk("s3_connection_settings.0"); ok {
-		connectionSettings := v.(map[string]any)
+	if connectionSettings, ok := conn.Get(d); ok {
 
 		input.ConnectionSettings = &pc.S3ConnectionSettingsInput{
 			Bucket:             connectionSettings["bucket"].(string),
@@ -78,7 +77,7 @@ func S3DataSourceCreate(ctx context.Context, d *schema.ResourceData, c graphql.C
 	return response.CreateS3DataSource.DataSource.Id, nil
 }
 
-func S3DataSourceUpdate(ctx context.Context, d *schema.ResourceData, c graphql.Client) error {
+func S3DataSourceUpdate(ctx context.Context, d *schema.ResourceData, c graphql.Client, conn ConnectionSettings) error {
 	id := d.Id()
 	input := &pc.ModifyS3DataSourceInput{
 		IdOrUniqueName: &pc.IdOrUniqueName{Id: &id},
@@ -92,8 +91,8 @@ func S3DataSourceUpdate(ctx context.Context, d *schema.ResourceData, c graphql.C
 		input.Description = &description
 	}
 
-	if d.HasChanges("table", "s3_connection_settings") {
-		connectionSettings := d.Get("s3_connection_settings.0").(map[string]any)
+	if d.HasChange("table") || conn.HasChange(d) {
+		connectionSettings, _ := conn.Get(d)
 
 		tables := make([]*pc.S3DataSourceTableInput, 0)
 		if _, ok := d.GetOk("table"); ok {
@@ -134,12 +133,12 @@ func S3DataSourceUpdate(ctx context.Context, d *schema.ResourceData, c graphql.C
 	return nil
 }
 
-func HandleS3ConnectionSettings(response *pc.DataSourceResponse, d *schema.ResourceData) error {
-	if _, exists := d.GetOk("s3_connection_settings.0"); !exists {
+func HandleS3ConnectionSettings(response *pc.DataSourceResponse, d *schema.ResourceData, conn ConnectionSettings) error {
+	if _, exists := conn.Get(d); !exists {
 		return nil
 	}
 
-	cs := d.Get("s3_connection_settings.0").(map[string]any)
+	cs, _ := conn.Get(d)
 	settings := map[string]any{
 		"aws_secret_access_key": cs["aws_secret_access_key"],
 	}
@@ -149,7 +148,7 @@ func HandleS3ConnectionSettings(response *pc.DataSourceResponse, d *schema.Resou
 		settings["bucket"] = s.GetBucket()
 		settings["aws_access_key_id"] = s.GetAwsAccessKeyId()
 
-		if err := d.Set("s3_connection_settings", []map[string]any{settings}); err != nil {
+		if err := conn.Set(d, settings); err != nil {
 			return err
 		}
 
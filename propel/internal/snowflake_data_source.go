@@ -16,7 +16,7 @@ func SnowflakeDataSourceSchema() *schema.Schema {
 
 		Type:          schema.TypeList,
 		Optional:      true,
-		ConflictsWith: []string{"http_connection_settings", "s3_connection_settings", "webhook_connection_settings", "kafka_connection_settings", "clickhouse_connection_settings"},
+		ConflictsWith: []string{"http_connection_settings", "s3_connection_settings", "webhook_connection_settings", "kafka_connection_settings", "clickhouse_connection_settings", "postgresql_connection_settings"},
 		MaxItems:      1,
 		Description:   "Snowflake connection settings. Specify these for Snowflake Data Sources.",
 		Elem: &schema.Resource{
@@ -62,7 +62,7 @@ func SnowflakeDataSourceSchema() *schema.Schema {
 	}
 }
 
-func SnowflakeDataSourceCreate(ctx context.Context, d *schema.ResourceData, c graphql.Client) (string, error) {
+func SnowflakeDataSourceCreate(ctx context.Context, d *schema.ResourceData, c graphql.Client, conn ConnectionSettings) (string, error) {
 	input := &pc.CreateSnowflakeDataSourceInput{}
 
 	if v, ok := d.GetOk("unique_name"); ok && v.(string) != "" {
@@ -75,8 +75,7 @@ func SnowflakeDataSourceCreate(ctx context.Context, d *schema.ResourceData, c gr
 		input.Description = &description
 	}
 
-	if v, ok := d.GetOk("snowflake_connection_settings.0"); ok {
-		connectionSettings := v.(map[string]any)
+	if connectionSettings, ok := conn.Get(d); ok {
 		input.ConnectionSettings = &pc.SnowflakeConnectionSettingsInput{
 			Account:   connectionSettings["account"].(string),
 			Database:  connectionSettings["database"].(string),
@@ -103,7 +102,7 @@ func SnowflakeDataSourceCreate(ctx context.Context, d *schema.ResourceData, c gr
 	}
 }
 
-func SnowflakeDataSourceUpdate(ctx context.Context, d *schema.ResourceData, c graphql.Client) error {
+func SnowflakeDataSourceUpdate(ctx context.Context, d *schema.ResourceData, c graphql.Client, conn ConnectionSettings) error {
 	id := d.Id()
 	input := &pc.ModifySnowflakeDataSourceInput{
 		IdOrUniqueName: &pc.IdOrUniqueName{Id: &id},
@@ -117,8 +116,8 @@ func SnowflakeDataSourceUpdate(ctx context.Context, d *schema.ResourceData, c gr
 		input.Description = &description
 	}
 
-	if d.HasChanges("snowflake_connection_settings") {
-		connectionSettings := d.Get("snowflake_connection_settings.0").(map[string]any)
+	if conn.HasChange(d) {
+		connectionSettings, _ := conn.Get(d)
 		partialInput := &pc.PartialSnowflakeConnectionSettingsInput{}
 
 		if def, ok := connectionSettings["account"]; ok && def.(string) != "" {
@@ -166,12 +165,12 @@ func SnowflakeDataSourceUpdate(ctx context.Context, d *schema.ResourceData, c gr
 	return nil
 }
 
-func HandleSnowflakeConnectionSettings(response *pc.DataSourceResponse, d *schema.ResourceData) error {
-	if _, exists := d.GetOk("snowflake_connection_settings.0"); !exists {
+func HandleSnowflakeConnectionSettings(response *pc.DataSourceResponse, d *schema.ResourceData, conn ConnectionSettings) error {
+	if _, exists := conn.Get(d); !exists {
 		return nil
 	}
 
-	cs := d.Get("snowflake_connection_settings.0").(map[string]any)
+	cs, _ := conn.Get(d)
 	settings := map[string]any{
 		"password": cs["password"],
 	}
@@ -185,7 +184,7 @@ func HandleSnowflakeConnectionSettings(response *pc.DataSourceResponse, d *schem
 		settings["role"] = s.GetRole()
 		settings["username"] = s.GetUsername()
 
-		if err := d.Set("snowflake_connection_settings", []map[string]any{settings}); err != nil {
+		if err := conn.Set(d, settings); err != nil {
 			return err
 		}
 	default:
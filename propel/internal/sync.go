@@ -0,0 +1,69 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+// WaitForSyncSucceeded waits for a Sync to succeed, failing if it FAILED.
+func WaitForSyncSucceeded(ctx context.Context, client graphql.Client, id string, timeout time.Duration) error {
+	waiter := &Waiter[*pc.SyncData]{
+		Name: "Sync",
+		Pending: []string{
+			string(pc.SyncStatusSyncing),
+		},
+		Target: []string{
+			string(pc.SyncStatusSucceeded),
+		},
+		Failed: []string{
+			string(pc.SyncStatusFailed),
+		},
+		Refresh: func(ctx context.Context) (Observation[*pc.SyncData], error) {
+			resp, err := pc.Sync(ctx, client, id)
+			if err != nil {
+				return Observation[*pc.SyncData]{}, fmt.Errorf("error trying to read Sync status: %w", err)
+			}
+
+			if resp.Sync == nil {
+				return Observation[*pc.SyncData]{}, fmt.Errorf("Sync \"%s\" not found", id)
+			}
+
+			observation := Observation[*pc.SyncData]{
+				Value: &resp.Sync.SyncData,
+				State: string(resp.Sync.Status),
+			}
+			if resp.Sync.Error != nil {
+				observation.Message = resp.Sync.Error.Message
+			}
+
+			return observation, nil
+		},
+		Timeout:      WaitTimeout(timeout),
+		PollInterval: PollInterval(client),
+	}
+
+	_, err := waiter.Wait(ctx)
+	return err
+}
+
+// SetDataPoolSyncing enables or disables syncing for a Data Pool.
+func SetDataPoolSyncing(ctx context.Context, client graphql.Client, id string, enabled bool) error {
+	if enabled {
+		if _, err := pc.EnableSyncing(ctx, client, id); err != nil {
+			return fmt.Errorf("failed to enable syncing: %w", err)
+		}
+
+		return nil
+	}
+
+	if _, err := pc.DisableSyncing(ctx, client, id); err != nil {
+		return fmt.Errorf("failed to disable syncing: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ComputedSchema converts a resource schema into one where every attribute is computed. It is used for
+// building Terraform data sources that expose the same attributes as their corresponding resources.
+func ComputedSchema(rs map[string]*schema.Schema) map[string]*schema.Schema {
+	ds := make(map[string]*schema.Schema, len(rs))
+
+	for k, v := range rs {
+		ds[k] = computedSchemaAttribute(v)
+	}
+
+	return ds
+}
+
+func computedSchemaAttribute(rs *schema.Schema) *schema.Schema {
+	ds := &schema.Schema{
+		Type:        rs.Type,
+		Computed:    true,
+		Sensitive:   rs.Sensitive,
+		Description: rs.Description,
+	}
+
+	switch elem := rs.Elem.(type) {
+	case *schema.Resource:
+		ds.Elem = &schema.Resource{
+			Description: elem.Description,
+			Schema:      ComputedSchema(elem.Schema),
+		}
+	case *schema.Schema:
+		ds.Elem = &schema.Schema{Type: elem.Type}
+	}
+
+	return ds
+}
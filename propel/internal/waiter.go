@@ -0,0 +1,186 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+// DefaultPollInterval is how often an object is read while waiting for it when the client does not set an interval.
+const DefaultPollInterval = 5 * time.Second
+
+// StateDeleted is the state reported by the deletion waiters once the object can no longer be found.
+const StateDeleted = "DELETED"
+
+// PollInterval returns how often objects are read while waiting for them with the given client. The provider's
+// client sets it with the `poll_interval` setting.
+func PollInterval(client graphql.Client) time.Duration {
+	if c, ok := client.(interface{ PollInterval() time.Duration }); ok && c.PollInterval() > 0 {
+		return c.PollInterval()
+	}
+
+	return DefaultPollInterval
+}
+
+// WaitTimeout returns how long to wait for an object within an operation with the given timeout. It leaves a minute
+// for the rest of the operation, or half of the timeout when it is too short for that.
+func WaitTimeout(timeout time.Duration) time.Duration {
+	return max(timeout-time.Minute, timeout/2)
+}
+
+// Observation is an object read while waiting for it, along with its state.
+type Observation[T any] struct {
+	Value T
+	State string
+	// Message is the server's error message, reported when the object is in a failed state.
+	Message string
+}
+
+// Waiter waits for an object to reach a target state by reading it every PollInterval.
+type Waiter[T any] struct {
+	// Name is the kind of object waited for, e.g. "Data Pool", used in the errors.
+	Name string
+	// Pending are the states the object goes through before reaching a target state.
+	Pending []string
+	// Target are the states the object is done in.
+	Target []string
+	// Failed are the terminal states the object cannot recover from.
+	Failed []string
+	// Refresh reads the object. Its errors stop the wait and are returned as they are.
+	Refresh func(ctx context.Context) (Observation[T], error)
+	// Confirmations is how many times in a row the object has to be read in a target state, for the states that
+	// take a while to settle. It defaults to one.
+	Confirmations int
+	Timeout       time.Duration
+	PollInterval  time.Duration
+}
+
+// Wait reads the object until it reaches a target state, and returns it as last read. It fails with a StateError
+// if the object reaches a failed or unexpected state, and with a TimeoutError if the timeout expires first.
+func (w *Waiter[T]) Wait(ctx context.Context) (T, error) {
+	start := time.Now()
+
+	if w.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, w.Timeout)
+		defer cancel()
+	}
+
+	// The context may have an earlier deadline than the timeout, or the only one, and it is the one reported.
+	timeout := w.Timeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = roundTimeout(deadline.Sub(start))
+	}
+
+	interval := w.PollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+
+	var last Observation[T]
+	confirmations := 0
+
+	for {
+		timer := time.NewTimer(interval)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return last.Value, w.contextError(ctx, last.State, timeout)
+		case <-timer.C:
+		}
+
+		observation, err := w.Refresh(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return last.Value, w.contextError(ctx, last.State, timeout)
+			}
+
+			return last.Value, err
+		}
+
+		last = observation
+
+		switch {
+		case slices.Contains(w.Target, observation.State):
+			confirmations++
+			if confirmations >= max(w.Confirmations, 1) {
+				return observation.Value, nil
+			}
+		case slices.Contains(w.Pending, observation.State):
+			confirmations = 0
+		default:
+			return observation.Value, &StateError{
+				Name:     w.Name,
+				State:    observation.State,
+				Message:  observation.Message,
+				Expected: w.Target,
+				failed:   slices.Contains(w.Failed, observation.State),
+			}
+		}
+	}
+}
+
+func (w *Waiter[T]) contextError(ctx context.Context, state string, timeout time.Duration) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return &TimeoutError{Name: w.Name, State: state, Expected: w.Target, Timeout: timeout}
+	}
+
+	return fmt.Errorf("stopped waiting for %s: %w", w.Name, ctx.Err())
+}
+
+// roundTimeout rounds a timeout derived from a deadline to the second, or to the millisecond when it is shorter.
+func roundTimeout(timeout time.Duration) time.Duration {
+	if timeout < time.Second {
+		return timeout.Round(time.Millisecond)
+	}
+
+	return timeout.Round(time.Second)
+}
+
+// StateError is returned when an object reaches a state it cannot go from to a target state.
+type StateError struct {
+	Name     string
+	State    string
+	Message  string
+	Expected []string
+
+	// failed is set for the known failed states, as opposed to unexpected ones.
+	failed bool
+}
+
+func (e *StateError) Error() string {
+	if !e.failed {
+		return fmt.Sprintf("%s reached the unexpected state %s while waiting for it to be %s", e.Name, e.State, strings.Join(e.Expected, " or "))
+	}
+
+	message := e.Message
+	if message == "" {
+		message = "unknown error"
+	}
+
+	return fmt.Sprintf("%s is %s: %s", e.Name, e.State, message)
+}
+
+// TimeoutError is returned when an object does not reach a target state in time.
+type TimeoutError struct {
+	Name string
+	// State is the object's last known state, if it could be read at all.
+	State    string
+	Expected []string
+	Timeout  time.Duration
+}
+
+func (e *TimeoutError) Error() string {
+	state := e.State
+	if state == "" {
+		state = "unknown"
+	}
+
+	return fmt.Sprintf("timeout after %s while waiting for %s to be %s (last state: %s)", e.Timeout, e.Name, strings.Join(e.Expected, " or "), state)
+}
@@ -0,0 +1,167 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// observations returns a Refresh function reporting the given states one after the other, and then the last one.
+func observations(states ...string) func(ctx context.Context) (Observation[int], error) {
+	n := 0
+
+	return func(_ context.Context) (Observation[int], error) {
+		i := min(n, len(states)-1)
+		n++
+
+		return Observation[int]{Value: n, State: states[i], Message: "setup failed"}, nil
+	}
+}
+
+func Test_Waiter(t *testing.T) {
+	tests := []struct {
+		name          string
+		states        []string
+		confirmations int
+		timeout       time.Duration
+		parentTimeout time.Duration
+		expectedValue int
+		expectedError string
+	}{
+		{
+			name:          "Target reached",
+			states:        []string{"CREATED", "PENDING", "LIVE"},
+			expectedValue: 3,
+		},
+		{
+			name:          "Target confirmed",
+			states:        []string{"LIVE", "PENDING", "LIVE", "LIVE"},
+			confirmations: 2,
+			expectedValue: 4,
+		},
+		{
+			name:          "Failed state",
+			states:        []string{"PENDING", "FAILED"},
+			expectedValue: 2,
+			expectedError: "Data Pool is FAILED: setup failed",
+		},
+		{
+			name:          "Unexpected state",
+			states:        []string{"PENDING", "PAUSED"},
+			expectedValue: 2,
+			expectedError: "Data Pool reached the unexpected state PAUSED while waiting for it to be LIVE",
+		},
+		{
+			name:          "Timeout",
+			states:        []string{"PENDING"},
+			timeout:       50 * time.Millisecond,
+			expectedError: "timeout after 50ms while waiting for Data Pool to be LIVE (last state: PENDING)",
+		},
+		{
+			name:          "Parent deadline before the timeout",
+			states:        []string{"PENDING"},
+			timeout:       time.Minute,
+			parentTimeout: 50 * time.Millisecond,
+			expectedError: "timeout after 50ms while waiting for Data Pool to be LIVE (last state: PENDING)",
+		},
+		{
+			name:          "Parent deadline without a timeout",
+			states:        []string{"PENDING"},
+			parentTimeout: 50 * time.Millisecond,
+			expectedError: "timeout after 50ms while waiting for Data Pool to be LIVE (last state: PENDING)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(st *testing.T) {
+			a := assert.New(st)
+
+			waiter := &Waiter[int]{
+				Name:          "Data Pool",
+				Pending:       []string{"CREATED", "PENDING"},
+				Target:        []string{"LIVE"},
+				Failed:        []string{"FAILED"},
+				Refresh:       observations(tt.states...),
+				Confirmations: tt.confirmations,
+				Timeout:       tt.timeout,
+				PollInterval:  time.Millisecond,
+			}
+
+			ctx := context.Background()
+			if tt.parentTimeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.parentTimeout)
+				defer cancel()
+			}
+
+			value, err := waiter.Wait(ctx)
+			if tt.expectedError != "" {
+				a.EqualError(err, tt.expectedError)
+			} else {
+				a.NoError(err)
+				a.Equal(tt.expectedValue, value)
+			}
+		})
+	}
+}
+
+func Test_Waiter_errors(t *testing.T) {
+	a := assert.New(t)
+
+	refreshErr := errors.New("connection refused")
+	waiter := &Waiter[int]{
+		Name:   "Data Pool",
+		Target: []string{"LIVE"},
+		Refresh: func(_ context.Context) (Observation[int], error) {
+			return Observation[int]{}, refreshErr
+		},
+		PollInterval: time.Millisecond,
+	}
+
+	_, err := waiter.Wait(context.Background())
+	a.ErrorIs(err, refreshErr)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = waiter.Wait(ctx)
+	a.ErrorIs(err, context.Canceled)
+
+	var timeoutErr *TimeoutError
+	a.False(errors.As(err, &timeoutErr))
+}
+
+func Test_WaitTimeout(t *testing.T) {
+	tests := []struct {
+		name     string
+		timeout  time.Duration
+		expected time.Duration
+	}{
+		{
+			name:     "A minute left for the operation",
+			timeout:  20 * time.Minute,
+			expected: 19 * time.Minute,
+		},
+		{
+			name:     "Timeout of a minute",
+			timeout:  time.Minute,
+			expected: 30 * time.Second,
+		},
+		{
+			name:     "Timeout shorter than a minute",
+			timeout:  30 * time.Second,
+			expected: 15 * time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(st *testing.T) {
+			a := assert.New(st)
+
+			a.Equal(tt.expected, WaitTimeout(tt.timeout))
+		})
+	}
+}
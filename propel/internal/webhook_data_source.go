@@ -16,7 +16,7 @@ func WebhookDataSourceSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:          schema.TypeList,
 		Optional:      true,
-		ConflictsWith: []string{"snowflake_connection_settings", "http_connection_settings", "s3_connection_settings", "kafka_connection_settings", "clickhouse_connection_settings"},
+		ConflictsWith: []string{"snowflake_connection_settings", "http_connection_settings", "s3_connection_settings", "kafka_connection_settings", "clickhouse_connection_settings", "postgresql_connection_settings"},
 		MaxItems:      1,
 		Elem: &schema.Resource{
 			Description: "Webhook connection settings. Specify these for Webhook Data Sources.",
@@ -97,7 +97,7 @@ Then you can use the JSON property "greeting.message" to extract "hello, world"
 	}
 }
 
-func WebhookDataSourceCreate(ctx context.Context, d *schema.ResourceData, c graphql.Client) (string, error) {
+func WebhookDataSourceCreate(ctx context.Context, d *schema.ResourceData, c graphql.Client, conn ConnectionSettings) (string, error) {
 	input := &pc.CreateWebhookDataSourceInput{}
 
 	if v, ok := d.GetOk("unique_name"); ok && v.(string) != "" {
@@ -110,8 +110,7 @@ func WebhookDataSourceCreate(ctx context.Context, d *schema.ResourceData, c grap
 		input.Description = &description
 	}
 
-	if v, ok := d.GetOk("webhook_connection_settings.0"); ok {
-		cs := v.(map[string]any)
+	if cs, ok := conn.Get(d); ok {
 		connectionSettingsInput := &pc.WebhookConnectionSettingsInput{}
 
 		if def, ok := cs["basic_auth"]; ok && len(def.([]any)) > 0 {
@@ -164,23 +163,18 @@ func WebhookDataSourceCreate(ctx context.Context, d *schema.ResourceData, c grap
 	return response.CreateWebhookDataSource.DataSource.Id, nil
 }
 
-func WebhookDataSourceUpdate(ctx context.Context, d *schema.ResourceData, c graphql.Client) error {
+func WebhookDataSourceUpdate(ctx context.Context, d *schema.ResourceData, c graphql.Client, conn ConnectionSettings) error {
 	id := d.Id()
 	input := &pc.ModifyWebhookDataSourceInput{
 		IdOrUniqueName: &pc.IdOrUniqueName{Id: &id},
 	}
 
-	if d.HasChange("webhook_connection_settings") {
-		oldItem, newItem := d.GetChange("webhook_connection_settings")
-		oldDef, oldOk := oldItem.([]any)
-		newDef, newOk := newItem.([]any)
-
-		if !oldOk || !newOk || len(newDef) < 1 {
+	if conn.HasChange(d) {
+		oldConnectionSettings, newConnectionSettings := conn.GetChange(d)
+		if oldConnectionSettings == nil || newConnectionSettings == nil {
 			return errors.New("invalid Webhook Connection Settings format")
 		}
 
-		oldConnectionSettings, newConnectionSettings := oldDef[0].(map[string]any), newDef[0].(map[string]any)
-
 		oldColumnItem, okOldColumn := oldConnectionSettings["column"]
 		newColumnItem, okNewColumn := newConnectionSettings["column"]
 		if !okNewColumn || !okOldColumn {
@@ -189,6 +183,10 @@ func WebhookDataSourceUpdate(ctx context.Context, d *schema.ResourceData, c grap
 
 		dataPoolId := oldConnectionSettings["data_pool_id"].(string)
 
+		if err := ValidateWebhookColumns(oldColumnItem.([]any), newColumnItem.([]any)); err != nil {
+			return err
+		}
+
 		newColumns, err := newWebhookColumns(oldColumnItem.([]any), newColumnItem.([]any))
 		if err != nil {
 			return err
@@ -240,8 +238,8 @@ func WebhookDataSourceUpdate(ctx context.Context, d *schema.ResourceData, c grap
 	return err
 }
 
-func HandleWebhookConnectionSettings(response *pc.DataSourceResponse, d *schema.ResourceData) error {
-	if _, exists := d.GetOk("webhook_connection_settings.0"); !exists {
+func HandleWebhookConnectionSettings(response *pc.DataSourceResponse, d *schema.ResourceData, conn ConnectionSettings) error {
+	if _, exists := conn.Get(d); !exists {
 		return nil
 	}
 
@@ -287,7 +285,7 @@ func HandleWebhookConnectionSettings(response *pc.DataSourceResponse, d *schema.
 			settings["table_settings"] = []map[string]any{ParseTableSettings(s.GetTableSettings().TableSettingsData)}
 		}
 
-		if err := d.Set("webhook_connection_settings", []map[string]any{settings}); err != nil {
+		if err := conn.Set(d, settings); err != nil {
 			return err
 		}
 	default:
@@ -297,8 +295,8 @@ func HandleWebhookConnectionSettings(response *pc.DataSourceResponse, d *schema.
 	return nil
 }
 
-func WebhookDataSourceDelete(ctx context.Context, d *schema.ResourceData, c graphql.Client) error {
-	connectionSettings := d.Get("webhook_connection_settings.0").(map[string]any)
+func WebhookDataSourceDelete(ctx context.Context, d *schema.ResourceData, c graphql.Client, conn ConnectionSettings) error {
+	connectionSettings, _ := conn.Get(d)
 	dataPoolID := connectionSettings["data_pool_id"].(string)
 
 	if _, err := pc.DeleteDataPool(ctx, c, dataPoolID); err != nil {
@@ -373,28 +371,43 @@ func newWebhookColumns(oldItemDef []any, newItemDef []any) (map[string]pc.Webhoo
 	return newColumns, nil
 }
 
-func addWebhookColumns(ctx context.Context, d *schema.ResourceData, c graphql.Client, dataPoolId string, newColumns map[string]pc.WebhookDataSourceColumnInput) error {
-	for _, newColumn := range newColumns {
-		if !newColumn.Nullable {
+// ValidateWebhookColumns checks that the Webhook Data Source columns can be changed from oldItemDef to newItemDef.
+// Columns cannot be removed or modified, and the columns that are added must be nullable. It is called with no
+// old columns for a new Data Source, in which case only the column names are checked.
+func ValidateWebhookColumns(oldItemDef []any, newItemDef []any) error {
+	newColumns, err := newWebhookColumns(oldItemDef, newItemDef)
+	if err != nil {
+		return err
+	}
+
+	if oldItemDef == nil {
+		return nil
+	}
+
+	for _, rawColumn := range newItemDef {
+		column := rawColumn.(map[string]any)
+
+		if newColumn, ok := newColumns[column["name"].(string)]; ok && !newColumn.Nullable {
 			return fmt.Errorf(`new column "%s" must be nullable`, newColumn.Name)
 		}
+	}
+
+	return nil
+}
+
+func addWebhookColumns(ctx context.Context, d *schema.ResourceData, c graphql.Client, dataPoolId string, newColumns map[string]pc.WebhookDataSourceColumnInput) error {
+	inputs := make([]*pc.CreateAddColumnToDataPoolJobInput, 0, len(newColumns))
+
+	for _, newColumn := range newColumns {
+		jsonProperty := newColumn.JsonProperty
 
-		jobResponse, err := pc.CreateAddColumnToDataPoolJob(ctx, c, &pc.CreateAddColumnToDataPoolJobInput{
+		inputs = append(inputs, &pc.CreateAddColumnToDataPoolJobInput{
 			DataPool:     dataPoolId,
 			ColumnName:   newColumn.Name,
 			ColumnType:   newColumn.Type,
-			JsonProperty: &newColumn.JsonProperty,
+			JsonProperty: &jsonProperty,
 		})
-		if err != nil {
-			return err
-		}
-
-		timeout := d.Timeout(schema.TimeoutUpdate)
-
-		if err = WaitForAddColumnJobSucceeded(ctx, c, jobResponse.CreateAddColumnToDataPoolJob.Job.Id, timeout); err != nil {
-			return err
-		}
 	}
 
-	return nil
+	return AddColumnsToDataPool(ctx, c, inputs, d.Timeout(schema.TimeoutUpdate))
 }
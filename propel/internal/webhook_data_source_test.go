@@ -101,3 +101,72 @@ func Test_getNewDataSourceColumns(t *testing.T) {
 		})
 	}
 }
+
+func Test_ValidateWebhookColumns(t *testing.T) {
+	tests := []struct {
+		name          string
+		oldItemDef    []any
+		newItemDef    []any
+		expectedError string
+	}{
+		{
+			name: "New Data Source",
+			newItemDef: []any{
+				map[string]any{"name": "COLUMN_A", "type": "STRING", "nullable": false, "json_property": "column_a"},
+			},
+		},
+		{
+			name: "New Data Source with repeated column names",
+			newItemDef: []any{
+				map[string]any{"name": "COLUMN_A", "type": "STRING", "nullable": false, "json_property": "column_a"},
+				map[string]any{"name": "COLUMN_A", "type": "STRING", "nullable": false, "json_property": "column_b"},
+			},
+			expectedError: `column "COLUMN_A" already exists`,
+		},
+		{
+			name: "Nullable column added",
+			oldItemDef: []any{
+				map[string]any{"name": "COLUMN_A", "type": "STRING", "nullable": false, "json_property": "column_a"},
+			},
+			newItemDef: []any{
+				map[string]any{"name": "COLUMN_A", "type": "STRING", "nullable": false, "json_property": "column_a"},
+				map[string]any{"name": "COLUMN_B", "type": "INT64", "nullable": true, "json_property": "column_b"},
+			},
+		},
+		{
+			name: "Non-nullable column added",
+			oldItemDef: []any{
+				map[string]any{"name": "COLUMN_A", "type": "STRING", "nullable": false, "json_property": "column_a"},
+			},
+			newItemDef: []any{
+				map[string]any{"name": "COLUMN_A", "type": "STRING", "nullable": false, "json_property": "column_a"},
+				map[string]any{"name": "COLUMN_B", "type": "INT64", "nullable": false, "json_property": "column_b"},
+			},
+			expectedError: `new column "COLUMN_B" must be nullable`,
+		},
+		{
+			name: "JSON property modified",
+			oldItemDef: []any{
+				map[string]any{"name": "COLUMN_A", "type": "STRING", "nullable": false, "json_property": "column_a"},
+			},
+			newItemDef: []any{
+				map[string]any{"name": "COLUMN_A", "type": "STRING", "nullable": false, "json_property": "column_z"},
+			},
+			expectedError: `column "COLUMN_A" was modified, column updates are not supported`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(st *testing.T) {
+			a := assert.New(st)
+
+			err := ValidateWebhookColumns(tt.oldItemDef, tt.newItemDef)
+			if tt.expectedError != "" {
+				a.EqualError(err, tt.expectedError)
+				return
+			}
+
+			a.NoError(err)
+		})
+	}
+}
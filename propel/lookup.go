@@ -0,0 +1,231 @@
+package propel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/propeldata/terraform-provider-propel/propel/internal/utils"
+)
+
+// lookupFunc resolves the ID of a Propel object given its unique name.
+type lookupFunc func(ctx context.Context, c graphql.Client, uniqueName string) (string, error)
+
+// lookupDataSourceSchema builds the schema of a Terraform data source from the schema of the corresponding
+// resource. Every attribute becomes computed except for `id` and `unique_name`, which are used for looking up
+// the object.
+func lookupDataSourceSchema(rs map[string]*schema.Schema, objectName string, exclude ...string) map[string]*schema.Schema {
+	ds := utils.ComputedSchema(rs)
+
+	for _, k := range exclude {
+		delete(ds, k)
+	}
+
+	ds["id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"id", "unique_name"},
+		Description:  "The " + objectName + "'s ID. Either `id` or `unique_name` must be specified.",
+	}
+
+	ds["unique_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"id", "unique_name"},
+		Description:  "The " + objectName + "'s unique name. Either `id` or `unique_name` must be specified.",
+	}
+
+	return ds
+}
+
+// lookupID returns the ID configured in a Terraform data source or, if only the unique name was provided,
+// resolves it with the given lookup function.
+func lookupID(ctx context.Context, d *schema.ResourceData, c graphql.Client, lookup lookupFunc) (string, error) {
+	if v, ok := d.GetOk("id"); ok && v.(string) != "" {
+		return v.(string), nil
+	}
+
+	if v, ok := d.GetOk("unique_name"); ok && v.(string) != "" {
+		return lookup(ctx, c, v.(string))
+	}
+
+	return "", errors.New("either `id` or `unique_name` must be specified")
+}
+
+// importNamePrefix marks an import ID as the object's unique name rather than its ID, as in `name:my_data_pool`.
+const importNamePrefix = "name:"
+
+// importSetFunc sets the attributes of an imported resource that the Propel API does not return, such as the ones
+// that only affect how the object is created. They would otherwise be null in the imported state, and in the
+// configuration that Terraform generates from it.
+type importSetFunc func(ctx context.Context, d *schema.ResourceData, c graphql.Client) error
+
+// importDefaults returns an importSetFunc setting the given attributes to their default value.
+func importDefaults(defaults map[string]any) importSetFunc {
+	return func(_ context.Context, d *schema.ResourceData, _ graphql.Client) error {
+		for k, v := range defaults {
+			if err := d.Set(k, v); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// readDefaults wraps a resource's Read function to set the given attributes to their default value when they are
+// missing from the state, as they are for objects managed with a version of the provider that predates them. They
+// would otherwise show a diff on the next plan. The defaults must be zero values, which GetOk does not tell apart
+// from missing ones.
+func readDefaults(read schema.ReadContextFunc, defaults map[string]any) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		diags := read(ctx, d, meta)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+
+		for k, v := range defaults {
+			if _, ok := d.GetOk(k); ok {
+				continue
+			}
+
+			if err := d.Set(k, v); err != nil {
+				return append(diags, diag.FromErr(err)...)
+			}
+		}
+
+		return diags
+	}
+}
+
+// importByIDOrName returns an importer accepting either the object's ID or its unique name prefixed with `name:`,
+// which it resolves with the given lookup function before the object is read. Objects that cannot be looked up by
+// unique name have no lookup function and are only imported by ID. The set functions are called once the ID is
+// resolved.
+func importByIDOrName(objectName string, lookup lookupFunc, set ...importSetFunc) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+			c := meta.(graphql.Client)
+
+			if uniqueName, ok := strings.CutPrefix(d.Id(), importNamePrefix); ok {
+				if lookup == nil {
+					return nil, fmt.Errorf("%s resources can only be imported by ID", objectName)
+				}
+
+				if uniqueName == "" {
+					return nil, fmt.Errorf("the %s's unique name must follow the `%s` prefix", objectName, importNamePrefix)
+				}
+
+				id, err := lookup(ctx, c, uniqueName)
+				if err != nil {
+					return nil, err
+				}
+
+				d.SetId(id)
+			}
+
+			for _, f := range set {
+				if err := f(ctx, d, c); err != nil {
+					return nil, err
+				}
+			}
+
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+// listPageSize is the number of objects requested per page by the list data sources.
+const listPageSize = 100
+
+// listFilter holds the client-side filters supported by the list data sources.
+type listFilter struct {
+	uniqueNamePrefix string
+	uniqueNameRegex  *regexp.Regexp
+	objectType       string
+	status           string
+}
+
+// listFilterSchema returns the filter attributes shared by the list data sources.
+func listFilterSchema(objectName string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"unique_name_prefix": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Only include " + objectName + " whose unique name starts with this prefix.",
+		},
+		"unique_name_regex": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsValidRegExp,
+			Description:  "Only include " + objectName + " whose unique name matches this regular expression.",
+		},
+	}
+}
+
+func expandListFilter(d *schema.ResourceData, withType bool, withStatus bool) (*listFilter, error) {
+	filter := &listFilter{
+		uniqueNamePrefix: d.Get("unique_name_prefix").(string),
+	}
+
+	if v, ok := d.GetOk("unique_name_regex"); ok && v.(string) != "" {
+		re, err := regexp.Compile(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("invalid unique_name_regex: %w", err)
+		}
+
+		filter.uniqueNameRegex = re
+	}
+
+	if withType {
+		filter.objectType = d.Get("type").(string)
+	}
+
+	if withStatus {
+		filter.status = d.Get("status").(string)
+	}
+
+	return filter, nil
+}
+
+// matches reports whether an object with the given unique name, type and status passes the filter. Types and
+// statuses are compared case-insensitively, since the API does not use a consistent casing for them.
+func (f *listFilter) matches(uniqueName string, objectType string, status string) bool {
+	if f.uniqueNamePrefix != "" && !strings.HasPrefix(uniqueName, f.uniqueNamePrefix) {
+		return false
+	}
+
+	if f.uniqueNameRegex != nil && !f.uniqueNameRegex.MatchString(uniqueName) {
+		return false
+	}
+
+	if f.objectType != "" && !strings.EqualFold(f.objectType, objectType) {
+		return false
+	}
+
+	if f.status != "" && !strings.EqualFold(f.status, status) {
+		return false
+	}
+
+	return true
+}
+
+// id returns a stable identifier for a list data source based on its filter.
+func (f *listFilter) id() string {
+	regex := ""
+	if f.uniqueNameRegex != nil {
+		regex = f.uniqueNameRegex.String()
+	}
+
+	return strconv.Itoa(schema.HashString(strings.Join([]string{f.uniqueNamePrefix, regex, f.objectType, f.status}, "|")))
+}
@@ -0,0 +1,200 @@
+package propel
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+func Test_listFilterMatches(t *testing.T) {
+	tests := []struct {
+		name       string
+		filter     listFilter
+		uniqueName string
+		objectType string
+		status     string
+		expected   bool
+	}{
+		{
+			name:       "Empty filter",
+			filter:     listFilter{},
+			uniqueName: "orders",
+			expected:   true,
+		},
+		{
+			name:       "Matching prefix",
+			filter:     listFilter{uniqueNamePrefix: "prod_"},
+			uniqueName: "prod_orders",
+			expected:   true,
+		},
+		{
+			name:       "Non-matching prefix",
+			filter:     listFilter{uniqueNamePrefix: "prod_"},
+			uniqueName: "staging_orders",
+			expected:   false,
+		},
+		{
+			name:       "Matching regex",
+			filter:     listFilter{uniqueNameRegex: regexp.MustCompile(`_(orders|events)$`)},
+			uniqueName: "prod_events",
+			expected:   true,
+		},
+		{
+			name:       "Non-matching regex",
+			filter:     listFilter{uniqueNameRegex: regexp.MustCompile(`_(orders|events)$`)},
+			uniqueName: "prod_customers",
+			expected:   false,
+		},
+		{
+			name:       "Type is compared case-insensitively",
+			filter:     listFilter{objectType: "SNOWFLAKE"},
+			uniqueName: "warehouse",
+			objectType: "Snowflake",
+			expected:   true,
+		},
+		{
+			name:       "Non-matching status",
+			filter:     listFilter{status: "LIVE"},
+			uniqueName: "orders",
+			status:     "BROKEN",
+			expected:   false,
+		},
+		{
+			name:       "All criteria must match",
+			filter:     listFilter{uniqueNamePrefix: "prod_", objectType: "WEBHOOK", status: "CONNECTED"},
+			uniqueName: "prod_events",
+			objectType: "WEBHOOK",
+			status:     "BROKEN",
+			expected:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(st *testing.T) {
+			a := assert.New(st)
+
+			a.Equal(tt.expected, tt.filter.matches(tt.uniqueName, tt.objectType, tt.status))
+		})
+	}
+}
+
+func Test_importByIDOrName(t *testing.T) {
+	lookup := func(_ context.Context, _ graphql.Client, uniqueName string) (string, error) {
+		if uniqueName != "orders" {
+			return "", fmt.Errorf("Data Pool \"%s\" %w", uniqueName, pc.ErrNotFound)
+		}
+
+		return "DPO00000000000000000000000000", nil
+	}
+
+	tests := []struct {
+		name          string
+		importId      string
+		lookup        lookupFunc
+		set           []importSetFunc
+		expectedId    string
+		expectedError string
+	}{
+		{
+			name:       "ID",
+			importId:   "DPO00000000000000000000000001",
+			lookup:     lookup,
+			expectedId: "DPO00000000000000000000000001",
+		},
+		{
+			name:       "Unique name",
+			importId:   "name:orders",
+			lookup:     lookup,
+			expectedId: "DPO00000000000000000000000000",
+		},
+		{
+			name:          "Unknown unique name",
+			importId:      "name:customers",
+			lookup:        lookup,
+			expectedError: `Data Pool "customers" not found`,
+		},
+		{
+			name:          "Empty unique name",
+			importId:      "name:",
+			lookup:        lookup,
+			expectedError: "the Data Pool's unique name must follow the `name:` prefix",
+		},
+		{
+			name:       "Attributes set on import",
+			importId:   "name:orders",
+			lookup:     lookup,
+			set:        []importSetFunc{importDefaults(map[string]any{"allow_column_recreate": true})},
+			expectedId: "DPO00000000000000000000000000",
+		},
+		{
+			name:          "No lookup",
+			importId:      "name:orders",
+			expectedError: "Data Pool resources can only be imported by ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(st *testing.T) {
+			a := assert.New(st)
+
+			d := schema.TestResourceDataRaw(st, resourceDataPool().Schema, map[string]any{})
+			d.SetId(tt.importId)
+
+			imported, err := importByIDOrName("Data Pool", tt.lookup, tt.set...).StateContext(context.Background(), d, &providerClient{})
+			if tt.expectedError != "" {
+				a.EqualError(err, tt.expectedError)
+				return
+			}
+
+			a.NoError(err)
+			a.Len(imported, 1)
+			a.Equal(tt.expectedId, imported[0].Id())
+			a.Equal(tt.set != nil, imported[0].Get("allow_column_recreate"))
+		})
+	}
+}
+
+func Test_readDefaults(t *testing.T) {
+	read := func(_ context.Context, _ *schema.ResourceData, _ any) diag.Diagnostics {
+		return nil
+	}
+
+	tests := []struct {
+		name       string
+		attributes map[string]string
+		expected   string
+	}{
+		{
+			name:       "Missing from the state",
+			attributes: map[string]string{"unique_name": "orders"},
+			expected:   "false",
+		},
+		{
+			name:       "Set in the state",
+			attributes: map[string]string{"unique_name": "orders", "run_checks_on_plan": "true"},
+			expected:   "true",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(st *testing.T) {
+			a := assert.New(st)
+
+			r := resourceDataSource()
+			d := r.Data(&terraform.InstanceState{ID: "DSO00000000000000000000000000", Attributes: tt.attributes})
+
+			diags := readDefaults(read, map[string]any{"run_checks_on_plan": false})(context.Background(), d, nil)
+			a.False(diags.HasError())
+			a.Equal(tt.expected, d.State().Attributes["run_checks_on_plan"])
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package propel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+// materializedViewSQLDialect is the dialect Materialized View SQL is described with, since it runs on ClickHouse.
+const materializedViewSQLDialect = pc.SqlDialectV1Clickhouse
+
+// sqlIdentifierPattern matches plain column names, as opposed to expressions such as `toYYYYMM(timestamp)`.
+var sqlIdentifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// describeMaterializedViewSQL returns the columns that the SQL outputs, in the form of the `output_columns` attribute.
+func describeMaterializedViewSQL(ctx context.Context, c graphql.Client, sql string) ([]any, error) {
+	dialect := materializedViewSQLDialect
+
+	response, err := pc.DescribeSql(ctx, c, &pc.DescribeSqlV1Input{Query: sql, Dialect: &dialect})
+	if err != nil {
+		return nil, fmt.Errorf("the Materialized View's SQL is not valid: %w", err)
+	}
+
+	columns := make([]any, 0, len(response.DescribeSqlV1.Columns))
+	for _, column := range response.DescribeSqlV1.Columns {
+		columns = append(columns, map[string]any{
+			"name":     column.ColumnName,
+			"type":     string(column.Type),
+			"nullable": column.IsNullable,
+		})
+	}
+
+	return columns, nil
+}
+
+// customizeDiffMaterializedView describes the SQL when planning, so that a SQL error fails the plan rather than the
+// apply, after the Materialized View it replaces was destroyed. The SQL of a Data Pool that is not found is left to be
+// described after the apply, since the Data Pool may be created in the same apply. It also checks that the columns
+// the new Data Pool is configured with are part of the SQL's output.
+func customizeDiffMaterializedView(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	outputColumns := d.Get("output_columns").([]any)
+
+	if d.Id() == "" || d.HasChange("sql") {
+		if !d.NewValueKnown("sql") {
+			return d.SetNewComputed("output_columns")
+		}
+
+		var err error
+		if outputColumns, err = describeMaterializedViewSQL(ctx, meta.(graphql.Client), d.Get("sql").(string)); err != nil {
+			if !pc.IsNotFound(err) {
+				return err
+			}
+
+			return d.SetNewComputed("output_columns")
+		}
+
+		if err := d.SetNew("output_columns", outputColumns); err != nil {
+			return err
+		}
+	} else if !d.HasChange("new_data_pool") {
+		return nil
+	}
+
+	// The output is not in the state yet if the Materialized View was created by a previous version of the provider.
+	if len(outputColumns) == 0 {
+		return nil
+	}
+
+	newDataPool, ok := d.Get("new_data_pool").([]any)
+	if !ok || len(newDataPool) == 0 || newDataPool[0] == nil {
+		return nil
+	}
+
+	return validateNewDataPoolColumns(newDataPool[0].(map[string]any), outputColumns)
+}
+
+// validateNewDataPoolColumns checks that the timestamp and table settings columns of the new Data Pool are output by
+// the Materialized View's SQL. Names that are not known yet are empty, and expressions are left to the Propel API.
+func validateNewDataPoolColumns(newDataPool map[string]any, outputColumns []any) error {
+	columns := make(map[string]bool, len(outputColumns))
+	for _, rawColumn := range outputColumns {
+		columns[rawColumn.(map[string]any)["name"].(string)] = true
+	}
+
+	errs := make([]error, 0)
+
+	check := func(attribute string, name string) {
+		if sqlIdentifierPattern.MatchString(name) && !columns[name] {
+			errs = append(errs, fmt.Errorf(`new_data_pool: %s "%s" is not a column of the Materialized View's output`, attribute, name))
+		}
+	}
+
+	if timestamp, _ := newDataPool["timestamp"].(string); timestamp != "" {
+		check("timestamp", timestamp)
+	}
+
+	rawSettings, _ := newDataPool["table_settings"].([]any)
+	if len(rawSettings) == 0 || rawSettings[0] == nil {
+		return errors.Join(errs...)
+	}
+
+	settings := rawSettings[0].(map[string]any)
+
+	for _, attribute := range []string{"partition_by", "primary_key", "order_by"} {
+		rawNames, _ := settings[attribute].([]any)
+		for _, rawName := range rawNames {
+			name, _ := rawName.(string)
+			check(attribute, name)
+		}
+	}
+
+	if rawEngine, _ := settings["engine"].([]any); len(rawEngine) == 1 && rawEngine[0] != nil {
+		engine := rawEngine[0].(map[string]any)
+
+		if ver, _ := engine["ver"].(string); ver != "" {
+			check("engine ver", ver)
+		}
+
+		rawNames, _ := engine["columns"].([]any)
+		for _, rawName := range rawNames {
+			name, _ := rawName.(string)
+			check("engine columns", name)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,150 @@
+package propel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+func Test_validateNewDataPoolColumns(t *testing.T) {
+	outputColumns := []any{
+		map[string]any{"name": "customer_id", "type": "STRING", "nullable": false},
+		map[string]any{"name": "value", "type": "INT64", "nullable": false},
+		map[string]any{"name": "timestamp", "type": "TIMESTAMP", "nullable": false},
+	}
+
+	tests := []struct {
+		name          string
+		newDataPool   map[string]any
+		expectedError string
+	}{
+		{
+			name:        "No timestamp or table settings",
+			newDataPool: map[string]any{"unique_name": "orders"},
+		},
+		{
+			name: "Existing columns",
+			newDataPool: map[string]any{
+				"timestamp": "timestamp",
+				"table_settings": []any{map[string]any{
+					"engine":       []any{map[string]any{"type": "SUMMING_MERGE_TREE", "ver": "", "columns": []any{"value"}}},
+					"partition_by": []any{""},
+					"primary_key":  []any{},
+					"order_by":     []any{"timestamp", "customer_id"},
+				}},
+			},
+		},
+		{
+			name: "Expressions are not checked",
+			newDataPool: map[string]any{
+				"table_settings": []any{map[string]any{
+					"partition_by": []any{"toYYYYMM(timestamp)"},
+					"order_by":     []any{"cityHash64(customer_id)"},
+				}},
+			},
+		},
+		{
+			name:          "Missing timestamp",
+			newDataPool:   map[string]any{"timestamp": "timestamp_tz"},
+			expectedError: `new_data_pool: timestamp "timestamp_tz" is not a column of the Materialized View's output`,
+		},
+		{
+			name: "Missing table settings columns",
+			newDataPool: map[string]any{
+				"timestamp": "timestamp",
+				"table_settings": []any{map[string]any{
+					"engine":   []any{map[string]any{"type": "REPLACING_MERGE_TREE", "ver": "version", "columns": []any{}}},
+					"order_by": []any{"timestamp", "account_id"},
+				}},
+			},
+			expectedError: "new_data_pool: order_by \"account_id\" is not a column of the Materialized View's output\n" +
+				"new_data_pool: engine ver \"version\" is not a column of the Materialized View's output",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(st *testing.T) {
+			a := assert.New(st)
+
+			err := validateNewDataPoolColumns(tt.newDataPool, outputColumns)
+			if tt.expectedError == "" {
+				a.NoError(err)
+				return
+			}
+
+			a.EqualError(err, tt.expectedError)
+		})
+	}
+}
+
+func Test_customizeDiffMaterializedView(t *testing.T) {
+	tests := []struct {
+		name             string
+		response         string
+		expectedComputed bool
+		expectedKind     error
+	}{
+		{
+			name:     "Described",
+			response: `{"data": {"describeSqlV1": {"columns": [{"columnName": "customer_id", "type": "STRING", "isNullable": false}]}}}`,
+		},
+		{
+			name:         "Syntax error",
+			response:     `{"errors": [{"message": "Syntax error: failed at position 1 ('SELEKT')", "path": ["describeSqlV1"], "extensions": {"code": "BAD_USER_INPUT"}}], "data": null}`,
+			expectedKind: pc.ErrValidation,
+		},
+		{
+			name:             "Data Pool not created yet",
+			response:         `{"errors": [{"message": "Data Pool \"orders\" not found", "path": ["describeSqlV1"], "extensions": {"code": "NOT_FOUND"}}], "data": null}`,
+			expectedComputed: true,
+		},
+		{
+			name:         "Unauthorized",
+			response:     `{"errors": [{"message": "Unauthorized", "extensions": {"code": "UNAUTHORIZED"}}], "data": null}`,
+			expectedKind: pc.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(st *testing.T) {
+			a := assert.New(st)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/oauth2/token" {
+					_, _ = w.Write([]byte(`{"access_token": "token", "expires_in": 3600}`))
+					return
+				}
+
+				_, _ = w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			c, err := pc.NewPropelClient("id", "secret", "test", server.URL+"/oauth2/token", server.URL+"/graphql", pc.WithRetries(0, time.Second))
+			a.NoError(err)
+
+			config := terraform.NewResourceConfigRaw(map[string]any{
+				"unique_name": "mv",
+				"sql":         `SELECT customer_id FROM "orders"`,
+			})
+
+			diff, err := resourceMaterializedView().Diff(context.Background(), nil, config, c)
+			if tt.expectedKind != nil {
+				a.ErrorIs(err, tt.expectedKind)
+				return
+			}
+
+			a.NoError(err)
+			a.Equal(tt.expectedComputed, diff.Attributes["output_columns.#"].NewComputed)
+			if !tt.expectedComputed {
+				a.Equal("customer_id", diff.Attributes["output_columns.0.name"].New)
+			}
+		})
+	}
+}
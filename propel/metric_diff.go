@@ -0,0 +1,211 @@
+package propel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+// numericColumnTypes are the Data Pool column types that SUM, AVERAGE, MIN and MAX Metrics can measure. ClickHouse
+// columns are included because their exact type is checked by the Propel API when the Metric is migrated.
+var numericColumnTypes = map[pc.ColumnType]bool{
+	pc.ColumnTypeInt8:       true,
+	pc.ColumnTypeInt16:      true,
+	pc.ColumnTypeInt32:      true,
+	pc.ColumnTypeInt64:      true,
+	pc.ColumnTypeFloat:      true,
+	pc.ColumnTypeDouble:     true,
+	pc.ColumnTypeClickhouse: true,
+}
+
+// canMigrateMetric returns whether a Metric can be moved to a Data Pool with the given columns instead of being
+// replaced. The columns are nil when the new Data Pool is not known yet, in which case only the Metric type is checked.
+func canMigrateMetric(metricType string, measure string, dimension string, columns map[string]pc.ColumnType) bool {
+	switch metricType {
+	case "CUSTOM":
+		// Custom expressions are validated against the Data Pool the Metric was created on, so they are not migrated.
+		return false
+	case "SUM", "AVERAGE", "MIN", "MAX":
+		if columns == nil {
+			return true
+		}
+
+		columnType, ok := columns[measure]
+		return ok && numericColumnTypes[columnType]
+	case "COUNT_DISTINCT":
+		if columns == nil {
+			return true
+		}
+
+		_, ok := columns[dimension]
+		return ok
+	}
+
+	return true
+}
+
+// customizeDiffMetric checks the Metric against its Data Pool when the Data Pool is known at plan time, so that a
+// wrong expression or column fails the plan rather than the apply. Columns added to the Data Pool in the same apply
+// must be referenced from its resource, so that they are not known and checked until then. It also keeps replacing
+// the Metric when it moves to a Data Pool it cannot be migrated to.
+func customizeDiffMetric(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("data_pool") {
+		return forceNewMetricDataPool(d, nil)
+	}
+
+	if d.Id() != "" && !d.HasChanges("data_pool", "type", "measure", "dimension", "expression", "dimensions", "filter") {
+		return nil
+	}
+
+	c := meta.(graphql.Client)
+	dataPoolId := d.Get("data_pool").(string)
+
+	columns, err := getDataPoolColumnTypes(ctx, c, dataPoolId)
+	if err != nil {
+		return err
+	}
+
+	metricType := d.Get("type").(string)
+
+	var dimensions []any
+	if d.NewValueKnown("dimensions") {
+		dimensions = d.Get("dimensions").([]any)
+	}
+
+	var filters []*pc.FilterInput
+	if d.NewValueKnown("filter") {
+		var diags diag.Diagnostics
+		if filters, diags = expandMetricFilters(d.Get("filter").([]any)); diags.HasError() {
+			return fmt.Errorf("invalid filter: %s", diags[0].Summary)
+		}
+	}
+
+	if err := validateMetricColumns(metricType, d.Get("measure").(string), d.Get("dimension").(string), dimensions, filters, columns); err != nil {
+		return err
+	}
+
+	if expression := d.Get("expression").(string); metricType == "CUSTOM" && d.NewValueKnown("expression") && expression != "" {
+		if err := validateMetricExpression(ctx, c, dataPoolId, expression); err != nil {
+			return err
+		}
+	}
+
+	return forceNewMetricDataPool(d, columns)
+}
+
+// forceNewMetricDataPool keeps replacing the Metric when it moves to a Data Pool it cannot be migrated to.
+func forceNewMetricDataPool(d *schema.ResourceDiff, columns map[string]pc.ColumnType) error {
+	if d.Id() == "" || !d.HasChange("data_pool") {
+		return nil
+	}
+
+	// Changing any of these replaces the Metric anyway.
+	if d.HasChanges("type", "measure", "dimension", "expression") {
+		return nil
+	}
+
+	if canMigrateMetric(d.Get("type").(string), d.Get("measure").(string), d.Get("dimension").(string), columns) {
+		return nil
+	}
+
+	return d.ForceNew("data_pool")
+}
+
+// getDataPoolColumnTypes returns the types of the Data Pool's columns by name.
+func getDataPoolColumnTypes(ctx context.Context, c graphql.Client, dataPoolId string) (map[string]pc.ColumnType, error) {
+	response, err := pc.DataPool(ctx, c, dataPoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.DataPool == nil {
+		return nil, fmt.Errorf("Data Pool \"%s\" %w", dataPoolId, pc.ErrNotFound)
+	}
+
+	columns := make(map[string]pc.ColumnType, len(response.DataPool.Columns.Nodes))
+	for _, column := range response.DataPool.Columns.Nodes {
+		columns[column.ColumnName] = column.Type
+	}
+
+	return columns, nil
+}
+
+// validateMetricColumns checks that the columns the Metric uses exist in its Data Pool. Column names that are not known
+// yet are empty and skipped.
+func validateMetricColumns(metricType string, measure string, dimension string, dimensions []any, filters []*pc.FilterInput, columns map[string]pc.ColumnType) error {
+	errs := make([]error, 0)
+
+	missing := func(name string) bool {
+		_, ok := columns[name]
+		return name != "" && !ok
+	}
+
+	switch metricType {
+	case "SUM", "AVERAGE", "MIN", "MAX":
+		if missing(measure) {
+			errs = append(errs, fmt.Errorf(`measure "%s" is not a column of the Data Pool`, measure))
+		}
+	case "COUNT_DISTINCT":
+		if missing(dimension) {
+			errs = append(errs, fmt.Errorf(`dimension "%s" is not a column of the Data Pool`, dimension))
+		}
+	}
+
+	for _, rawDimension := range dimensions {
+		if name, _ := rawDimension.(string); missing(name) {
+			errs = append(errs, fmt.Errorf(`dimensions: "%s" is not a column of the Data Pool`, name))
+		}
+	}
+
+	reported := make(map[string]bool)
+	for _, name := range filterColumns(filters) {
+		if missing(name) && !reported[name] {
+			errs = append(errs, fmt.Errorf(`filter: "%s" is not a column of the Data Pool`, name))
+			reported[name] = true
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// filterColumns returns the columns of the filters and their nested filters, in order.
+func filterColumns(filters []*pc.FilterInput) []string {
+	columns := make([]string, 0, len(filters))
+
+	for _, filter := range filters {
+		columns = append(columns, filter.Column)
+		columns = append(columns, filterColumns(filter.And)...)
+		columns = append(columns, filterColumns(filter.Or)...)
+	}
+
+	return columns
+}
+
+// validateMetricExpression checks a Custom Metric's expression against the Data Pool, returning the reason it is invalid.
+func validateMetricExpression(ctx context.Context, c graphql.Client, dataPoolId string, expression string) error {
+	response, err := pc.ValidateExpression(ctx, c, dataPoolId, expression)
+	if err != nil {
+		return err
+	}
+
+	if response.DataPool == nil {
+		return fmt.Errorf("Data Pool \"%s\" %w", dataPoolId, pc.ErrNotFound)
+	}
+
+	result := response.DataPool.ValidateExpression
+	if result == nil || result.Valid {
+		return nil
+	}
+
+	if result.Reason == nil {
+		return fmt.Errorf(`expression "%s" is not valid`, expression)
+	}
+
+	return fmt.Errorf(`expression "%s" is not valid: %s`, expression, *result.Reason)
+}
@@ -0,0 +1,289 @@
+package propel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+func Test_canMigrateMetric(t *testing.T) {
+	columns := map[string]pc.ColumnType{
+		"timestamp":   pc.ColumnTypeTimestamp,
+		"account_id":  pc.ColumnTypeString,
+		"price":       pc.ColumnTypeDouble,
+		"quantity":    pc.ColumnTypeClickhouse,
+		"customer_id": pc.ColumnTypeString,
+	}
+
+	tests := []struct {
+		name       string
+		metricType string
+		measure    string
+		dimension  string
+		columns    map[string]pc.ColumnType
+		expected   bool
+	}{
+		{
+			name:       "COUNT Metric",
+			metricType: "COUNT",
+			columns:    columns,
+			expected:   true,
+		},
+		{
+			name:       "SUM Metric with a numeric measure",
+			metricType: "SUM",
+			measure:    "price",
+			columns:    columns,
+			expected:   true,
+		},
+		{
+			name:       "MAX Metric with a ClickHouse measure",
+			metricType: "MAX",
+			measure:    "quantity",
+			columns:    columns,
+			expected:   true,
+		},
+		{
+			name:       "AVERAGE Metric with a non-numeric measure",
+			metricType: "AVERAGE",
+			measure:    "account_id",
+			columns:    columns,
+			expected:   false,
+		},
+		{
+			name:       "MIN Metric with a missing measure",
+			metricType: "MIN",
+			measure:    "discount",
+			columns:    columns,
+			expected:   false,
+		},
+		{
+			name:       "COUNT_DISTINCT Metric with an existing dimension",
+			metricType: "COUNT_DISTINCT",
+			dimension:  "customer_id",
+			columns:    columns,
+			expected:   true,
+		},
+		{
+			name:       "COUNT_DISTINCT Metric with a missing dimension",
+			metricType: "COUNT_DISTINCT",
+			dimension:  "user_id",
+			columns:    columns,
+			expected:   false,
+		},
+		{
+			name:       "CUSTOM Metric",
+			metricType: "CUSTOM",
+			columns:    columns,
+			expected:   false,
+		},
+		{
+			name:       "SUM Metric with an unknown Data Pool",
+			metricType: "SUM",
+			measure:    "discount",
+			expected:   true,
+		},
+		{
+			name:       "CUSTOM Metric with an unknown Data Pool",
+			metricType: "CUSTOM",
+			expected:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(st *testing.T) {
+			a := assert.New(st)
+
+			a.Equal(tt.expected, canMigrateMetric(tt.metricType, tt.measure, tt.dimension, tt.columns))
+		})
+	}
+}
+
+func Test_validateMetricColumns(t *testing.T) {
+	columns := map[string]pc.ColumnType{
+		"timestamp":  pc.ColumnTypeTimestamp,
+		"account_id": pc.ColumnTypeString,
+		"price":      pc.ColumnTypeDouble,
+	}
+
+	tests := []struct {
+		name          string
+		metricType    string
+		measure       string
+		dimension     string
+		dimensions    []any
+		filters       []*pc.FilterInput
+		expectedError string
+	}{
+		{
+			name:       "Existing columns",
+			metricType: "SUM",
+			measure:    "price",
+			dimensions: []any{"account_id"},
+			filters: []*pc.FilterInput{
+				{Column: "account_id", Operator: pc.FilterOperatorIsNotNull, Or: []*pc.FilterInput{{Column: "price", Operator: pc.FilterOperatorIsNull}}},
+			},
+		},
+		{
+			name:          "Missing measure",
+			metricType:    "AVERAGE",
+			measure:       "discount",
+			expectedError: `measure "discount" is not a column of the Data Pool`,
+		},
+		{
+			name:          "Missing count distinct dimension",
+			metricType:    "COUNT_DISTINCT",
+			dimension:     "user_id",
+			expectedError: `dimension "user_id" is not a column of the Data Pool`,
+		},
+		{
+			name:       "Measure is ignored for COUNT Metrics",
+			metricType: "COUNT",
+			measure:    "discount",
+		},
+		{
+			name:       "Unknown columns are skipped",
+			metricType: "MIN",
+			dimensions: []any{""},
+			filters:    []*pc.FilterInput{{Column: "", Operator: pc.FilterOperatorIsNull}},
+		},
+		{
+			name:       "Missing dimensions and nested filter columns",
+			metricType: "COUNT",
+			dimensions: []any{"account_id", "country"},
+			filters: []*pc.FilterInput{
+				{Column: "account_id", Operator: pc.FilterOperatorIsNotNull, And: []*pc.FilterInput{{Column: "status", Operator: pc.FilterOperatorIsNull}}},
+				{Column: "status", Operator: pc.FilterOperatorIsNotNull},
+			},
+			expectedError: "dimensions: \"country\" is not a column of the Data Pool\nfilter: \"status\" is not a column of the Data Pool",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(st *testing.T) {
+			a := assert.New(st)
+
+			err := validateMetricColumns(tt.metricType, tt.measure, tt.dimension, tt.dimensions, tt.filters, columns)
+			if tt.expectedError == "" {
+				a.NoError(err)
+				return
+			}
+
+			a.EqualError(err, tt.expectedError)
+		})
+	}
+}
+
+// fakeMetricDataPoolClient serves a Data Pool with the given columns, and validates the expressions that do not have
+// an invalid reason.
+type fakeMetricDataPoolClient struct {
+	columns []string
+	invalid map[string]string
+
+	validated []string
+}
+
+func (c *fakeMetricDataPoolClient) MakeRequest(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+	var data string
+
+	switch req.OpName {
+	case "DataPool":
+		nodes := make([]string, len(c.columns))
+		for i, column := range c.columns {
+			columnType := "STRING"
+			if column == "price" {
+				columnType = "DOUBLE"
+			}
+
+			nodes[i] = fmt.Sprintf(`{"columnName": "%s", "type": "%s"}`, column, columnType)
+		}
+
+		data = fmt.Sprintf(`{"dataPool": {"id": "DPO00000000000000000000000000", "columns": {"nodes": [%s]}}}`, strings.Join(nodes, ", "))
+	case "ValidateExpression":
+		expression := req.Variables.(interface{ GetExpression() string }).GetExpression()
+		c.validated = append(c.validated, expression)
+
+		result := `{"valid": true, "reason": null}`
+		if reason, ok := c.invalid[expression]; ok {
+			result = fmt.Sprintf(`{"valid": false, "reason": %q}`, reason)
+		}
+
+		data = fmt.Sprintf(`{"dataPool": {"id": "DPO00000000000000000000000000", "validateExpression": %s}}`, result)
+	default:
+		return fmt.Errorf("unexpected operation %s", req.OpName)
+	}
+
+	return json.Unmarshal([]byte(data), resp.Data)
+}
+
+func Test_customizeDiffMetric(t *testing.T) {
+	tests := []struct {
+		name          string
+		config        map[string]any
+		validated     string
+		expectedError string
+	}{
+		{
+			name: "Expression with keywords",
+			config: map[string]any{
+				"type":       "CUSTOM",
+				"expression": "CASE WHEN account_id IS NULL THEN 0 ELSE SUM(price) END",
+			},
+			validated: "CASE WHEN account_id IS NULL THEN 0 ELSE SUM(price) END",
+		},
+		{
+			name: "Invalid expression",
+			config: map[string]any{
+				"type":       "CUSTOM",
+				"expression": "SUM(amount) AND NOT NULL",
+			},
+			validated:     "SUM(amount) AND NOT NULL",
+			expectedError: `expression "SUM(amount) AND NOT NULL" is not valid: Unknown column "amount"`,
+		},
+		{
+			name: "Missing measure",
+			config: map[string]any{
+				"type":    "SUM",
+				"measure": "amount",
+			},
+			expectedError: `measure "amount" is not a column of the Data Pool`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(st *testing.T) {
+			a := assert.New(st)
+
+			client := &fakeMetricDataPoolClient{
+				columns: []string{"timestamp", "account_id", "price"},
+				invalid: map[string]string{"SUM(amount) AND NOT NULL": `Unknown column "amount"`},
+			}
+
+			config := map[string]any{"unique_name": "metric", "data_pool": "DPO00000000000000000000000000"}
+			for k, v := range tt.config {
+				config[k] = v
+			}
+
+			_, err := resourceMetric().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), client)
+			if tt.expectedError == "" {
+				a.NoError(err)
+			} else {
+				a.EqualError(err, tt.expectedError)
+			}
+
+			if tt.validated != "" {
+				a.Contains(client.validated, tt.validated, "the expression should be validated by the Propel API")
+			} else {
+				a.Empty(client.validated)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package propel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// providerServer extends the gRPC server of the SDK with support for `moved` blocks between resource types,
+// which the SDK does not implement. It allows moving a `propel_data_source` to its dedicated resource.
+type providerServer struct {
+	*schema.GRPCProviderServer
+}
+
+func (s *providerServer) GetMetadata(ctx context.Context, req *tfprotov5.GetMetadataRequest) (*tfprotov5.GetMetadataResponse, error) {
+	resp, err := s.GRPCProviderServer.GetMetadata(ctx, req)
+	if resp != nil {
+		resp.ServerCapabilities = withMoveResourceState(resp.ServerCapabilities)
+	}
+
+	return resp, err
+}
+
+func (s *providerServer) GetProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	resp, err := s.GRPCProviderServer.GetProviderSchema(ctx, req)
+	if resp != nil {
+		resp.ServerCapabilities = withMoveResourceState(resp.ServerCapabilities)
+	}
+
+	return resp, err
+}
+
+func (s *providerServer) MoveResourceState(ctx context.Context, req *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error) {
+	resp := &tfprotov5.MoveResourceStateResponse{}
+
+	schemas, err := s.GRPCProviderServer.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	target, ok := schemas.ResourceSchemas[req.TargetTypeName]
+	if !ok {
+		resp.Diagnostics = append(resp.Diagnostics, moveStateError(fmt.Sprintf("Unknown resource type \"%s\".", req.TargetTypeName)))
+		return resp, nil
+	}
+
+	if req.SourceState == nil {
+		resp.Diagnostics = append(resp.Diagnostics, moveStateError("The source resource has no state."))
+		return resp, nil
+	}
+
+	movedState, err := moveDataSourceState(req.SourceProviderAddress, req.SourceTypeName, req.TargetTypeName, req.SourceState.JSON)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, moveStateError(err.Error()))
+		return resp, nil
+	}
+
+	value, err := tftypes.ValueFromJSONWithOpts(movedState, target.ValueType(), tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true})
+	if err != nil {
+		return nil, err
+	}
+
+	targetState, err := tfprotov5.NewDynamicValue(target.ValueType(), value)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.TargetState = &targetState
+	resp.TargetPrivate = req.SourcePrivate
+
+	return resp, nil
+}
+
+// moveDataSourceState converts the JSON state of a `propel_data_source` into the state of the dedicated
+// resource for its kind of Data Source. Only the attributes the dedicated resource has are kept, and the connection
+// settings are moved out of their block.
+func moveDataSourceState(sourceProvider string, sourceType string, targetType string, state []byte) ([]byte, error) {
+	connector, ok := dataSourceConnectors[targetType]
+	if !ok {
+		return nil, fmt.Errorf("moving resources to \"%s\" is not supported", targetType)
+	}
+
+	if sourceType != "propel_data_source" || !strings.HasSuffix(sourceProvider, "propeldata/propel") {
+		return nil, fmt.Errorf("only a propel_data_source can be moved to %s, not a %s", targetType, sourceType)
+	}
+
+	var source map[string]json.RawMessage
+	if err := json.Unmarshal(state, &source); err != nil {
+		return nil, fmt.Errorf("unable to read the state of the propel_data_source: %w", err)
+	}
+
+	var dataSourceType string
+	if err := json.Unmarshal(source["type"], &dataSourceType); err != nil || !strings.EqualFold(dataSourceType, connector.dataSourceType) {
+		return nil, fmt.Errorf("only a propel_data_source of type \"%s\" can be moved to %s, not one of type \"%s\"", connector.dataSourceType, targetType, dataSourceType)
+	}
+
+	target := resourceTypedDataSource(targetType).CoreConfigSchema()
+	moved := make(map[string]json.RawMessage)
+
+	for k, v := range source {
+		_, isAttribute := target.Attributes[k]
+		_, isBlock := target.BlockTypes[k]
+
+		if (isAttribute || isBlock) && k != connector.settingsKey {
+			moved[k] = v
+		}
+	}
+
+	// The connection settings block is flattened into the top-level attributes of the dedicated resource.
+	var settings []map[string]json.RawMessage
+	if raw, ok := source[connector.settingsKey]; ok && string(raw) != "null" {
+		if err := json.Unmarshal(raw, &settings); err != nil {
+			return nil, fmt.Errorf("unable to read the %s of the propel_data_source: %w", connector.settingsKey, err)
+		}
+	}
+
+	conn := connector.settings()
+	for _, s := range settings {
+		for name, v := range s {
+			if attribute, ok := conn.Attributes[name]; ok {
+				moved[attribute] = v
+			}
+		}
+	}
+
+	return json.Marshal(moved)
+}
+
+func withMoveResourceState(capabilities *tfprotov5.ServerCapabilities) *tfprotov5.ServerCapabilities {
+	if capabilities == nil {
+		capabilities = &tfprotov5.ServerCapabilities{}
+	}
+
+	capabilities.MoveResourceState = true
+
+	return capabilities
+}
+
+func moveStateError(detail string) *tfprotov5.Diagnostic {
+	return &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityError,
+		Summary:  "Unable to move resource state",
+		Detail:   detail,
+	}
+}
@@ -0,0 +1,131 @@
+package propel
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/assert"
+)
+
+const testSnowflakeDataSourceState = `{
+	"id": "DSO00000000000000000000000000",
+	"unique_name": "my-snowflake",
+	"description": "",
+	"type": "SNOWFLAKE",
+	"status": "CONNECTED",
+	"account": "ACC00000000000000000000000000",
+	"environment": "ENV00000000000000000000000000",
+	"created_at": "2024-01-01 00:00:00 +0000 UTC",
+	"modified_at": "2024-01-01 00:00:00 +0000 UTC",
+	"created_by": "system",
+	"modified_by": "system",
+	"snowflake_connection_settings": [{
+		"account": "znXXXXX.us-east-2.aws",
+		"database": "DB",
+		"warehouse": "PROPELLING",
+		"schema": "PUBLIC",
+		"role": "PROPELLER",
+		"username": "PROPEL",
+		"password": "secret"
+	}],
+	"http_connection_settings": [],
+	"s3_connection_settings": [],
+	"webhook_connection_settings": [],
+	"kafka_connection_settings": [],
+	"clickhouse_connection_settings": [],
+	"postgresql_connection_settings": [],
+	"table": [],
+	"timeouts": null
+}`
+
+func Test_moveDataSourceState(t *testing.T) {
+	tests := []struct {
+		name           string
+		sourceProvider string
+		sourceType     string
+		targetType     string
+		wantErr        string
+	}{
+		{
+			name:           "Data Source of the matching type",
+			sourceProvider: "registry.terraform.io/propeldata/propel",
+			sourceType:     "propel_data_source",
+			targetType:     "propel_snowflake_data_source",
+		},
+		{
+			name:           "Data Source of another type",
+			sourceProvider: "registry.terraform.io/propeldata/propel",
+			sourceType:     "propel_data_source",
+			targetType:     "propel_kafka_data_source",
+			wantErr:        `only a propel_data_source of type "KAFKA" can be moved to propel_kafka_data_source, not one of type "SNOWFLAKE"`,
+		},
+		{
+			name:           "Unsupported source resource",
+			sourceProvider: "registry.terraform.io/propeldata/propel",
+			sourceType:     "propel_data_pool",
+			targetType:     "propel_snowflake_data_source",
+			wantErr:        "only a propel_data_source can be moved to propel_snowflake_data_source, not a propel_data_pool",
+		},
+		{
+			name:           "Unsupported target resource",
+			sourceProvider: "registry.terraform.io/propeldata/propel",
+			sourceType:     "propel_data_source",
+			targetType:     "propel_data_pool",
+			wantErr:        `moving resources to "propel_data_pool" is not supported`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(st *testing.T) {
+			a := assert.New(st)
+
+			moved, err := moveDataSourceState(tt.sourceProvider, tt.sourceType, tt.targetType, []byte(testSnowflakeDataSourceState))
+			if tt.wantErr != "" {
+				a.EqualError(err, tt.wantErr)
+				return
+			}
+
+			a.NoError(err)
+
+			var state map[string]any
+			a.NoError(json.Unmarshal(moved, &state))
+
+			a.Equal("DSO00000000000000000000000000", state["id"])
+			a.Equal("my-snowflake", state["unique_name"])
+			a.Equal("ACC00000000000000000000000000", state["account"])
+			a.Equal("znXXXXX.us-east-2.aws", state["snowflake_account"])
+			a.Equal("PROPELLING", state["warehouse"])
+			a.Equal("secret", state["password"])
+			a.NotContains(state, "snowflake_connection_settings")
+			a.NotContains(state, "type")
+			a.NotContains(state, "table")
+			a.NotContains(state, "kafka_connection_settings")
+		})
+	}
+}
+
+func Test_providerServerMoveResourceState(t *testing.T) {
+	a := assert.New(t)
+	ctx := context.Background()
+
+	server, err := NewProviderServer(ctx)
+	a.NoError(err)
+
+	s := server()
+
+	schemas, err := s.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	a.NoError(err)
+	a.True(schemas.ServerCapabilities.MoveResourceState)
+
+	resp, err := s.MoveResourceState(ctx, &tfprotov6.MoveResourceStateRequest{
+		SourceProviderAddress: "registry.terraform.io/propeldata/propel",
+		SourceTypeName:        "propel_data_source",
+		SourceState:           &tfprotov6.RawState{JSON: []byte(testSnowflakeDataSourceState)},
+		TargetTypeName:        "propel_snowflake_data_source",
+	})
+	a.NoError(err)
+	a.Empty(resp.Diagnostics)
+	a.NotNil(resp.TargetState)
+}
@@ -4,10 +4,14 @@ import (
 	"context"
 	"fmt"
 	"runtime"
+	"time"
 
+	"github.com/Khan/genqlient/graphql"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/propeldata/terraform-provider-propel/propel/internal"
 	"github.com/propeldata/terraform-provider-propel/propel/internal/utils"
 	pc "github.com/propeldata/terraform-provider-propel/propel_client"
 )
@@ -44,15 +48,83 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("PROPEL_API_URL", nil),
 				Description: "The Propel API URL",
 			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("PROPEL_MAX_RETRIES", 4),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "How many times a request failing with a transient error, such as being rate limited, is retried. Queries are retried on any transient error, while mutations are only retried when the API rejected them without running them. Set to 0 to disable retries. Defaults to 4.",
+			},
+			"max_backoff": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("PROPEL_MAX_BACKOFF", 30),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The maximum number of seconds to wait between two attempts of a retried request. Defaults to 30.",
+			},
+			"request_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("PROPEL_REQUEST_TIMEOUT", 60),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The number of seconds after which a single request to the Propel API is aborted. Each retry of a request gets its own timeout. Set to 0 to disable the timeout. Defaults to 60.",
+			},
+			"proxy_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("PROPEL_PROXY_URL", nil),
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "socks5"}),
+				Description:  "The URL of the proxy to send requests to the Propel API through. If not set, the proxy is taken from the `HTTPS_PROXY` and `NO_PROXY` environment variables.",
+			},
+			"ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("PROPEL_CA_BUNDLE", nil),
+				Description: "The path to a file with PEM encoded CA certificates to trust in addition to the system's certificates, for example when a TLS intercepting proxy is in use.",
+			},
+			"max_parallel_jobs": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("PROPEL_MAX_PARALLEL_JOBS", internal.DefaultMaxParallelJobs),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The maximum number of Jobs, such as the ones adding columns to a Data Pool, that run at once for a single resource. Defaults to 4.",
+			},
+			"poll_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("PROPEL_POLL_INTERVAL", int(internal.DefaultPollInterval.Seconds())),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of seconds to wait between two reads of an object whose status is awaited, such as a Data Pool being set up or a Job running. Defaults to 5.",
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"propel_application":             resourceApplication(),
-			"propel_data_source":             resourceDataSource(),
-			"propel_data_pool":               resourceDataPool(),
-			"propel_data_pool_access_policy": resourceDataPoolAccessPolicy(),
-			"propel_metric":                  resourceMetric(),
-			"propel_policy":                  resourcePolicy(),
-			"propel_materialized_view":       resourceMaterializedView(),
+			"propel_application":                  resourceApplication(),
+			"propel_booster":                      resourceBooster(),
+			"propel_data_source":                  resourceDataSource(),
+			"propel_data_pool":                    resourceDataPool(),
+			"propel_data_pool_access_policy":      resourceDataPoolAccessPolicy(),
+			"propel_data_pool_deletion_job":       resourceDataPoolDeletionJob(),
+			"propel_data_pool_resync":             resourceDataPoolResync(),
+			"propel_data_pool_update_records_job": resourceDataPoolUpdateRecordsJob(),
+			"propel_metric":                       resourceMetric(),
+			"propel_policy":                       resourcePolicy(),
+			"propel_materialized_view":            resourceMaterializedView(),
+			"propel_snowflake_data_source":        resourceTypedDataSource("propel_snowflake_data_source"),
+			"propel_s3_data_source":               resourceTypedDataSource("propel_s3_data_source"),
+			"propel_kafka_data_source":            resourceTypedDataSource("propel_kafka_data_source"),
+			"propel_webhook_data_source":          resourceTypedDataSource("propel_webhook_data_source"),
+			"propel_http_data_source":             resourceTypedDataSource("propel_http_data_source"),
+			"propel_clickhouse_data_source":       resourceTypedDataSource("propel_clickhouse_data_source"),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"propel_application":       dataSourceApplication(),
+			"propel_data_source":       dataSourceDataSource(),
+			"propel_data_sources":      dataSourceDataSources(),
+			"propel_data_pool":         dataSourceDataPool(),
+			"propel_data_pools":        dataSourceDataPools(),
+			"propel_metric":            dataSourceMetric(),
+			"propel_metrics":           dataSourceMetrics(),
+			"propel_materialized_view": dataSourceMaterializedView(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
@@ -82,10 +154,43 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (any, diag.D
 		runtime.GOARCH,
 	))
 
-	c, err := pc.NewPropelClient(clientID, clientSecret, userAgent, d.Get("oauth_url").(string), d.Get("api_url").(string))
+	c, err := pc.NewPropelClient(
+		clientID,
+		clientSecret,
+		userAgent,
+		d.Get("oauth_url").(string),
+		d.Get("api_url").(string),
+		pc.WithRetries(d.Get("max_retries").(int), time.Duration(d.Get("max_backoff").(int))*time.Second),
+		pc.WithRequestTimeout(time.Duration(d.Get("request_timeout").(int))*time.Second),
+		pc.WithProxy(d.Get("proxy_url").(string)),
+		pc.WithCABundle(d.Get("ca_bundle").(string)),
+	)
 	if err != nil {
 		return nil, diag.FromErr(err)
 	}
 
-	return c, nil
+	return &providerClient{
+		Client:          c,
+		maxParallelJobs: d.Get("max_parallel_jobs").(int),
+		pollInterval:    time.Duration(d.Get("poll_interval").(int)) * time.Second,
+	}, nil
+}
+
+// providerClient is the provider's meta. It is the Propel API client, along with the provider settings that the
+// resources need when calling it.
+type providerClient struct {
+	graphql.Client
+
+	maxParallelJobs int
+	pollInterval    time.Duration
+}
+
+// MaxParallelJobs returns how many Jobs run at once for a single resource.
+func (c *providerClient) MaxParallelJobs() int {
+	return c.maxParallelJobs
+}
+
+// PollInterval returns how long to wait between two reads of an object whose status is awaited.
+func (c *providerClient) PollInterval() time.Duration {
+	return c.pollInterval
 }
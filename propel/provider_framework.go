@@ -0,0 +1,100 @@
+package propel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// frameworkProvider serves the resources and data sources migrated to terraform-plugin-framework. It is muxed with
+// the SDK provider, whose settings and client it shares.
+type frameworkProvider struct {
+	sdk *schema.Provider
+}
+
+func newFrameworkProvider(p *schema.Provider) provider.Provider {
+	return &frameworkProvider{sdk: p}
+}
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "propel"
+}
+
+// Schema returns the settings of the SDK provider, since the muxed providers must have the same schema.
+func (p *frameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	s, err := frameworkProviderSchema(p.sdk)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid provider schema", err.Error())
+		return
+	}
+
+	resp.Schema = s
+}
+
+// Configure shares the client of the SDK provider, which the mux server configures first.
+func (p *frameworkProvider) Configure(_ context.Context, _ provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	c, ok := p.sdk.Meta().(graphql.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Provider not configured", "The Propel client must be configured before the resources migrated to terraform-plugin-framework.")
+		return
+	}
+
+	resp.ResourceData = c
+	resp.DataSourceData = c
+}
+
+func (p *frameworkProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		newEnvironmentResource,
+	}
+}
+
+func (p *frameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		newEnvironmentDataSource,
+	}
+}
+
+// frameworkProviderSchema converts the settings of the SDK provider, as they are sent to Terraform, into a
+// framework schema.
+func frameworkProviderSchema(p *schema.Provider) (providerschema.Schema, error) {
+	block := schema.InternalMap(p.Schema).CoreConfigSchema()
+	attributes := make(map[string]providerschema.Attribute, len(block.Attributes))
+
+	for name, a := range block.Attributes {
+		switch a.Type {
+		case cty.String:
+			attributes[name] = providerschema.StringAttribute{
+				Required:    a.Required,
+				Optional:    a.Optional,
+				Sensitive:   a.Sensitive,
+				Description: a.Description,
+			}
+		case cty.Number:
+			attributes[name] = providerschema.Int64Attribute{
+				Required:    a.Required,
+				Optional:    a.Optional,
+				Sensitive:   a.Sensitive,
+				Description: a.Description,
+			}
+		case cty.Bool:
+			attributes[name] = providerschema.BoolAttribute{
+				Required:    a.Required,
+				Optional:    a.Optional,
+				Sensitive:   a.Sensitive,
+				Description: a.Description,
+			}
+		default:
+			return providerschema.Schema{}, fmt.Errorf("the provider setting \"%s\" has the unsupported type %s", name, a.Type.FriendlyName())
+		}
+	}
+
+	return providerschema.Schema{Attributes: attributes}, nil
+}
@@ -8,21 +8,28 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 var (
 	testAccProvider          *schema.Provider
-	testAccProviderFactories map[string]func() (*schema.Provider, error)
+	testAccProviderFactories map[string]func() (tfprotov6.ProviderServer, error)
 )
 
 func init() {
 	testAccProvider = Provider()
-	testAccProviderFactories = map[string]func() (*schema.Provider, error){
-		"propel": func() (*schema.Provider, error) {
-			return testAccProvider, nil
+	testAccProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+		"propel": func() (tfprotov6.ProviderServer, error) {
+			server, err := newProviderServer(context.Background(), testAccProvider)
+			if err != nil {
+				return nil, err
+			}
+
+			return server(), nil
 		},
 	}
 }
@@ -37,6 +44,23 @@ func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = Provider()
 }
 
+func TestProviderServer(t *testing.T) {
+	a := assert.New(t)
+	ctx := context.Background()
+
+	server, err := NewProviderServer(ctx)
+	a.NoError(err)
+
+	// The muxed providers must have the same settings, and each resource must be served by a single provider.
+	resp, err := server().GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	a.NoError(err)
+	a.Empty(resp.Diagnostics)
+	a.Contains(resp.ResourceSchemas, "propel_environment")
+	a.Contains(resp.ResourceSchemas, "propel_data_pool")
+	a.Contains(resp.DataSourceSchemas, "propel_environment")
+	a.Len(resp.Provider.Block.Attributes, len(Provider().Schema))
+}
+
 func testAccPreCheck(t *testing.T) {
 	if v := os.Getenv("PROPEL_CLIENT_ID"); v == "" {
 		t.Fatal("PROPEL_CLIENT_ID must be set for acceptance tests")
@@ -0,0 +1,20 @@
+package propel
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+// readError handles an error from reading a resource's object. An object that was deleted outside of Terraform is
+// removed from the state, so that Terraform plans to create it again. Any other error is reported, including a
+// missing object that was just created or that a data source looks up, since it must exist.
+func readError(d *schema.ResourceData, err error) diag.Diagnostics {
+	if pc.IsNotFound(err) && !d.IsNewResource() {
+		d.SetId("")
+		return nil
+	}
+
+	return diag.FromErr(err)
+}
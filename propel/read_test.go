@@ -0,0 +1,57 @@
+package propel
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+func Test_readError(t *testing.T) {
+	tests := []struct {
+		name          string
+		err           error
+		newResource   bool
+		expectedId    string
+		expectedError bool
+	}{
+		{
+			name:       "Deleted outside of Terraform",
+			err:        fmt.Errorf("Data Pool \"DPO00000000000000000000000000\" %w", pc.ErrNotFound),
+			expectedId: "",
+		},
+		{
+			name:          "Just created",
+			err:           fmt.Errorf("Data Pool \"DPO00000000000000000000000000\" %w", pc.ErrNotFound),
+			newResource:   true,
+			expectedId:    "DPO00000000000000000000000000",
+			expectedError: true,
+		},
+		{
+			name:          "Other error",
+			err:           errors.New("returned error 404 Not Found: page not found"),
+			expectedId:    "DPO00000000000000000000000000",
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(st *testing.T) {
+			a := assert.New(st)
+
+			d := schema.TestResourceDataRaw(st, resourceDataPool().Schema, map[string]any{})
+			d.SetId("DPO00000000000000000000000000")
+			if tt.newResource {
+				d.MarkNewResource()
+			}
+
+			diags := readError(d, tt.err)
+			a.Equal(tt.expectedError, diags.HasError())
+			a.Equal(tt.expectedId, d.Id())
+		})
+	}
+}
@@ -18,9 +18,7 @@ func resourceApplication() *schema.Resource {
 		ReadContext:   resourceApplicationRead,
 		UpdateContext: resourceApplicationUpdate,
 		DeleteContext: resourceApplicationDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importByIDOrName("Application", lookupApplicationID),
 		SchemaVersion: 1,
 		Description:   "Provides a Propel Application resource.",
 		Schema: map[string]*schema.Schema{
@@ -128,7 +126,11 @@ func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta a
 
 	response, err := pc.Application(ctx, c, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return readError(d, err)
+	}
+
+	if response.Application == nil {
+		return readError(d, fmt.Errorf("Application \"%s\" %w", d.Id(), pc.ErrNotFound))
 	}
 
 	d.SetId(response.Application.Id)
@@ -14,9 +14,9 @@ func TestAccPropelApplication(t *testing.T) {
 	t.Parallel()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckPropelApplicationDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckPropelApplicationDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPropelApplicationConfig(),
@@ -25,6 +25,12 @@ func TestAccPropelApplication(t *testing.T) {
 					resource.TestCheckResourceAttr("propel_application.test", "propeller", "P1_SMALL"),
 				),
 			},
+			// should plan to re-create the Application when it is deleted outside of Terraform
+			{
+				Config:             testAccCheckPropelApplicationConfig(),
+				Check:              testAccCheckPropelApplicationDestroy,
+				ExpectNonEmptyPlan: true,
+			},
 		},
 	})
 }
@@ -48,7 +54,7 @@ func testAccCheckPropelApplicationDestroy(s *terraform.State) error {
 
 		applicationID := rs.Primary.ID
 
-		if _, err := pc.DeleteApplication(context.Background(), c, applicationID); err != nil {
+		if _, err := pc.DeleteApplication(context.Background(), c, applicationID); err != nil && !pc.IsNotFound(err) {
 			return err
 		}
 	}
@@ -0,0 +1,170 @@
+package propel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/propeldata/terraform-provider-propel/propel/internal"
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+func resourceBooster() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBoosterCreate,
+		ReadContext:   resourceBoosterRead,
+		DeleteContext: resourceBoosterDelete,
+		Importer:      importByIDOrName("Booster", nil),
+		Description:   "Provides a Propel Booster resource. Boosters optimize Metric Queries for a subset of commonly used Dimensions. Boosters cannot be modified, so any change replaces the Booster.",
+		Schema: map[string]*schema.Schema{
+			"metric": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the Metric the Booster is associated to.",
+			},
+			"dimensions": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Description: "The names of the columns to include in the Booster. Specify them in descending order of importance for filtering and in ascending order of cardinality.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Booster's status.",
+			},
+			"account": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Account that the Booster belongs to.",
+			},
+			"environment": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Environment that the Booster belongs to.",
+			},
+			"record_count": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The number of records in the Booster.",
+			},
+			"size_in_terabytes": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The amount of storage in terabytes used by the Booster.",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+	}
+}
+
+func resourceBoosterCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(graphql.Client)
+
+	input := &pc.CreateBoosterInput{
+		Metric:     d.Get("metric").(string),
+		Dimensions: make([]*pc.DimensionInput, 0),
+	}
+
+	for _, v := range d.Get("dimensions").([]any) {
+		input.Dimensions = append(input.Dimensions, &pc.DimensionInput{ColumnName: v.(string)})
+	}
+
+	response, err := pc.CreateBooster(ctx, c, input)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if response.CreateBooster == nil || response.CreateBooster.Booster == nil {
+		return diag.FromErr(fmt.Errorf("failed to create Booster for Metric \"%s\"", input.Metric))
+	}
+
+	d.SetId(response.CreateBooster.Booster.Id)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if err := internal.WaitForBoosterLive(ctx, c, d.Id(), timeout); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceBoosterRead(ctx, d, meta)
+}
+
+func resourceBoosterRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(graphql.Client)
+
+	response, err := pc.Booster(ctx, c, d.Id())
+	if err != nil {
+		return readError(d, err)
+	}
+
+	if response.Booster == nil {
+		return readError(d, fmt.Errorf("Booster \"%s\" %w", d.Id(), pc.ErrNotFound))
+	}
+
+	d.SetId(response.Booster.Id)
+
+	if err := d.Set("metric", response.Booster.Metric.Id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	dimensions := make([]string, 0, len(response.Booster.Dimensions))
+	for _, dimension := range response.Booster.Dimensions {
+		dimensions = append(dimensions, dimension.ColumnName)
+	}
+
+	if err := d.Set("dimensions", dimensions); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("status", response.Booster.Status); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("account", response.Booster.Account.Id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("environment", response.Booster.Environment.Id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("record_count", response.Booster.RecordCount); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("size_in_terabytes", response.Booster.SizeInTerabytes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceBoosterDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(graphql.Client)
+
+	if _, err := pc.DeleteBooster(ctx, c, d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutDelete)
+	if err := internal.WaitForBoosterDeletion(ctx, c, d.Id(), timeout); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
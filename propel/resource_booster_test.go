@@ -0,0 +1,102 @@
+package propel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+func TestAccPropelBooster(t *testing.T) {
+	ctx := map[string]any{
+		"unique_name": acctest.RandString(12),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckPropelBoosterDestroy,
+		Steps: []resource.TestStep{
+			// should create a Booster and wait for it to be LIVE
+			{
+				Config: testAccCheckPropelBoosterConfig(ctx),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPropelResourceExists("propel_booster.test", "Booster"),
+					resource.TestCheckResourceAttrPair("propel_booster.test", "metric", "propel_metric.baz", "id"),
+					resource.TestCheckResourceAttr("propel_booster.test", "status", "LIVE"),
+					resource.TestCheckResourceAttr("propel_booster.test", "dimensions.#", "2"),
+					resource.TestCheckResourceAttr("propel_booster.test", "dimensions.0", "account_id"),
+				),
+			},
+			// should import the Booster
+			{
+				ResourceName:      "propel_booster.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPropelBoosterConfig(ctx map[string]any) string {
+	// language=hcl-terraform
+	return Nprintf(`
+	resource "propel_data_pool" "bar" {
+		unique_name = "%{unique_name}"
+
+		column {
+			name = "timestamp_tz"
+			type = "TIMESTAMP"
+			nullable = false
+		}
+		column {
+			name = "account_id"
+			type = "STRING"
+			nullable = false
+		}
+		column {
+			name = "country"
+			type = "STRING"
+			nullable = false
+		}
+		column {
+			name = "value"
+			type = "INT64"
+			nullable = false
+		}
+		timestamp = "timestamp_tz"
+	}
+
+	resource "propel_metric" "baz" {
+		unique_name = "%{unique_name}"
+		data_pool   = propel_data_pool.bar.id
+		type        = "SUM"
+		measure     = "value"
+	}
+
+	resource "propel_booster" "test" {
+		metric     = propel_metric.baz.id
+		dimensions = ["account_id", "country"]
+	}`, ctx)
+}
+
+func testAccCheckPropelBoosterDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(graphql.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "propel_booster" {
+			continue
+		}
+
+		if _, err := pc.DeleteBooster(context.Background(), c, rs.Primary.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -18,11 +18,13 @@ import (
 func resourceDataPool() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceDataPoolCreate,
-		ReadContext:   resourceDataPoolRead,
+		ReadContext:   readDefaults(resourceDataPoolRead, map[string]any{"allow_column_recreate": false}),
 		UpdateContext: resourceDataPoolUpdate,
 		DeleteContext: resourceDataPoolDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+		CustomizeDiff: customizeDiffDataPoolColumns,
+		Importer:      importByIDOrName("Data Pool", lookupDataPoolID, importDefaults(map[string]any{"allow_column_recreate": false})),
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(60 * time.Minute),
 		},
 		SchemaVersion: 1,
 		Description:   "Provides a Propel Data Pool resource. This can be used to create and manage Propel Data Pools.",
@@ -132,6 +134,12 @@ func resourceDataPool() *schema.Resource {
 							Computed:    true,
 							Description: "Indicates whether syncing is enabled or disabled.",
 						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether syncing is enabled. Set it to `false` to pause syncing the Data Pool, and back to `true` to resume it.",
+						},
 						"interval": {
 							Type:        schema.TypeString,
 							Required:    true,
@@ -152,6 +160,12 @@ func resourceDataPool() *schema.Resource {
 				Description: "Whether the Data Pool has access control enabled or not. If the Data Pool has access control enabled, Applications must be assigned Data Pool Access Policies in order to query the Data Pool and its Metrics.",
 			},
 			"table_settings": internal.TableSettingsSchema(),
+			"allow_column_recreate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether changing the type or nullability of a column, or removing a column, recreates the Data Pool in place instead of replacing it. When enabled, a new Data Pool with the updated columns is created, the existing records are backfilled into it with a Materialized View, and the previous Data Pool is deleted. The Data Pool's ID changes. Not supported for Data Pools with a Data Source, nor while Metrics, Data Pool Access Policies or Materialized Views use the Data Pool, which would be left pointing at the deleted one.",
+			},
 		},
 	}
 }
@@ -177,9 +191,7 @@ func expandDataPoolColumns(def []any) []*pc.DataPoolColumnInput {
 	return columns
 }
 
-func resourceDataPoolCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	c := meta.(graphql.Client)
-
+func expandCreateDataPoolInput(d *schema.ResourceData) (*pc.CreateDataPoolInputV2, error) {
 	accessControlEnabled := d.Get("access_control_enabled").(bool)
 
 	columns := make([]*pc.DataPoolColumnInput, 0)
@@ -233,7 +245,7 @@ func resourceDataPoolCreate(ctx context.Context, d *schema.ResourceData, meta an
 	if v, exists := d.GetOk("table_settings.0"); exists {
 		s, err := internal.BuildTableSettingsInput(v.(map[string]any))
 		if err != nil {
-			return diag.FromErr(err)
+			return nil, err
 		}
 
 		input.TableSettings = s
@@ -247,6 +259,17 @@ func resourceDataPoolCreate(ctx context.Context, d *schema.ResourceData, meta an
 		}
 	}
 
+	return input, nil
+}
+
+func resourceDataPoolCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(graphql.Client)
+
+	input, err := expandCreateDataPoolInput(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	response, err := pc.CreateDataPool(ctx, c, input)
 	if err != nil {
 		return diag.FromErr(err)
@@ -260,6 +283,12 @@ func resourceDataPoolCreate(ctx context.Context, d *schema.ResourceData, meta an
 		return diag.FromErr(err)
 	}
 
+	if _, exists := d.GetOk("syncing"); exists && !d.Get("syncing.0.enabled").(bool) {
+		if err := internal.SetDataPoolSyncing(ctx, c, d.Id(), false); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceDataPoolRead(ctx, d, meta)
 }
 
@@ -270,7 +299,11 @@ func resourceDataPoolRead(ctx context.Context, d *schema.ResourceData, m any) di
 
 	response, err := pc.DataPool(ctx, c, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return readError(d, err)
+	}
+
+	if response.DataPool == nil {
+		return readError(d, fmt.Errorf("Data Pool \"%s\" %w", d.Id(), pc.ErrNotFound))
 	}
 
 	d.SetId(response.DataPool.Id)
@@ -334,6 +367,7 @@ func resourceDataPoolRead(ctx context.Context, d *schema.ResourceData, m any) di
 
 	syncing := map[string]any{
 		"status":   response.DataPool.Syncing.GetStatus(),
+		"enabled":  response.DataPool.Syncing.GetStatus() == pc.DataPoolSyncStatusEnabled,
 		"interval": response.DataPool.Syncing.GetInterval(),
 	}
 
@@ -369,6 +403,27 @@ func resourceDataPoolRead(ctx context.Context, d *schema.ResourceData, m any) di
 
 func resourceDataPoolUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	c := m.(graphql.Client)
+
+	if d.HasChange("column") && d.Get("allow_column_recreate").(bool) {
+		oldItem, newItem := d.GetChange("column")
+
+		if len(getReplacedDataPoolColumns(oldItem.([]any), newItem.([]any))) > 0 {
+			previousId := d.Id()
+
+			if err := recreateDataPool(ctx, d, c); err != nil {
+				return diag.FromErr(err)
+			}
+
+			diags := resourceDataPoolRead(ctx, d, m)
+
+			return append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Data Pool recreated",
+				Detail:   fmt.Sprintf("The Data Pool \"%s\" was recreated with its new columns and replaced by \"%s\". Resources referencing the previous Data Pool ID are updated on the next plan.", previousId, d.Id()),
+			})
+		}
+	}
+
 	id := d.Id()
 	input := &pc.ModifyDataPoolInput{
 		IdOrUniqueName: &pc.IdOrUniqueName{Id: &id},
@@ -395,25 +450,39 @@ func resourceDataPoolUpdate(ctx context.Context, d *schema.ResourceData, m any)
 		}
 	}
 
-	_, err := pc.ModifyDataPool(ctx, c, input)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
+	// The column changes are validated before modifying the Data Pool, so it is not left partially updated.
+	var newColumns map[string]pc.DataPoolColumnInput
 	if d.HasChange("column") {
 		oldItem, newItem := d.GetChange("column")
 		oldDef, oldOk := oldItem.([]any)
 		newDef, newOk := newItem.([]any)
 
 		if !oldOk || !newOk {
-			diag.FromErr(errors.New("invalid column format"))
+			return diag.FromErr(errors.New("invalid column format"))
 		}
 
-		newColumns, err := getNewDataPoolColumns(oldDef, newDef)
-		if err != nil {
+		if err := validateDataPoolColumns(oldDef, newDef); err != nil {
+			return diag.FromErr(err)
+		}
+
+		var err error
+		if newColumns, err = getNewDataPoolColumns(oldDef, newDef); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	_, err := pc.ModifyDataPool(ctx, c, input)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("syncing.0.enabled") {
+		if err := internal.SetDataPoolSyncing(ctx, c, id, d.Get("syncing.0.enabled").(bool)); err != nil {
 			return diag.FromErr(err)
 		}
+	}
 
+	if len(newColumns) > 0 {
 		if err = addNewDataPoolColumns(ctx, d, c, id, newColumns); err != nil {
 			return diag.FromErr(err)
 		}
@@ -474,29 +543,18 @@ func getNewDataPoolColumns(oldItemDef []any, newItemDef []any) (map[string]pc.Da
 }
 
 func addNewDataPoolColumns(ctx context.Context, d *schema.ResourceData, c graphql.Client, dataPoolId string, newColumns map[string]pc.DataPoolColumnInput) error {
-	for _, newColumn := range newColumns {
-		if !newColumn.IsNullable {
-			return fmt.Errorf(`new column "%s" must be nullable`, newColumn.ColumnName)
-		}
+	inputs := make([]*pc.CreateAddColumnToDataPoolJobInput, 0, len(newColumns))
 
-		response, err := pc.CreateAddColumnToDataPoolJob(ctx, c, &pc.CreateAddColumnToDataPoolJobInput{
+	for _, newColumn := range newColumns {
+		inputs = append(inputs, &pc.CreateAddColumnToDataPoolJobInput{
 			DataPool:             dataPoolId,
 			ColumnName:           newColumn.ColumnName,
 			ColumnType:           newColumn.Type,
 			ColumnClickHouseType: newColumn.ClickHouseType,
 		})
-		if err != nil {
-			return err
-		}
-
-		timeout := d.Timeout(schema.TimeoutUpdate)
-
-		if err = internal.WaitForAddColumnJobSucceeded(ctx, c, response.CreateAddColumnToDataPoolJob.Job.Id, timeout); err != nil {
-			return err
-		}
 	}
 
-	return nil
+	return internal.AddColumnsToDataPool(ctx, c, inputs, d.Timeout(schema.TimeoutUpdate))
 }
 
 func resourceDataPoolDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -2,14 +2,12 @@ package propel
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/Khan/genqlient/graphql"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
-	"github.com/propeldata/terraform-provider-propel/propel/internal/utils"
 	pc "github.com/propeldata/terraform-provider-propel/propel_client"
 )
 
@@ -19,9 +17,7 @@ func resourceDataPoolAccessPolicy() *schema.Resource {
 		ReadContext:   resourceDataPoolAccessPolicyRead,
 		UpdateContext: resourceDataPoolAccessPolicyUpdate,
 		DeleteContext: resourceDataPoolAccessPolicyDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importByIDOrName("Data Pool Access Policy", nil),
 		SchemaVersion: 1,
 		Description:   "Provides a Propel Data Pool Access Policy resource. This can be used to create and manage Propel Data Pool Access Policies.",
 		Schema: map[string]*schema.Schema{
@@ -60,49 +56,18 @@ func resourceDataPoolAccessPolicy() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"row": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: `Row-level filters that the Access Policy applies before executing queries. Not setting any row filters means all rows can be queried.`,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"column": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The name of the column to filter on.",
-						},
-						"operator": {
-							Type:         schema.TypeString,
-							Required:     true,
-							Description:  "The operation to perform when comparing the column and filter values.",
-							ValidateFunc: utils.IsValidOperator,
-						},
-						"value": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "The value to compare the column to.",
-						},
-						"and": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Description:  "Additional filters to AND with this one. AND takes precedence over OR. It is defined as a JSON string value.",
-							ValidateFunc: validation.StringIsJSON,
-							StateFunc: func(v any) string {
-								nJSON, _ := structure.NormalizeJsonString(v)
-								return nJSON
-							},
-						},
-						"or": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Description:  "Additional filters to OR with this one. AND takes precedence over OR. It is defined as a JSON string value.",
-							ValidateFunc: validation.StringIsJSON,
-							StateFunc: func(v any) string {
-								nJSON, _ := structure.NormalizeJsonString(v)
-								return nJSON
-							},
-						},
-					},
-				},
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"filter_sql"},
+				Description:   `Row-level filters that the Access Policy applies before executing queries. Not setting any row filters means all rows can be queried.`,
+				Elem:          &schema.Resource{Schema: filterSchema()},
+			},
+			"filter_sql": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"row"},
+				DiffSuppressFunc: suppressEquivalentSQL,
+				Description:      "Row-level filters that the Access Policy applies before executing queries, in the form of SQL, e.g. `tenant_id IN ('a', 'b') AND (region = 'us' OR shared = true)`. It is an alternative to the `row` blocks that supports `IN` lists and nested conditions. Whitespace differences are ignored.",
 			},
 			"applications": {
 				Type:        schema.TypeSet,
@@ -112,13 +77,12 @@ func resourceDataPoolAccessPolicy() *schema.Resource {
 			},
 		},
 	}
+
 }
 
 func resourceDataPoolAccessPolicyCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	c := meta.(graphql.Client)
 
-	var diags diag.Diagnostics
-
 	dataPoolId := d.Get("data_pool").(string)
 	uniqueName := d.Get("unique_name").(string)
 	description := d.Get("description").(string)
@@ -130,12 +94,9 @@ func resourceDataPoolAccessPolicyCreate(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
-	rows := make([]*pc.FilterInput, 0)
-	if def, ok := d.Get("row").([]any); ok && len(def) > 0 {
-		rows, diags = expandMetricFilters(def)
-		if diags != nil {
-			return diags
-		}
+	rows, filterSQL, diags := expandFilters(d, "row")
+	if diags != nil {
+		return diags
 	}
 
 	createPolicyInput := &pc.CreateDataPoolAccessPolicyInput{
@@ -144,6 +105,7 @@ func resourceDataPoolAccessPolicyCreate(ctx context.Context, d *schema.ResourceD
 		DataPool:    dataPoolId,
 		Columns:     columns,
 		Rows:        rows,
+		FilterSql:   filterSQL,
 	}
 
 	response, err := pc.CreateDataPoolAccessPolicy(ctx, c, createPolicyInput)
@@ -170,7 +132,11 @@ func resourceDataPoolAccessPolicyRead(ctx context.Context, d *schema.ResourceDat
 
 	response, err := pc.DataPoolAccessPolicy(ctx, c, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return readError(d, err)
+	}
+
+	if response.DataPoolAccessPolicy == nil {
+		return readError(d, fmt.Errorf("Data Pool Access Policy \"%s\" %w", d.Id(), pc.ErrNotFound))
 	}
 
 	d.SetId(response.DataPoolAccessPolicy.Id)
@@ -204,7 +170,7 @@ func resourceDataPoolAccessPolicyRead(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	if err := d.Set("row", rows); err != nil {
+	if err := setFilters(d, "row", rows, response.DataPoolAccessPolicy.FilterSql); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -225,9 +191,7 @@ func resourceDataPoolAccessPolicyRead(ctx context.Context, d *schema.ResourceDat
 func resourceDataPoolAccessPolicyUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	c := m.(graphql.Client)
 
-	var diags diag.Diagnostics
-
-	if d.HasChanges("unique_name", "description", "data_pool", "columns", "row") {
+	if d.HasChanges("unique_name", "description", "data_pool", "columns", "row", "filter_sql") {
 		id := d.Id()
 		uniqueName := d.Get("unique_name").(string)
 		description := d.Get("description").(string)
@@ -239,12 +203,9 @@ func resourceDataPoolAccessPolicyUpdate(ctx context.Context, d *schema.ResourceD
 			}
 		}
 
-		rows := make([]*pc.FilterInput, 0)
-		if def, ok := d.Get("row").([]any); ok && len(def) > 0 {
-			rows, diags = expandMetricFilters(def)
-			if diags != nil {
-				return diags
-			}
+		rows, filterSQL, diags := expandFilters(d, "row")
+		if diags != nil {
+			return diags
 		}
 
 		input := &pc.ModifyDataPoolAccessPolicyInput{
@@ -253,6 +214,7 @@ func resourceDataPoolAccessPolicyUpdate(ctx context.Context, d *schema.ResourceD
 			Description: &description,
 			Columns:     columns,
 			Rows:        rows,
+			FilterSql:   filterSQL,
 		}
 
 		_, err := pc.ModifyDataPoolAccessPolicy(ctx, c, input)
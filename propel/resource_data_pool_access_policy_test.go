@@ -16,6 +16,12 @@ import (
 func TestAccPropelDataPoolAccessPolicyBasic(t *testing.T) {
 	ctx := map[string]any{
 		"unique_name": acctest.RandString(11),
+		// language=hcl-terraform
+		"filters": `
+		row {
+			column   = "account_id"
+			operator = "IS_NOT_NULL"
+		}`,
 	}
 
 	if v := os.Getenv("PROPEL_CLIENT_ID"); v != "" {
@@ -23,9 +29,9 @@ func TestAccPropelDataPoolAccessPolicyBasic(t *testing.T) {
 	}
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckPropelDataPoolAccessPolicyDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckPropelDataPoolAccessPolicyDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPropelDataPoolAccessPolicyConfigBasic(ctx),
@@ -34,8 +40,31 @@ func TestAccPropelDataPoolAccessPolicyBasic(t *testing.T) {
 					resource.TestCheckResourceAttr("propel_data_pool.bar", "access_control_enabled", "true"),
 					resource.TestCheckResourceAttr("propel_data_pool_access_policy.baz", "unique_name", ctx["unique_name"].(string)),
 					resource.TestCheckResourceAttr("propel_data_pool_access_policy.baz", "description", "This is an example of a Data Pool Access Policy"),
+					resource.TestCheckResourceAttr("propel_data_pool_access_policy.baz", "row.#", "1"),
+					resource.TestCheckResourceAttr("propel_data_pool_access_policy.baz", "filter_sql", ""),
+				),
+			},
+			// should replace the row filters with a SQL filter
+			{
+				Config: testAccCheckPropelDataPoolAccessPolicyConfigBasic(map[string]any{
+					"unique_name": ctx["unique_name"],
+					"app_id":      ctx["app_id"],
+					"filters":     `filter_sql = "account_id IN ('a', 'b') OR (account_id IS NULL)"`,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("propel_data_pool_access_policy.baz", "row.#", "0"),
+					resource.TestCheckResourceAttrSet("propel_data_pool_access_policy.baz", "filter_sql"),
 				),
 			},
+			// should not plan changes when only the SQL filter's whitespace changes
+			{
+				Config: testAccCheckPropelDataPoolAccessPolicyConfigBasic(map[string]any{
+					"unique_name": ctx["unique_name"],
+					"app_id":      ctx["app_id"],
+					"filters":     `filter_sql = "account_id IN ('a', 'b')\n  OR ( account_id IS NULL )"`,
+				}),
+				PlanOnly: true,
+			},
 		},
 	})
 }
@@ -98,10 +127,7 @@ func testAccCheckPropelDataPoolAccessPolicyConfigBasic(ctx map[string]any) strin
 		
 		columns = ["*"]
 
-		row {
-		    column   = "account_id"
-			operator = "IS_NOT_NULL"
-		}
+		%{filters}
 
 		applications = ["%{app_id}"]
 	}`, ctx)
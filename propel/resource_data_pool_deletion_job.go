@@ -0,0 +1,122 @@
+package propel
+
+import (
+	"context"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/propeldata/terraform-provider-propel/propel/internal"
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+func resourceDataPoolDeletionJob() *schema.Resource {
+	rs := jobSchema()
+	rs["data_pool"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The ID of the Data Pool to delete records from.",
+	}
+	rs["filter_sql"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "The filters that select the records to delete, in the form of SQL, e.g. `tenant_id = 'acme'`.",
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceDataPoolDeletionJobCreate,
+		ReadContext:   resourceDataPoolDeletionJobRead,
+		DeleteContext: resourceDataPoolDeletionJobDelete,
+		Description: "Provides a Propel Deletion Job resource. Creating it deletes the records of a Data Pool that match the filters and waits for the Job to complete. " +
+			"Changing any of its arguments runs a new Deletion Job.\n\n" +
+			"Destroying this resource does not restore the deleted records.",
+		Schema: rs,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+	}
+}
+
+func resourceDataPoolDeletionJobCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(graphql.Client)
+
+	filterSql := d.Get("filter_sql").(string)
+
+	response, err := pc.CreateDeletionJob(ctx, c, &pc.CreateDeletionJobInput{
+		DataPool:  d.Get("data_pool").(string),
+		FilterSql: &filterSql,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(response.CreateDeletionJob.Job.Id)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	job, err := internal.WaitForDeletionJob(ctx, c, d.Id(), timeout)
+
+	// The Job's progress and error are kept in the state even if it failed.
+	if job != nil {
+		if err := deletionJobAttributes(job).set(d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceDataPoolDeletionJobRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(graphql.Client)
+
+	// The Job already ran, so the last known state is kept if it can no longer be found.
+	response, err := pc.DeletionJob(ctx, c, d.Id())
+	if err != nil {
+		if pc.IsNotFound(err) {
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
+
+	if response.DeletionJob == nil {
+		return nil
+	}
+
+	// The arguments are not read back, since a difference would run the Job again.
+	if err := deletionJobAttributes(&response.DeletionJob.DeletionJobData).set(d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceDataPoolDeletionJobDelete(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+func deletionJobAttributes(job *pc.DeletionJobData) jobAttributes {
+	attributes := jobAttributes{
+		status:      job.Status,
+		progress:    job.Progress,
+		startedAt:   job.StartedAt,
+		succeededAt: job.SucceededAt,
+		failedAt:    job.FailedAt,
+	}
+
+	if job.Error != nil {
+		attributes.error = job.Error.Message
+	}
+
+	return attributes
+}
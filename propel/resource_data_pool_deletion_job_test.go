@@ -0,0 +1,58 @@
+package propel
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPropelDataPoolDeletionJob(t *testing.T) {
+	ctx := map[string]any{
+		"unique_name": acctest.RandString(12),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckPropelDataPoolDestroy,
+		Steps: []resource.TestStep{
+			// should delete the matching records and wait for the Job to succeed
+			{
+				Config: testAccCheckPropelDataPoolDeletionJobConfig(ctx),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPropelResourceExists("propel_data_pool_deletion_job.test", "Deletion Job"),
+					resource.TestCheckResourceAttr("propel_data_pool_deletion_job.test", "status", "SUCCEEDED"),
+					resource.TestCheckResourceAttr("propel_data_pool_deletion_job.test", "progress", "1"),
+					resource.TestCheckResourceAttr("propel_data_pool_deletion_job.test", "error", ""),
+					resource.TestCheckResourceAttrSet("propel_data_pool_deletion_job.test", "succeeded_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPropelDataPoolDeletionJobConfig(ctx map[string]any) string {
+	// language=hcl-terraform
+	return Nprintf(`
+	resource "propel_data_pool" "bar" {
+		unique_name = "%{unique_name}"
+
+		column {
+			name = "timestamp_tz"
+			type = "TIMESTAMP"
+			nullable = false
+		}
+		column {
+			name = "account_id"
+			type = "STRING"
+			nullable = false
+		}
+		timestamp = "timestamp_tz"
+	}
+
+	resource "propel_data_pool_deletion_job" "test" {
+		data_pool  = propel_data_pool.bar.id
+		filter_sql = "account_id = 'deleted-account'"
+	}`, ctx)
+}
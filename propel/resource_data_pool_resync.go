@@ -0,0 +1,132 @@
+package propel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/propeldata/terraform-provider-propel/propel/internal"
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+func resourceDataPoolResync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDataPoolResyncCreate,
+		ReadContext:   resourceDataPoolResyncRead,
+		DeleteContext: resourceDataPoolResyncDelete,
+		Description: "Provides a Propel Data Pool resync trigger. Creating it re-syncs all the records of a Data Pool from its Data Source and waits for the Sync to finish. " +
+			"Changing any of the `triggers` starts a new resync, which can be used to re-ingest a Data Pool when its source data changes as part of a deploy.\n\n" +
+			"Destroying this resource does not affect the Data Pool.",
+		Schema: map[string]*schema.Schema{
+			"data_pool": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the Data Pool to resync.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary values that, when changed, start a new resync of the Data Pool.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the Sync.",
+			},
+			"processed_records": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The number of new, updated, and deleted records contained within the Sync.",
+			},
+			"started_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time in UTC when the Sync started.",
+			},
+			"succeeded_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time in UTC when the Sync succeeded.",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+	}
+}
+
+func resourceDataPoolResyncCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(graphql.Client)
+
+	dataPoolId := d.Get("data_pool").(string)
+
+	response, err := pc.ResyncDataPool(ctx, c, dataPoolId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if response.ResyncDataPool == nil {
+		return diag.FromErr(fmt.Errorf("failed to resync Data Pool \"%s\"", dataPoolId))
+	}
+
+	d.SetId(response.ResyncDataPool.Id)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if err := internal.WaitForSyncSucceeded(ctx, c, d.Id(), timeout); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDataPoolResyncRead(ctx, d, meta)
+}
+
+func resourceDataPoolResyncRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(graphql.Client)
+
+	// Syncs are eventually removed by Propel. The resync already happened, so the last known state is kept.
+	response, err := pc.Sync(ctx, c, d.Id())
+	if err != nil {
+		if pc.IsNotFound(err) {
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
+
+	if response.Sync == nil {
+		return nil
+	}
+
+	if err := d.Set("status", response.Sync.Status); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("processed_records", response.Sync.ProcessedRecords); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if response.Sync.StartedAt != nil {
+		if err := d.Set("started_at", response.Sync.StartedAt.Format(time.RFC3339)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if response.Sync.SucceededAt != nil {
+		if err := d.Set("succeeded_at", response.Sync.SucceededAt.Format(time.RFC3339)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceDataPoolResyncDelete(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
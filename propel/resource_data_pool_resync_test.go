@@ -0,0 +1,71 @@
+package propel
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPropelDataPoolResync(t *testing.T) {
+	ctx := map[string]any{
+		"unique_name": acctest.RandString(12),
+		"trigger":     "v1",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckPropelDataPoolDestroy,
+		Steps: []resource.TestStep{
+			// should resync the Data Pool and wait for the Sync to succeed
+			{
+				Config: testAccCheckPropelDataPoolResyncConfig(ctx),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPropelResourceExists("propel_data_pool_resync.test", "Sync"),
+					resource.TestCheckResourceAttrPair("propel_data_pool_resync.test", "data_pool", "propel_data_pool.bar", "id"),
+					resource.TestCheckResourceAttr("propel_data_pool_resync.test", "status", "SUCCEEDED"),
+				),
+			},
+			// should start a new resync when a trigger changes
+			{
+				Config: testAccCheckPropelDataPoolResyncConfig(map[string]any{
+					"unique_name": ctx["unique_name"],
+					"trigger":     "v2",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("propel_data_pool_resync.test", "triggers.schema_version", "v2"),
+					resource.TestCheckResourceAttr("propel_data_pool_resync.test", "status", "SUCCEEDED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPropelDataPoolResyncConfig(ctx map[string]any) string {
+	// language=hcl-terraform
+	return Nprintf(`
+	resource "propel_data_pool" "bar" {
+		unique_name = "%{unique_name}"
+
+		column {
+			name = "timestamp_tz"
+			type = "TIMESTAMP"
+			nullable = false
+		}
+		column {
+			name = "account_id"
+			type = "STRING"
+			nullable = false
+		}
+		timestamp = "timestamp_tz"
+	}
+
+	resource "propel_data_pool_resync" "test" {
+		data_pool = propel_data_pool.bar.id
+
+		triggers = {
+			schema_version = "%{trigger}"
+		}
+	}`, ctx)
+}
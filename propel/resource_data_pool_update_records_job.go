@@ -0,0 +1,165 @@
+package propel
+
+import (
+	"context"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/propeldata/terraform-provider-propel/propel/internal"
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+func resourceDataPoolUpdateRecordsJob() *schema.Resource {
+	rs := jobSchema()
+	rs["data_pool"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The ID of the Data Pool whose records are updated.",
+	}
+	rs["filter_sql"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "The filters that select the records to update, in the form of SQL, e.g. `tenant_id = 'acme'`. If not set, all the records are updated.",
+	}
+	rs["set"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Required:    true,
+		ForceNew:    true,
+		MinItems:    1,
+		Description: "The columns to update and the values to update them to.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"column": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "The name of the column to update.",
+				},
+				"expression": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "The SQL expression the column is updated to, e.g. `counter + 1` or `concat(first_name, ' ', last_name)`. Once evaluated, it must be of the same type as the column.",
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceDataPoolUpdateRecordsJobCreate,
+		ReadContext:   resourceDataPoolUpdateRecordsJobRead,
+		DeleteContext: resourceDataPoolUpdateRecordsJobDelete,
+		Description: "Provides a Propel Update Data Pool Records Job resource. Creating it updates the records of a Data Pool that match the filters and waits for the Job to complete. " +
+			"Changing any of its arguments runs a new Update Data Pool Records Job.\n\n" +
+			"Destroying this resource does not revert the updated records.",
+		Schema: rs,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+	}
+}
+
+func resourceDataPoolUpdateRecordsJobCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(graphql.Client)
+
+	input := &pc.CreateUpdateDataPoolRecordsJobInput{
+		DataPool: d.Get("data_pool").(string),
+		Set:      expandUpdateDataPoolRecordsJobSet(d.Get("set").([]any)),
+	}
+
+	if v, ok := d.GetOk("filter_sql"); ok && v.(string) != "" {
+		filterSql := v.(string)
+		input.FilterSql = &filterSql
+	}
+
+	response, err := pc.CreateUpdateDataPoolRecordsJob(ctx, c, input)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(response.CreateUpdateDataPoolRecordsJob.Job.Id)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	job, err := internal.WaitForUpdateDataPoolRecordsJob(ctx, c, d.Id(), timeout)
+
+	// The Job's progress and error are kept in the state even if it failed.
+	if job != nil {
+		if err := updateDataPoolRecordsJobAttributes(job).set(d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceDataPoolUpdateRecordsJobRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	c := meta.(graphql.Client)
+
+	// The Job already ran, so the last known state is kept if it can no longer be found.
+	response, err := pc.UpdateDataPoolRecordsJob(ctx, c, d.Id())
+	if err != nil {
+		if pc.IsNotFound(err) {
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
+
+	if response.UpdateDataPoolRecordsJob == nil {
+		return nil
+	}
+
+	// The arguments are not read back, since a difference would run the Job again.
+	if err := updateDataPoolRecordsJobAttributes(&response.UpdateDataPoolRecordsJob.UpdateDataPoolRecordsJobData).set(d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceDataPoolUpdateRecordsJobDelete(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+func expandUpdateDataPoolRecordsJobSet(def []any) []*pc.UpdateDataPoolRecordsJobSetColumnInput {
+	set := make([]*pc.UpdateDataPoolRecordsJobSetColumnInput, len(def))
+
+	for i, rawColumn := range def {
+		column := rawColumn.(map[string]any)
+
+		set[i] = &pc.UpdateDataPoolRecordsJobSetColumnInput{
+			Column:     column["column"].(string),
+			Expression: column["expression"].(string),
+		}
+	}
+
+	return set
+}
+
+func updateDataPoolRecordsJobAttributes(job *pc.UpdateDataPoolRecordsJobData) jobAttributes {
+	attributes := jobAttributes{
+		status:      job.Status,
+		progress:    job.Progress,
+		startedAt:   job.StartedAt,
+		succeededAt: job.SucceededAt,
+		failedAt:    job.FailedAt,
+	}
+
+	if job.Error != nil {
+		attributes.error = job.Error.Message
+	}
+
+	return attributes
+}
@@ -0,0 +1,79 @@
+package propel
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+func TestAccPropelDataPoolUpdateRecordsJob(t *testing.T) {
+	ctx := map[string]any{
+		"unique_name": acctest.RandString(12),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckPropelDataPoolDestroy,
+		Steps: []resource.TestStep{
+			// should update the matching records and wait for the Job to succeed
+			{
+				Config: testAccCheckPropelDataPoolUpdateRecordsJobConfig(ctx),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPropelResourceExists("propel_data_pool_update_records_job.test", "Update Data Pool Records Job"),
+					resource.TestCheckResourceAttr("propel_data_pool_update_records_job.test", "status", "SUCCEEDED"),
+					resource.TestCheckResourceAttr("propel_data_pool_update_records_job.test", "progress", "1"),
+					resource.TestCheckResourceAttrSet("propel_data_pool_update_records_job.test", "succeeded_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPropelDataPoolUpdateRecordsJobConfig(ctx map[string]any) string {
+	// language=hcl-terraform
+	return Nprintf(`
+	resource "propel_data_pool" "bar" {
+		unique_name = "%{unique_name}"
+
+		column {
+			name = "timestamp_tz"
+			type = "TIMESTAMP"
+			nullable = false
+		}
+		column {
+			name = "account_id"
+			type = "STRING"
+			nullable = false
+		}
+		timestamp = "timestamp_tz"
+	}
+
+	resource "propel_data_pool_update_records_job" "test" {
+		data_pool  = propel_data_pool.bar.id
+		filter_sql = "account_id = 'old-account'"
+
+		set {
+			column     = "account_id"
+			expression = "'new-account'"
+		}
+	}`, ctx)
+}
+
+func Test_expandUpdateDataPoolRecordsJobSet(t *testing.T) {
+	a := assert.New(t)
+
+	set := expandUpdateDataPoolRecordsJobSet([]any{
+		map[string]any{"column": "counter", "expression": "counter + 1"},
+		map[string]any{"column": "full_name", "expression": "concat(first_name, ' ', last_name)"},
+	})
+
+	a.Equal([]*pc.UpdateDataPoolRecordsJobSetColumnInput{
+		{Column: "counter", Expression: "counter + 1"},
+		{Column: "full_name", Expression: "concat(first_name, ' ', last_name)"},
+	}, set)
+}
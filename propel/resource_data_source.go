@@ -8,7 +8,7 @@ import (
 
 	"github.com/Khan/genqlient/graphql"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -20,14 +20,13 @@ import (
 func resourceDataSource() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceDataSourceCreate,
-		ReadContext:   resourceDataSourceRead,
+		ReadContext:   readDefaults(resourceDataSourceRead, map[string]any{"run_checks_on_plan": false}),
 		UpdateContext: resourceDataSourceUpdate,
 		DeleteContext: resourceDataSourceDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		CustomizeDiff: customdiff.Sequence(customizeDiffWebhookColumns(internal.NestedSettings("webhook_connection_settings")), customizeDiffDataSourceChecks),
+		Importer:      importByIDOrName("Data Source", lookupDataSourceID, importDefaults(map[string]any{"run_checks_on_plan": false})),
 		SchemaVersion: 1,
-		Description:   "Provides a Propel Data Source resource. This can be used to create and manage Propel Data Sources.",
+		Description:   "Provides a Propel Data Source resource. This can be used to create and manage Propel Data Sources. Each type of Data Source also has a dedicated resource, such as `propel_snowflake_data_source`, which validates its connection settings at plan time.",
 		Schema: map[string]*schema.Schema{
 			"unique_name": {
 				Type:        schema.TypeString,
@@ -51,8 +50,9 @@ func resourceDataSource() *schema.Resource {
 					"WEBHOOK",
 					"KAFKA",
 					"CLICKHOUSE",
+					"POSTGRESQL",
 				}, true),
-				Description: "The Data Source's type. Depending on this, you will need to specify one of `snowflake_connection_settings`, `s3_connection_settings`, `http_connection_settings`, `webhook_connection_settings`, `kafka_connection_settings` or `clickhouse_connection_settings`. The valid values are `SNOWFLAKE`, `S3`, `HTTP`, `WEBHOOK`, `KAFKA` and `CLICKHOUSE`",
+				Description: "The Data Source's type. Depending on this, you will need to specify one of `snowflake_connection_settings`, `s3_connection_settings`, `http_connection_settings`, `webhook_connection_settings`, `kafka_connection_settings`, `clickhouse_connection_settings` or `postgresql_connection_settings`. The valid values are `SNOWFLAKE`, `S3`, `HTTP`, `WEBHOOK`, `KAFKA`, `CLICKHOUSE` and `POSTGRESQL`",
 			},
 			"status": {
 				Type:        schema.TypeString,
@@ -95,6 +95,8 @@ func resourceDataSource() *schema.Resource {
 			"webhook_connection_settings":    internal.WebhookDataSourceSchema(),
 			"kafka_connection_settings":      internal.KafkaDataSourceSchema(),
 			"clickhouse_connection_settings": internal.ClickHouseDataSourceSchema(),
+			"postgresql_connection_settings": internal.PostgreSqlDataSourceSchema(),
+			"run_checks_on_plan":             dataSourceRunChecksOnPlanSchema(),
 			"table": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -164,17 +166,19 @@ func resourceDataSourceCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	switch strings.ToUpper(dataSourceType) {
 	case "SNOWFLAKE":
-		id, err = internal.SnowflakeDataSourceCreate(ctx, d, c)
+		id, err = internal.SnowflakeDataSourceCreate(ctx, d, c, internal.NestedSettings("snowflake_connection_settings"))
 	case "HTTP":
-		id, err = internal.HttpDataSourceCreate(ctx, d, c)
+		id, err = internal.HttpDataSourceCreate(ctx, d, c, internal.NestedSettings("http_connection_settings"))
 	case "S3":
-		id, err = internal.S3DataSourceCreate(ctx, d, c)
+		id, err = internal.S3DataSourceCreate(ctx, d, c, internal.NestedSettings("s3_connection_settings"))
 	case "WEBHOOK":
-		id, err = internal.WebhookDataSourceCreate(ctx, d, c)
+		id, err = internal.WebhookDataSourceCreate(ctx, d, c, internal.NestedSettings("webhook_connection_settings"))
 	case "KAFKA":
-		id, err = internal.KafkaDataSourceCreate(ctx, d, c)
+		id, err = internal.KafkaDataSourceCreate(ctx, d, c, internal.NestedSettings("kafka_connection_settings"))
 	case "CLICKHOUSE":
-		id, err = internal.ClickHouseDataSourceCreate(ctx, d, c)
+		id, err = internal.ClickHouseDataSourceCreate(ctx, d, c, internal.NestedSettings("clickhouse_connection_settings"))
+	case "POSTGRESQL":
+		id, err = internal.PostgreSqlDataSourceCreate(ctx, d, c, internal.NestedSettings("postgresql_connection_settings"))
 	default:
 		err = fmt.Errorf("unsupported Data Source type \"%v\"", dataSourceType)
 	}
@@ -187,7 +191,7 @@ func resourceDataSourceCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	timeout := d.Timeout(schema.TimeoutCreate)
 	if err := waitForDataSourceConnected(ctx, c, id, timeout); err != nil {
-		return diag.FromErr(err)
+		return dataSourceDiagnostics(err)
 	}
 
 	return resourceDataSourceRead(ctx, d, meta)
@@ -198,16 +202,14 @@ func resourceDataSourceRead(ctx context.Context, d *schema.ResourceData, m any)
 
 	response, err := pc.DataSource(ctx, c, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return readError(d, err)
 	}
 
-	d.SetId(response.DataSource.Id)
-
-	if err := d.Set("unique_name", response.DataSource.GetUniqueName()); err != nil {
-		return diag.FromErr(err)
+	if response.DataSource == nil {
+		return readError(d, fmt.Errorf("Data Source \"%s\" %w", d.Id(), pc.ErrNotFound))
 	}
 
-	if err := d.Set("description", response.DataSource.GetDescription()); err != nil {
+	if err := setDataSourceAttributes(d, response); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -218,53 +220,70 @@ func resourceDataSourceRead(ctx context.Context, d *schema.ResourceData, m any)
 		}
 	}
 
-	if err := d.Set("created_at", response.DataSource.GetCreatedAt().String()); err != nil {
+	switch strings.ToUpper(dataSourceType) {
+	case "SNOWFLAKE":
+		err = internal.HandleSnowflakeConnectionSettings(response, d, internal.NestedSettings("snowflake_connection_settings"))
+	case "HTTP":
+		err = internal.HandleHttpConnectionSettings(response, d, internal.NestedSettings("http_connection_settings"))
+	case "S3":
+		err = internal.HandleS3ConnectionSettings(response, d, internal.NestedSettings("s3_connection_settings"))
+	case "WEBHOOK":
+		err = internal.HandleWebhookConnectionSettings(response, d, internal.NestedSettings("webhook_connection_settings"))
+	case "KAFKA":
+		err = internal.HandleKafkaConnectionSettings(response, d, internal.NestedSettings("kafka_connection_settings"))
+	case "CLICKHOUSE":
+		err = internal.HandleClickHouseConnectionSettings(response, d, internal.NestedSettings("clickhouse_connection_settings"))
+	case "POSTGRESQL":
+		err = internal.HandlePostgreSqlConnectionSettings(response, d, internal.NestedSettings("postgresql_connection_settings"))
+	default:
+		err = fmt.Errorf("unsupported Data Source type \"%v\"", dataSourceType)
+	}
+
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	return nil
+}
+
+// setDataSourceAttributes sets the attributes shared by every kind of Data Source resource.
+func setDataSourceAttributes(d *schema.ResourceData, response *pc.DataSourceResponse) error {
+	d.SetId(response.DataSource.Id)
+
+	if err := d.Set("unique_name", response.DataSource.GetUniqueName()); err != nil {
+		return err
+	}
+
+	if err := d.Set("description", response.DataSource.GetDescription()); err != nil {
+		return err
+	}
+
+	if err := d.Set("created_at", response.DataSource.GetCreatedAt().String()); err != nil {
+		return err
+	}
+
 	if err := d.Set("created_by", response.DataSource.GetCreatedBy()); err != nil {
-		return diag.FromErr(err)
+		return err
 	}
 
 	if err := d.Set("modified_at", response.DataSource.GetModifiedAt().String()); err != nil {
-		return diag.FromErr(err)
+		return err
 	}
 
 	if err := d.Set("modified_by", response.DataSource.GetModifiedBy()); err != nil {
-		return diag.FromErr(err)
+		return err
 	}
 
 	if err := d.Set("environment", response.DataSource.GetEnvironment().Id); err != nil {
-		return diag.FromErr(err)
+		return err
 	}
 
 	if err := d.Set("account", response.DataSource.GetAccount().Id); err != nil {
-		return diag.FromErr(err)
+		return err
 	}
 
 	if err := d.Set("status", response.DataSource.GetStatus()); err != nil {
-		return diag.FromErr(err)
-	}
-
-	switch strings.ToUpper(dataSourceType) {
-	case "SNOWFLAKE":
-		err = internal.HandleSnowflakeConnectionSettings(response, d)
-	case "HTTP":
-		err = internal.HandleHttpConnectionSettings(response, d)
-	case "S3":
-		err = internal.HandleS3ConnectionSettings(response, d)
-	case "WEBHOOK":
-		err = internal.HandleWebhookConnectionSettings(response, d)
-	case "KAFKA":
-		err = internal.HandleKafkaConnectionSettings(response, d)
-	case "CLICKHOUSE":
-		err = internal.HandleClickHouseConnectionSettings(response, d)
-	default:
-		err = fmt.Errorf("unsupported Data Source type \"%v\"", dataSourceType)
-	}
-
-	if err != nil {
-		return diag.FromErr(err)
+		return err
 	}
 
 	return nil
@@ -277,17 +296,19 @@ func resourceDataSourceUpdate(ctx context.Context, d *schema.ResourceData, meta
 	dataSourceType := d.Get("type").(string)
 	switch strings.ToUpper(dataSourceType) {
 	case "SNOWFLAKE":
-		err = internal.SnowflakeDataSourceUpdate(ctx, d, c)
+		err = internal.SnowflakeDataSourceUpdate(ctx, d, c, internal.NestedSettings("snowflake_connection_settings"))
 	case "HTTP":
-		err = internal.HttpDataSourceUpdate(ctx, d, c)
+		err = internal.HttpDataSourceUpdate(ctx, d, c, internal.NestedSettings("http_connection_settings"))
 	case "S3":
-		err = internal.S3DataSourceUpdate(ctx, d, c)
+		err = internal.S3DataSourceUpdate(ctx, d, c, internal.NestedSettings("s3_connection_settings"))
 	case "WEBHOOK":
-		err = internal.WebhookDataSourceUpdate(ctx, d, c)
+		err = internal.WebhookDataSourceUpdate(ctx, d, c, internal.NestedSettings("webhook_connection_settings"))
 	case "KAFKA":
-		err = internal.KafkaDataSourceUpdate(ctx, d, c)
+		err = internal.KafkaDataSourceUpdate(ctx, d, c, internal.NestedSettings("kafka_connection_settings"))
 	case "CLICKHOUSE":
-		err = internal.ClickHouseDataSourceUpdate(ctx, d, c)
+		err = internal.ClickHouseDataSourceUpdate(ctx, d, c, internal.NestedSettings("clickhouse_connection_settings"))
+	case "POSTGRESQL":
+		err = internal.PostgreSqlDataSourceUpdate(ctx, d, c, internal.NestedSettings("postgresql_connection_settings"))
 	default:
 		err = fmt.Errorf("unsupported Data Source type \"%v\"", dataSourceType)
 	}
@@ -299,7 +320,7 @@ func resourceDataSourceUpdate(ctx context.Context, d *schema.ResourceData, meta
 	timeout := d.Timeout(schema.TimeoutCreate)
 
 	if err := waitForDataSourceConnected(ctx, c, d.Id(), timeout); err != nil {
-		return diag.FromErr(err)
+		return dataSourceDiagnostics(err)
 	}
 	return resourceDataSourceRead(ctx, d, meta)
 
@@ -313,7 +334,7 @@ func resourceDataSourceDelete(ctx context.Context, d *schema.ResourceData, m any
 
 	// Deletes default Data Pool first
 	if strings.ToUpper(d.Get("type").(string)) == "WEBHOOK" {
-		if err := internal.WebhookDataSourceDelete(ctx, d, c); err != nil {
+		if err := internal.WebhookDataSourceDelete(ctx, d, c, internal.NestedSettings("webhook_connection_settings")); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -335,8 +356,35 @@ func resourceDataSourceDelete(ctx context.Context, d *schema.ResourceData, m any
 	return diags
 }
 
+// customizeDiffWebhookColumns validates the changes to a Webhook Data Source's columns at plan time, since the
+// Propel API can only add nullable columns to it.
+func customizeDiffWebhookColumns(conn internal.ConnectionSettings) schema.CustomizeDiffFunc {
+	key := conn.Attribute("column")
+
+	return func(_ context.Context, d *schema.ResourceDiff, _ any) error {
+		if !d.HasChange(key) {
+			return nil
+		}
+
+		oldItem, newItem := d.GetChange(key)
+
+		if d.Id() == "" {
+			return internal.ValidateWebhookColumns(nil, newItem.([]any))
+		}
+
+		return internal.ValidateWebhookColumns(oldItem.([]any), newItem.([]any))
+	}
+}
+
 func waitForDataSourceConnected(ctx context.Context, client graphql.Client, id string, timeout time.Duration) error {
-	createStateConf := &retry.StateChangeConf{
+	return waitForDataSourceChecks(ctx, client, id, timeout, time.Now())
+}
+
+// waitForDataSourceChecks waits for a Data Source to be CONNECTED. It stops early if the Data Source is BROKEN
+// or any of its checks performed since the given time failed.
+func waitForDataSourceChecks(ctx context.Context, client graphql.Client, id string, timeout time.Duration, since time.Time) error {
+	waiter := &internal.Waiter[*pc.DataSourceData]{
+		Name: "Data Source",
 		Pending: []string{
 			string(pc.DataSourceStatusCreated),
 			string(pc.DataSourceStatusConnecting),
@@ -344,52 +392,69 @@ func waitForDataSourceConnected(ctx context.Context, client graphql.Client, id s
 		Target: []string{
 			string(pc.DataSourceStatusConnected),
 		},
-		Refresh: func() (any, string, error) {
+		Failed: []string{
+			string(pc.DataSourceStatusBroken),
+		},
+		Refresh: func(ctx context.Context) (internal.Observation[*pc.DataSourceData], error) {
 			resp, err := pc.DataSource(ctx, client, id)
 			if err != nil {
-				return nil, "", fmt.Errorf("error trying to read Data Source status: %s", err)
+				return internal.Observation[*pc.DataSourceData]{}, fmt.Errorf("error trying to read Data Source status: %w", err)
 			}
 
-			return resp, string(resp.DataSource.Status), nil
-		},
-		Timeout:                   timeout - time.Minute,
-		Delay:                     10 * time.Second,
-		MinTimeout:                5 * time.Second,
-		ContinuousTargetOccurence: 3,
-	}
+			// Stop waiting as soon as a check fails instead of waiting for the timeout. The error is returned as it
+			// is, so that each failed check is reported in its own diagnostic.
+			if checksErr := failedDataSourceChecks(&resp.DataSource.DataSourceData, since); checksErr != nil {
+				return internal.Observation[*pc.DataSourceData]{}, checksErr
+			}
 
-	_, err := createStateConf.WaitForStateContext(ctx)
-	if err != nil {
-		return fmt.Errorf("error waiting for Data Source to be CONNECTED: %s", err)
+			return internal.Observation[*pc.DataSourceData]{
+				Value: &resp.DataSource.DataSourceData,
+				State: string(resp.DataSource.Status),
+			}, nil
+		},
+		// The Data Source may briefly be reported CONNECTED while its checks are still running.
+		Confirmations: 3,
+		Timeout:       internal.WaitTimeout(timeout),
+		PollInterval:  internal.PollInterval(client),
 	}
 
-	return nil
+	_, err := waiter.Wait(ctx)
+	return err
 }
 
+// waitForDataSourceDeletion waits for a Data Source to no longer be found.
 func waitForDataSourceDeletion(ctx context.Context, client graphql.Client, id string, timeout time.Duration) error {
-	tickerInterval := 10 // 10s
-	timeoutSeconds := int(timeout.Seconds())
-	n := 0
-
-	ticker := time.NewTicker(time.Duration(tickerInterval) * time.Second)
-	for range ticker.C {
-		if n*tickerInterval > timeoutSeconds {
-			ticker.Stop()
-			break
-		}
-
-		_, err := pc.DataSource(ctx, client, id)
-		if err != nil {
-			ticker.Stop()
-
-			if strings.Contains(err.Error(), "not found") {
-				return nil
+	waiter := &internal.Waiter[*pc.DataSourceData]{
+		Name: "Data Source deletion",
+		Pending: []string{
+			string(pc.DataSourceStatusCreated),
+			string(pc.DataSourceStatusConnecting),
+			string(pc.DataSourceStatusConnected),
+			string(pc.DataSourceStatusBroken),
+			string(pc.DataSourceStatusDeleting),
+		},
+		Target: []string{
+			internal.StateDeleted,
+		},
+		Refresh: func(ctx context.Context) (internal.Observation[*pc.DataSourceData], error) {
+			resp, err := pc.DataSource(ctx, client, id)
+			if pc.IsNotFound(err) || (err == nil && resp.DataSource == nil) {
+				return internal.Observation[*pc.DataSourceData]{State: internal.StateDeleted}, nil
 			}
 
-			return fmt.Errorf("error trying to fetch Data Source: %s", err)
-		}
+			if err != nil {
+				return internal.Observation[*pc.DataSourceData]{}, fmt.Errorf("error trying to read Data Source: %w", err)
+			}
 
-		n++
+			return internal.Observation[*pc.DataSourceData]{
+				Value: &resp.DataSource.DataSourceData,
+				State: string(resp.DataSource.Status),
+			}, nil
+		},
+		Timeout:      internal.WaitTimeout(timeout),
+		PollInterval: internal.PollInterval(client),
 	}
-	return nil
+
+	_, err := waiter.Wait(ctx)
+	return err
 }
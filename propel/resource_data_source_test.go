@@ -63,10 +63,19 @@ func TestAccPropelDataSourceBasic(t *testing.T) {
 		"clickhouse_password": "invalid-password",
 	}
 
+	postgreSqlCtxInvalid := map[string]any{
+		"resource_name":       "postgresql",
+		"unique_name":         acctest.RandString(10),
+		"postgresql_host":     "192.168.90.84",
+		"postgresql_database": "invalid-database",
+		"postgresql_user":     "invalid-user",
+		"postgresql_password": "invalid-password",
+	}
+
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckPropelDataSourceDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckPropelDataSourceDestroy,
 		Steps: []resource.TestStep{
 			// should create the Data Source
 			{
@@ -89,7 +98,7 @@ func TestAccPropelDataSourceBasic(t *testing.T) {
 			},
 			{
 				Config:      testAccCheckPropelDataSourceS3ConfigBroken(s3CtxInvalid),
-				ExpectError: regexp.MustCompile(`unexpected state 'BROKEN'`),
+				ExpectError: regexp.MustCompile(`Data Source (check ".+" failed|is BROKEN)`),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckPropelDataSourceExists("propel_data_source.fizz"),
 					resource.TestCheckResourceAttr("propel_data_source.fizz", "type", "S3"),
@@ -99,7 +108,7 @@ func TestAccPropelDataSourceBasic(t *testing.T) {
 			},
 			{
 				Config:      testAccCheckPropelDataSourceSnowflakeConfigBroken(snowflakeCtxInvalid),
-				ExpectError: regexp.MustCompile(`unexpected state 'BROKEN'`),
+				ExpectError: regexp.MustCompile(`Data Source (check ".+" failed|is BROKEN)`),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckPropelDataSourceExists("propel_data_source.foo"),
 					resource.TestCheckResourceAttr("propel_data_source.foo", "type", "SNOWFLAKE"),
@@ -130,7 +139,7 @@ func TestAccPropelDataSourceBasic(t *testing.T) {
 			// should create Kafka Data Source
 			{
 				Config:      testAccCheckPropelDataSourceKafkaConfigBroken(kafkaCtxInvalid),
-				ExpectError: regexp.MustCompile(`unexpected state 'BROKEN'`),
+				ExpectError: regexp.MustCompile(`Data Source (check ".+" failed|is BROKEN)`),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckPropelDataSourceExists("propel_data_source.kafka"),
 					resource.TestCheckResourceAttr("propel_data_source.kafka", "type", "KAFKA"),
@@ -141,7 +150,7 @@ func TestAccPropelDataSourceBasic(t *testing.T) {
 			// should create ClickHouse Data Source
 			{
 				Config:      testAccCheckPropelDataSourceClickHouseConfigBroken(clickHouseCtxInvalid),
-				ExpectError: regexp.MustCompile(`unexpected state 'BROKEN'`),
+				ExpectError: regexp.MustCompile(`Data Source (check ".+" failed|is BROKEN)`),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckPropelDataSourceExists("propel_data_source.clickhouse"),
 					resource.TestCheckResourceAttr("propel_data_source.clickhouse", "type", "CLICKHOUSE"),
@@ -149,6 +158,17 @@ func TestAccPropelDataSourceBasic(t *testing.T) {
 					resource.TestCheckResourceAttr("propel_data_source.clickhouse", "clickhouse_connection_settings.0.database", "invalid-database"),
 				),
 			},
+			// should create PostgreSQL Data Source
+			{
+				Config:      testAccCheckPropelDataSourcePostgreSqlConfigBroken(postgreSqlCtxInvalid),
+				ExpectError: regexp.MustCompile(`Data Source (check ".+" failed|is BROKEN)`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPropelDataSourceExists("propel_data_source.postgresql"),
+					resource.TestCheckResourceAttr("propel_data_source.postgresql", "type", "POSTGRESQL"),
+					resource.TestCheckResourceAttr("propel_data_source.postgresql", "status", "BROKEN"),
+					resource.TestCheckResourceAttr("propel_data_source.postgresql", "postgresql_connection_settings.0.database", "invalid-database"),
+				),
+			},
 		},
 	})
 }
@@ -235,6 +255,21 @@ func testAccCheckPropelDataSourceClickHouseConfigBroken(ctx map[string]any) stri
 	}`, ctx)
 }
 
+func testAccCheckPropelDataSourcePostgreSqlConfigBroken(ctx map[string]any) string {
+	return Nprintf(`
+	resource "propel_data_source" "%{resource_name}" {
+		unique_name = "%{unique_name}"
+		type = "POSTGRESQL"
+
+		postgresql_connection_settings {
+			host = "%{postgresql_host}"
+			database = "%{postgresql_database}"
+			user = "%{postgresql_user}"
+			password = "%{postgresql_password}"
+		}
+	}`, ctx)
+}
+
 func testAccCheckPropelDataSourceKafkaConfigBroken(ctx map[string]any) string {
 	return Nprintf(`
 	resource "propel_data_source" "%{resource_name}" {
@@ -365,7 +400,7 @@ func testAccCheckPropelDataSourceDestroy(s *terraform.State) error {
 	c := testAccProvider.Meta().(graphql.Client)
 
 	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "propel_data_source" {
+		if _, typed := dataSourceConnectors[rs.Type]; rs.Type != "propel_data_source" && !typed {
 			continue
 		}
 
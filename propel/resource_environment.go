@@ -0,0 +1,210 @@
+package propel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = &environmentResource{}
+	_ resource.ResourceWithImportState = &environmentResource{}
+)
+
+// environmentResource is the propel_environment resource, built on terraform-plugin-framework.
+type environmentResource struct {
+	client graphql.Client
+}
+
+type environmentModel struct {
+	ID          types.String `tfsdk:"id"`
+	UniqueName  types.String `tfsdk:"unique_name"`
+	Description types.String `tfsdk:"description"`
+	Account     types.String `tfsdk:"account"`
+}
+
+func newEnvironmentResource() resource.Resource {
+	return &environmentResource{}
+}
+
+func (r *environmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment"
+}
+
+func (r *environmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides a Propel Environment resource. Environments are independent and isolated Propel workspaces for development, staging (testing), and production workloads.\n\n" +
+			"~> **Note:** The Propel API does not support deleting Environments. Destroying this resource only removes it from the Terraform state; the Environment must be deleted from the Propel Console.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The Environment's ID.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"unique_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The Environment's unique name.",
+			},
+			"description": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The Environment's description.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"account": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The Account that the Environment belongs to.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *environmentResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	// The provider data is not set until the provider is configured.
+	if c, ok := req.ProviderData.(graphql.Client); ok {
+		r.client = c
+	}
+}
+
+func (r *environmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan environmentModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &pc.CreateEnvironmentInput{
+		UniqueName: plan.UniqueName.ValueString(),
+	}
+
+	if description := plan.Description.ValueString(); description != "" {
+		input.Description = &description
+	}
+
+	response, err := pc.CreateEnvironment(ctx, r.client, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create Environment", err.Error())
+		return
+	}
+
+	if response.CreateEnvironment.Environment == nil {
+		resp.Diagnostics.AddError("Failed to create Environment", fmt.Sprintf("failed to create Environment \"%s\"", input.UniqueName))
+		return
+	}
+
+	state, err := readEnvironment(ctx, r.client, response.CreateEnvironment.Environment.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read Environment", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *environmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var current environmentModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &current)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, err := readEnvironment(ctx, r.client, current.ID.ValueString())
+	if pc.IsNotFound(err) {
+		// The Environment was deleted outside of Terraform, so it is planned to be created again.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read Environment", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *environmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan environmentModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &pc.ModifyEnvironmentInput{
+		Id:          plan.ID.ValueString(),
+		UniqueName:  plan.UniqueName.ValueStringPointer(),
+		Description: plan.Description.ValueStringPointer(),
+	}
+
+	if _, err := pc.ModifyEnvironment(ctx, r.client, input); err != nil {
+		resp.Diagnostics.AddError("Failed to update Environment", err.Error())
+		return
+	}
+
+	state, err := readEnvironment(ctx, r.client, input.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read Environment", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *environmentResource) Delete(_ context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.AddWarning(
+		"Environment was not deleted",
+		"The Propel API does not support deleting Environments. The Environment was removed from the Terraform state, but it still exists in Propel and must be deleted from the Propel Console.",
+	)
+}
+
+func (r *environmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if strings.HasPrefix(req.ID, importNamePrefix) {
+		resp.Diagnostics.AddError("Unable to import Environment", "Environment resources can only be imported by ID")
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// readEnvironment reads the Environment with the given ID. The returned error wraps pc.ErrNotFound if it does not
+// exist.
+func readEnvironment(ctx context.Context, c graphql.Client, id string) (*environmentModel, error) {
+	response, err := pc.Environment(ctx, c, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Environment == nil {
+		return nil, fmt.Errorf("Environment \"%s\" %w", id, pc.ErrNotFound)
+	}
+
+	uniqueName := ""
+	if response.Environment.UniqueName != nil {
+		uniqueName = *response.Environment.UniqueName
+	}
+
+	description := ""
+	if response.Environment.Description != nil {
+		description = *response.Environment.Description
+	}
+
+	account := ""
+	if response.Environment.Account != nil {
+		account = response.Environment.Account.Id
+	}
+
+	return &environmentModel{
+		ID:          types.StringValue(response.Environment.Id),
+		UniqueName:  types.StringValue(uniqueName),
+		Description: types.StringValue(description),
+		Account:     types.StringValue(account),
+	}, nil
+}
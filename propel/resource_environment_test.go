@@ -0,0 +1,64 @@
+package propel
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPropelEnvironment(t *testing.T) {
+	t.Parallel()
+
+	ctx := map[string]any{
+		"unique_name": "terraform-test-" + acctest.RandString(10),
+		"description": "Created by the Terraform acceptance tests",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			// should create the Environment and look it up
+			{
+				Config: testAccCheckPropelEnvironmentConfig(ctx),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPropelResourceExists("propel_environment.test", "Environment"),
+					resource.TestCheckResourceAttr("propel_environment.test", "unique_name", ctx["unique_name"].(string)),
+					resource.TestCheckResourceAttrSet("propel_environment.test", "account"),
+					resource.TestCheckResourceAttrPair("data.propel_environment.test", "unique_name", "propel_environment.test", "unique_name"),
+				),
+			},
+			// should update the description
+			{
+				Config: testAccCheckPropelEnvironmentConfig(map[string]any{
+					"unique_name": ctx["unique_name"],
+					"description": "Updated by the Terraform acceptance tests",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("propel_environment.test", "description", "Updated by the Terraform acceptance tests"),
+				),
+			},
+			// should import the Environment
+			{
+				ResourceName:      "propel_environment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPropelEnvironmentConfig(ctx map[string]any) string {
+	// language=hcl-terraform
+	return Nprintf(`
+		resource "propel_environment" "test" {
+			unique_name = "%{unique_name}"
+			description = "%{description}"
+		}
+
+		data "propel_environment" "test" {
+			id = propel_environment.test.id
+		}
+	`, ctx)
+}
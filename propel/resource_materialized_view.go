@@ -2,6 +2,7 @@ package propel
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/Khan/genqlient/graphql"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -17,9 +18,8 @@ func resourceMaterializedView() *schema.Resource {
 		ReadContext:   resourceMaterializedViewRead,
 		UpdateContext: resourceMaterializedViewUpdate,
 		DeleteContext: resourceMaterializedViewDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		CustomizeDiff: customizeDiffMaterializedView,
+		Importer:      importByIDOrName("Materialized View", lookupMaterializedViewID, importMaterializedViewDestination),
 		SchemaVersion: 1,
 		Description:   "Provides a Propel Materialized View resource. This can be used to create and manage Propel Materialized Views.",
 		Schema: map[string]*schema.Schema{
@@ -49,7 +49,31 @@ func resourceMaterializedView() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 				ForceNew:    true,
-				Description: "The SQL that the Materialized View executes.",
+				Description: "The SQL that the Materialized View executes. It is checked for errors when planning, unless it queries a Data Pool that does not exist yet.",
+			},
+			"output_columns": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The columns that the Materialized View's SQL outputs.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The column name.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The column type.",
+						},
+						"nullable": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the column is nullable, meaning whether it accepts a null value.",
+						},
+					},
+				},
 			},
 			"existing_data_pool": {
 				Type:          schema.TypeList,
@@ -208,12 +232,35 @@ func resourceMaterializedViewCreate(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
+// importMaterializedViewDestination sets an imported Materialized View to target its existing destination Data Pool,
+// which is how it is configured once the Data Pool exists, whether the Materialized View created it or not.
+func importMaterializedViewDestination(ctx context.Context, d *schema.ResourceData, c graphql.Client) error {
+	response, err := pc.MaterializedView(ctx, c, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if response.MaterializedView == nil {
+		return fmt.Errorf("Materialized View \"%s\" %w", d.Id(), pc.ErrNotFound)
+	}
+
+	if err := d.Set("existing_data_pool", []map[string]any{{"id": response.MaterializedView.Destination.Id}}); err != nil {
+		return err
+	}
+
+	return d.Set("backfill", false)
+}
+
 func resourceMaterializedViewRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	c := m.(graphql.Client)
 
 	response, err := pc.MaterializedView(ctx, c, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return readError(d, err)
+	}
+
+	if response.MaterializedView == nil {
+		return readError(d, fmt.Errorf("Materialized View \"%s\" %w", d.Id(), pc.ErrNotFound))
 	}
 
 	d.SetId(response.MaterializedView.Id)
@@ -234,6 +281,23 @@ func resourceMaterializedViewRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
+	var diags diag.Diagnostics
+
+	// The output is described when planning, so it is only described again if the SQL changed outside of Terraform.
+	// Failing to describe it must not prevent refreshing the Materialized View, e.g. before destroying it.
+	if len(d.Get("output_columns").([]any)) == 0 || d.Get("sql").(string) != response.MaterializedView.Sql {
+		outputColumns, err := describeMaterializedViewSQL(ctx, c, response.MaterializedView.Sql)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Materialized View output not described",
+				Detail:   err.Error(),
+			})
+		} else if err := d.Set("output_columns", outputColumns); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if err := d.Set("sql", response.MaterializedView.Sql); err != nil {
 		return diag.FromErr(err)
 	}
@@ -255,7 +319,7 @@ func resourceMaterializedViewRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	return nil
+	return diags
 }
 
 func resourceMaterializedViewUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -2,6 +2,8 @@ package propel
 
 import (
 	"context"
+	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/Khan/genqlient/graphql"
@@ -12,12 +14,14 @@ import (
 )
 
 func TestAccPropelMaterializedViewBasic(t *testing.T) {
-	ctx := map[string]any{}
+	ctx := map[string]any{
+		"select": "SELECT",
+	}
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckPropelMaterializedViewDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckPropelMaterializedViewDestroy,
 		Steps: []resource.TestStep{
 			// should create the Materialized View
 			{
@@ -27,8 +31,30 @@ func TestAccPropelMaterializedViewBasic(t *testing.T) {
 					resource.TestCheckResourceAttr("propel_materialized_view.foo", "unique_name", "terraform-mv-1"),
 					testAccCheckPropelResourceExists("propel_materialized_view.bar", "Materialized View"),
 					resource.TestCheckResourceAttr("propel_materialized_view.bar", "unique_name", "terraform-mv-2"),
+					resource.TestCheckResourceAttr("propel_materialized_view.bar", "output_columns.#", "3"),
+					resource.TestCheckResourceAttr("propel_materialized_view.bar", "output_columns.0.name", "customer_id"),
+					resource.TestCheckResourceAttr("propel_materialized_view.bar", "output_columns.2.name", "timestamp"),
 				),
 			},
+			// should fail the plan, rather than replace the Materialized View, when the SQL is not valid
+			{
+				Config:      testAccCheckPropelMaterializedViewConfigBasic(map[string]any{"select": "SELEKT"}),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`the Materialized View's SQL is not valid`),
+			},
+			// should import the Materialized View by unique name
+			{
+				ResourceName:  "propel_materialized_view.foo",
+				ImportState:   true,
+				ImportStateId: "name:terraform-mv-1",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 || states[0].Attributes["unique_name"] != "terraform-mv-1" {
+						return fmt.Errorf("expected to import the Materialized View \"terraform-mv-1\", got %v", states)
+					}
+
+					return nil
+				},
+			},
 		},
 	})
 }
@@ -98,7 +124,7 @@ func testAccCheckPropelMaterializedViewConfigBasic(ctx map[string]any) string {
 
 		resource "propel_materialized_view" "bar" {
 			unique_name = "terraform-mv-2"
-			sql = "SELECT customer_id, value, \"timestamp_tz\" AS timestamp FROM \"${propel_data_source.terraform_mv_source_dp.webhook_connection_settings[0].data_pool_id}\""
+			sql = "%{select} customer_id, value, \"timestamp_tz\" AS timestamp FROM \"${propel_data_source.terraform_mv_source_dp.webhook_connection_settings[0].data_pool_id}\""
 			existing_data_pool {
 				id = "${propel_materialized_view.foo.destination}"
 			}
@@ -2,36 +2,25 @@ package propel
 
 import (
 	"context"
-	"encoding/json"
+	"fmt"
 
 	"github.com/Khan/genqlient/graphql"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
-	"github.com/propeldata/terraform-provider-propel/propel/internal/utils"
 	pc "github.com/propeldata/terraform-provider-propel/propel_client"
 )
 
-type MetricFilter interface {
-	GetColumn() string
-	GetOperator() pc.FilterOperator
-	GetValue() *string
-	GetAnd() []*pc.FilterDataAndFilter
-	GetOr() []*pc.FilterDataOrFilter
-}
-
 func resourceMetric() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceMetricCreate,
 		ReadContext:   resourceMetricRead,
 		UpdateContext: resourceMetricUpdate,
 		DeleteContext: resourceMetricDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		Description: "Provides a Propel Metric resource. This can be used to create and manage Propel Metrics.",
+		CustomizeDiff: customizeDiffMetric,
+		Importer:      importByIDOrName("Metric", lookupMetricID),
+		Description:   "Provides a Propel Metric resource. This can be used to create and manage Propel Metrics.",
 		Schema: map[string]*schema.Schema{
 			"unique_name": {
 				Type:        schema.TypeString,
@@ -70,53 +59,21 @@ func resourceMetric() *schema.Resource {
 			"data_pool": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
-				Description: "The Data Pool that powers this Metric.",
+				Description: "The Data Pool that powers this Metric. Changing it migrates the Metric to the new Data Pool, keeping its ID. CUSTOM Metrics, and Metrics whose measure or dimension is missing from the new Data Pool, are replaced instead. The columns the Metric uses are checked against the Data Pool when planning, if the Data Pool already exists, so columns added to it in the same apply must be referenced from the `propel_data_pool` resource.",
 			},
 			"filter": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Metric Filters allow defining a Metric with a subset of records from the given Data Pool. If no Metric Filters are present, all records will be included. To filter at query time, add Dimensions and use the `filters` property on the `timeSeriesInput`, `counterInput`, or `leaderboardInput` objects. There is no need to add `filters` to be able to filter at query time.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"column": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The name of the column to filter on.",
-						},
-						"operator": {
-							Type:         schema.TypeString,
-							Required:     true,
-							Description:  "The operation to perform when comparing the column and filter values.",
-							ValidateFunc: utils.IsValidOperator,
-						},
-						"value": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "The value to compare the column to.",
-						},
-						"and": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Description:  "Additional filters to AND with this one. AND takes precedence over OR. It is defined as a JSON string value.",
-							ValidateFunc: validation.StringIsJSON,
-							StateFunc: func(v any) string {
-								nJSON, _ := structure.NormalizeJsonString(v)
-								return nJSON
-							},
-						},
-						"or": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Description:  "Additional filters to OR with this one. AND takes precedence over OR. It is defined as a JSON string value.",
-							ValidateFunc: validation.StringIsJSON,
-							StateFunc: func(v any) string {
-								nJSON, _ := structure.NormalizeJsonString(v)
-								return nJSON
-							},
-						},
-					},
-				},
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"filter_sql"},
+				Description:   "Metric Filters allow defining a Metric with a subset of records from the given Data Pool. If no Metric Filters are present, all records will be included. To filter at query time, add Dimensions and use the `filters` property on the `timeSeriesInput`, `counterInput`, or `leaderboardInput` objects. There is no need to add `filters` to be able to filter at query time.",
+				Elem:          &schema.Resource{Schema: filterSchema()},
+			},
+			"filter_sql": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"filter"},
+				DiffSuppressFunc: suppressEquivalentSQL,
+				Description:      "The Metric Filters in the form of SQL, e.g. `status IN ('paid', 'shipped') AND (country = 'US' OR country = 'CA')`. It is an alternative to the `filter` blocks that supports `IN` lists and nested conditions. Whitespace differences are ignored.",
 			},
 			"dimensions": {
 				Type:        schema.TypeList,
@@ -137,7 +94,7 @@ func resourceMetric() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 				ForceNew:    true,
-				Description: "The custom expression for aggregating data in a Metric. Only valid for CUSTOM Metrics.",
+				Description: "The custom expression for aggregating data in a Metric. Only valid for CUSTOM Metrics. It is checked against the Data Pool when planning, if the Data Pool already exists.",
 			},
 			"access_control_enabled": {
 				Type:        schema.TypeBool,
@@ -148,19 +105,15 @@ func resourceMetric() *schema.Resource {
 			},
 		},
 	}
+
 }
 
 func resourceMetricCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	c := meta.(graphql.Client)
 
-	var diags diag.Diagnostics
-
-	filters := make([]*pc.FilterInput, 0)
-	if def, ok := d.Get("filter").([]any); ok && len(def) > 0 {
-		filters, diags = expandMetricFilters(def)
-		if diags != nil {
-			return diags
-		}
+	filters, filterSQL, diags := expandFilters(d, "filter")
+	if diags != nil {
+		return diags
 	}
 
 	dimensions := make([]*pc.DimensionInput, 0)
@@ -179,6 +132,7 @@ func resourceMetricCreate(ctx context.Context, d *schema.ResourceData, meta any)
 			UniqueName:  &uniqueName,
 			Description: &description,
 			Filters:     filters,
+			FilterSql:   filterSQL,
 			Dimensions:  dimensions,
 			Measure: &pc.DimensionInput{
 				ColumnName: d.Get("measure").(string),
@@ -197,6 +151,7 @@ func resourceMetricCreate(ctx context.Context, d *schema.ResourceData, meta any)
 			UniqueName:  &uniqueName,
 			Description: &description,
 			Filters:     filters,
+			FilterSql:   filterSQL,
 			Dimensions:  dimensions,
 		}
 
@@ -212,6 +167,7 @@ func resourceMetricCreate(ctx context.Context, d *schema.ResourceData, meta any)
 			UniqueName:  &uniqueName,
 			Description: &description,
 			Filters:     filters,
+			FilterSql:   filterSQL,
 			Dimensions:  dimensions,
 			Dimension: &pc.DimensionInput{
 				ColumnName: d.Get("dimension").(string),
@@ -230,6 +186,7 @@ func resourceMetricCreate(ctx context.Context, d *schema.ResourceData, meta any)
 			UniqueName:  &uniqueName,
 			Description: &description,
 			Filters:     filters,
+			FilterSql:   filterSQL,
 			Dimensions:  dimensions,
 			Measure: &pc.DimensionInput{
 				ColumnName: d.Get("measure").(string),
@@ -248,6 +205,7 @@ func resourceMetricCreate(ctx context.Context, d *schema.ResourceData, meta any)
 			UniqueName:  &uniqueName,
 			Description: &description,
 			Filters:     filters,
+			FilterSql:   filterSQL,
 			Dimensions:  dimensions,
 			Measure: &pc.DimensionInput{
 				ColumnName: d.Get("measure").(string),
@@ -266,6 +224,7 @@ func resourceMetricCreate(ctx context.Context, d *schema.ResourceData, meta any)
 			UniqueName:  &uniqueName,
 			Description: &description,
 			Filters:     filters,
+			FilterSql:   filterSQL,
 			Dimensions:  dimensions,
 			Measure: &pc.DimensionInput{
 				ColumnName: d.Get("measure").(string),
@@ -284,6 +243,7 @@ func resourceMetricCreate(ctx context.Context, d *schema.ResourceData, meta any)
 			UniqueName:  &uniqueName,
 			Description: &description,
 			Filters:     filters,
+			FilterSql:   filterSQL,
 			Dimensions:  dimensions,
 			Expression:  d.Get("expression").(string),
 		}
@@ -306,7 +266,11 @@ func resourceMetricRead(ctx context.Context, d *schema.ResourceData, meta any) d
 
 	response, err := pc.Metric(ctx, c, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return readError(d, err)
+	}
+
+	if response.Metric == nil {
+		return readError(d, fmt.Errorf("Metric \"%s\" %w", d.Id(), pc.ErrNotFound))
 	}
 
 	d.SetId(response.Metric.Id)
@@ -339,7 +303,8 @@ func resourceMetricRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		return diag.FromErr(err)
 	}
 
-	filters := make([]map[string]any, 0)
+	filters := make([]*pc.FilterInput, 0)
+	var filterSQL *string
 
 	switch s := response.Metric.Settings.(type) {
 	case *pc.MetricDataSettingsCountMetricSettings:
@@ -347,6 +312,8 @@ func resourceMetricRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		if err != nil {
 			return diag.FromErr(err)
 		}
+
+		filterSQL = s.FilterSql
 	case *pc.MetricDataSettingsSumMetricSettings:
 		if err := d.Set("measure", s.Measure.ColumnName); err != nil {
 			return diag.FromErr(err)
@@ -356,6 +323,8 @@ func resourceMetricRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		if err != nil {
 			return diag.FromErr(err)
 		}
+
+		filterSQL = s.FilterSql
 	case *pc.MetricDataSettingsCountDistinctMetricSettings:
 		if err := d.Set("dimension", s.Dimension.ColumnName); err != nil {
 			return diag.FromErr(err)
@@ -365,6 +334,8 @@ func resourceMetricRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		if err != nil {
 			return diag.FromErr(err)
 		}
+
+		filterSQL = s.FilterSql
 	case *pc.MetricDataSettingsAverageMetricSettings:
 		if err := d.Set("measure", s.Measure.ColumnName); err != nil {
 			return diag.FromErr(err)
@@ -374,6 +345,8 @@ func resourceMetricRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		if err != nil {
 			return diag.FromErr(err)
 		}
+
+		filterSQL = s.FilterSql
 	case *pc.MetricDataSettingsMinMetricSettings:
 		if err := d.Set("measure", s.Measure.ColumnName); err != nil {
 			return diag.FromErr(err)
@@ -383,6 +356,8 @@ func resourceMetricRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		if err != nil {
 			return diag.FromErr(err)
 		}
+
+		filterSQL = s.FilterSql
 	case *pc.MetricDataSettingsMaxMetricSettings:
 		if err := d.Set("measure", s.Measure.ColumnName); err != nil {
 			return diag.FromErr(err)
@@ -392,6 +367,8 @@ func resourceMetricRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		if err != nil {
 			return diag.FromErr(err)
 		}
+
+		filterSQL = s.FilterSql
 	case *pc.MetricDataSettingsCustomMetricSettings:
 		if err := d.Set("expression", s.Expression); err != nil {
 			return diag.FromErr(err)
@@ -401,9 +378,11 @@ func resourceMetricRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		if err != nil {
 			return diag.FromErr(err)
 		}
+
+		filterSQL = s.FilterSql
 	}
 
-	if err := d.Set("filter", filters); err != nil {
+	if err := setFilters(d, "filter", filters, filterSQL); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -413,18 +392,24 @@ func resourceMetricRead(ctx context.Context, d *schema.ResourceData, meta any) d
 func resourceMetricUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	c := m.(graphql.Client)
 
-	var diags diag.Diagnostics
+	if d.HasChange("data_pool") {
+		input := &pc.MigrateMetricInput{
+			MetricId:      d.Id(),
+			NewDataPoolId: d.Get("data_pool").(string),
+		}
+
+		if _, err := pc.MigrateMetric(ctx, c, input); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
-	if d.HasChanges("unique_name", "description", "dimensions", "filter", "access_control_enabled") {
+	if d.HasChanges("unique_name", "description", "dimensions", "filter", "filter_sql", "access_control_enabled") {
 		uniqueName := d.Get("unique_name").(string)
 		description := d.Get("description").(string)
 
-		filters := make([]*pc.FilterInput, 0)
-		if def, ok := d.Get("filter").([]any); ok && len(def) > 0 {
-			filters, diags = expandMetricFilters(def)
-			if diags != nil {
-				return diags
-			}
+		filters, filterSQL, diags := expandFilters(d, "filter")
+		if diags != nil {
+			return diags
 		}
 
 		dimensions := make([]*pc.DimensionInput, 0)
@@ -439,6 +424,7 @@ func resourceMetricUpdate(ctx context.Context, d *schema.ResourceData, m any) di
 			UniqueName:           &uniqueName,
 			Description:          &description,
 			Filters:              filters,
+			FilterSql:            filterSQL,
 			Dimensions:           dimensions,
 			AccessControlEnabled: &accessControlEnabled,
 		}
@@ -464,46 +450,6 @@ func resourceMetricDelete(ctx context.Context, d *schema.ResourceData, m any) di
 	return nil
 }
 
-func expandMetricFilters(def []any) ([]*pc.FilterInput, diag.Diagnostics) {
-	filters := make([]*pc.FilterInput, 0, len(def))
-
-	for _, rawFilter := range def {
-		filter := rawFilter.(map[string]any)
-
-		f := &pc.FilterInput{
-			Column:   filter["column"].(string),
-			Operator: pc.FilterOperator(filter["operator"].(string)),
-		}
-
-		if def, ok := filter["value"]; ok {
-			value := def.(string)
-			f.Value = &value
-		}
-
-		if def, ok := filter["and"]; ok && def != "" {
-			var andFilterInput []*pc.FilterInput
-			if err := json.Unmarshal([]byte(def.(string)), &andFilterInput); err != nil {
-				return nil, diag.FromErr(err)
-			}
-
-			f.And = andFilterInput
-		}
-
-		if def, ok := filter["or"]; ok && def != "" {
-			var orFilterInput []*pc.FilterInput
-			if err := json.Unmarshal([]byte(def.(string)), &orFilterInput); err != nil {
-				return nil, diag.FromErr(err)
-			}
-
-			f.Or = orFilterInput
-		}
-
-		filters = append(filters, f)
-	}
-
-	return filters, nil
-}
-
 func expandMetricDimensions(def []any) []*pc.DimensionInput {
 	dimensions := make([]*pc.DimensionInput, 0, len(def))
 
@@ -518,37 +464,3 @@ func expandMetricDimensions(def []any) []*pc.DimensionInput {
 
 	return dimensions
 }
-
-func parseMetricFilters[T MetricFilter](filters []T) ([]map[string]any, error) {
-	parsedFilters := make([]map[string]any, len(filters))
-
-	for i, f := range filters {
-		filter := map[string]any{
-			"column":   f.GetColumn(),
-			"operator": f.GetOperator(),
-			"value":    f.GetValue(),
-		}
-
-		if len(f.GetAnd()) > 0 {
-			and, err := json.Marshal(f.GetAnd())
-			if err != nil {
-				return nil, err
-			}
-
-			filter["and"] = string(and)
-		}
-
-		if len(f.GetOr()) > 0 {
-			or, err := json.Marshal(f.GetOr())
-			if err != nil {
-				return nil, err
-			}
-
-			filter["or"] = string(or)
-		}
-
-		parsedFilters[i] = filter
-	}
-
-	return parsedFilters, nil
-}
@@ -3,6 +3,7 @@ package propel
 import (
 	"context"
 	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/Khan/genqlient/graphql"
@@ -176,6 +177,58 @@ func Test_expandMetricFilters(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "With nested OR and AND filter blocks",
+			def: []any{
+				map[string]any{
+					"column":   "foo",
+					"operator": "EQUALS",
+					"value":    "2",
+					"or_filter": []any{
+						map[string]any{
+							"column":     "bar",
+							"operator":   "GREATER_THAN",
+							"value":      "5",
+							"and_filter": []any{map[string]any{"column": "baz", "operator": "EQUALS", "value": "abc"}},
+						},
+					},
+				},
+			},
+			want: []*pc.FilterInput{
+				{
+					Column:   "foo",
+					Operator: pc.FilterOperatorEquals,
+					Value:    &two,
+					Or: []*pc.FilterInput{
+						{
+							Column:   "bar",
+							Operator: pc.FilterOperatorGreaterThan,
+							Value:    &five,
+							And: []*pc.FilterInput{
+								{
+									Column:   "baz",
+									Operator: pc.FilterOperatorEquals,
+									Value:    &abc,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "With both an AND string and AND filter blocks",
+			def: []any{
+				map[string]any{
+					"column":     "foo",
+					"operator":   "EQUALS",
+					"value":      "2",
+					"and":        `[{"column": "bar", "operator": "GREATER_THAN", "value": "5"}]`,
+					"and_filter": []any{map[string]any{"column": "baz", "operator": "EQUALS", "value": "abc"}},
+				},
+			},
+			expectedError: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -194,12 +247,14 @@ func Test_expandMetricFilters(t *testing.T) {
 }
 
 func TestAccPropelMetricBasic(t *testing.T) {
-	ctx := map[string]any{}
+	ctx := map[string]any{
+		"expression": "COUNT_DISTINCT(account_id) / COUNT()",
+	}
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckPropelMetricDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckPropelMetricDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPropelMetricConfigBasic(ctx),
@@ -207,8 +262,18 @@ func TestAccPropelMetricBasic(t *testing.T) {
 					testAccCheckPropelDataPoolExists("propel_metric.baz"),
 					resource.TestCheckResourceAttr("propel_metric.baz", "type", "CUSTOM"),
 					resource.TestCheckResourceAttr("propel_metric.baz", "expression", "COUNT_DISTINCT(account_id) / COUNT()"),
+					resource.TestCheckResourceAttr("propel_metric.baz", "filter.0.or_filter.0.value", "unknown"),
+					resource.TestCheckResourceAttr("propel_metric.baz", "filter.0.or_filter.0.and_filter.0.column", "timestamp_tz"),
 				),
 			},
+			// should fail the plan when the expression is not valid for the Data Pool's columns
+			{
+				Config: testAccCheckPropelMetricConfigBasic(map[string]any{
+					"expression": "SUM(account_id)",
+				}),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`expression "SUM\(account_id\)" is not valid`),
+			},
 		},
 	})
 }
@@ -269,11 +334,22 @@ func testAccCheckPropelMetricConfigBasic(ctx map[string]any) string {
 		data_pool   = propel_data_pool.bar.id
 
 		type         = "CUSTOM"
-		expression   = "COUNT_DISTINCT(account_id) / COUNT()"
+		expression   = "%{expression}"
 
 		filter {
-		    column   = "account_id"
+			column   = "account_id"
 			operator = "IS_NOT_NULL"
+
+			or_filter {
+				column   = "account_id"
+				operator = "EQUALS"
+				value    = "unknown"
+
+				and_filter {
+					column   = "timestamp_tz"
+					operator = "IS_NOT_NULL"
+				}
+			}
 		}
 	}
 	`, ctx)
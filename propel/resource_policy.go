@@ -3,6 +3,7 @@ package propel
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/Khan/genqlient/graphql"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -14,13 +15,11 @@ import (
 
 func resourcePolicy() *schema.Resource {
 	return &schema.Resource{
-		CreateContext: resourcePolicyCreate,
-		ReadContext:   resourcePolicyRead,
-		UpdateContext: resourcePolicyUpdate,
-		DeleteContext: resourcePolicyDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		CreateContext:      resourcePolicyCreate,
+		ReadContext:        resourcePolicyRead,
+		UpdateContext:      resourcePolicyUpdate,
+		DeleteContext:      resourcePolicyDelete,
+		Importer:           importByIDOrName("Policy", nil),
 		SchemaVersion:      1,
 		Description:        "Provides a Propel Policy resource. This can be used to create and manage Propel Access Policies. It governs an Application's access to a Metric's data.",
 		DeprecationMessage: "Use Data Pool Access Policy instead",
@@ -57,7 +56,11 @@ func resourcePolicyRead(ctx context.Context, d *schema.ResourceData, m any) diag
 
 	response, err := pc.Policy(ctx, c, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return readError(d, err)
+	}
+
+	if response.Policy == nil {
+		return readError(d, fmt.Errorf("Policy \"%s\" %w", d.Id(), pc.ErrNotFound))
 	}
 
 	d.SetId(response.Policy.Id)
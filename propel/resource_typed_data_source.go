@@ -0,0 +1,296 @@
+package propel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/propeldata/terraform-provider-propel/propel/internal"
+	pc "github.com/propeldata/terraform-provider-propel/propel_client"
+)
+
+// dataSourceConnector describes a kind of Data Source that has a dedicated resource, such as
+// `propel_snowflake_data_source`. The dedicated resources share their attributes with `propel_data_source`, minus
+// `type`, and hold the connection settings of their kind as top-level attributes instead of in a block, so their
+// state can be moved over from it by flattening the block.
+type dataSourceConnector struct {
+	// name is the human-readable name of the Data Source kind, e.g. "Snowflake".
+	name string
+	// dataSourceType is the value of `type` in `propel_data_source` for this kind of Data Source.
+	dataSourceType string
+	// settingsKey is the block holding the connection settings in `propel_data_source`.
+	settingsKey string
+	// renamed maps the connection settings to the top-level attributes holding them, when their name is already
+	// taken by one of the common attributes.
+	renamed map[string]string
+	// withTable is set for the Data Sources whose tables are declared in the `table` attribute.
+	withTable bool
+
+	// validate checks the planned changes to the connection settings, before the Data Source checks run.
+	validate func(conn internal.ConnectionSettings) schema.CustomizeDiffFunc
+
+	settingsSchema func() *schema.Schema
+	create         func(ctx context.Context, d *schema.ResourceData, c graphql.Client, conn internal.ConnectionSettings) (string, error)
+	update         func(ctx context.Context, d *schema.ResourceData, c graphql.Client, conn internal.ConnectionSettings) error
+	handle         func(response *pc.DataSourceResponse, d *schema.ResourceData, conn internal.ConnectionSettings) error
+	delete         func(ctx context.Context, d *schema.ResourceData, c graphql.Client, conn internal.ConnectionSettings) error
+}
+
+// settings returns the top-level attributes holding the connection settings in the dedicated resource.
+func (dc dataSourceConnector) settings() internal.ConnectionSettings {
+	return internal.FlatSettings(dc.settingsSchema(), dc.renamed)
+}
+
+// dataSourceConnectors maps the dedicated Data Source resources to their kind of Data Source.
+var dataSourceConnectors = map[string]dataSourceConnector{
+	"propel_snowflake_data_source": {
+		name:           "Snowflake",
+		dataSourceType: "SNOWFLAKE",
+		settingsKey:    "snowflake_connection_settings",
+		renamed:        map[string]string{"account": "snowflake_account"},
+		settingsSchema: internal.SnowflakeDataSourceSchema,
+		create:         internal.SnowflakeDataSourceCreate,
+		update:         internal.SnowflakeDataSourceUpdate,
+		handle:         internal.HandleSnowflakeConnectionSettings,
+	},
+	"propel_s3_data_source": {
+		name:           "S3",
+		dataSourceType: "S3",
+		settingsKey:    "s3_connection_settings",
+		withTable:      true,
+		settingsSchema: internal.S3DataSourceSchema,
+		create:         internal.S3DataSourceCreate,
+		update:         internal.S3DataSourceUpdate,
+		handle:         internal.HandleS3ConnectionSettings,
+	},
+	"propel_kafka_data_source": {
+		name:           "Kafka",
+		dataSourceType: "KAFKA",
+		settingsKey:    "kafka_connection_settings",
+		settingsSchema: internal.KafkaDataSourceSchema,
+		create:         internal.KafkaDataSourceCreate,
+		update:         internal.KafkaDataSourceUpdate,
+		handle:         internal.HandleKafkaConnectionSettings,
+	},
+	"propel_webhook_data_source": {
+		name:           "Webhook",
+		dataSourceType: "WEBHOOK",
+		settingsKey:    "webhook_connection_settings",
+		validate:       customizeDiffWebhookColumns,
+		settingsSchema: internal.WebhookDataSourceSchema,
+		create:         internal.WebhookDataSourceCreate,
+		update:         internal.WebhookDataSourceUpdate,
+		handle:         internal.HandleWebhookConnectionSettings,
+		delete:         internal.WebhookDataSourceDelete,
+	},
+	"propel_http_data_source": {
+		name:           "HTTP",
+		dataSourceType: "HTTP",
+		settingsKey:    "http_connection_settings",
+		withTable:      true,
+		settingsSchema: internal.HttpDataSourceSchema,
+		create:         internal.HttpDataSourceCreate,
+		update:         internal.HttpDataSourceUpdate,
+		handle:         internal.HandleHttpConnectionSettings,
+	},
+	"propel_clickhouse_data_source": {
+		name:           "ClickHouse",
+		dataSourceType: "CLICKHOUSE",
+		settingsKey:    "clickhouse_connection_settings",
+		settingsSchema: internal.ClickHouseDataSourceSchema,
+		create:         internal.ClickHouseDataSourceCreate,
+		update:         internal.ClickHouseDataSourceUpdate,
+		handle:         internal.HandleClickHouseConnectionSettings,
+	},
+}
+
+// dataSourceCommonAttributes are the attributes of `propel_data_source` that every dedicated Data Source
+// resource has.
+var dataSourceCommonAttributes = []string{
+	"unique_name",
+	"description",
+	"status",
+	"account",
+	"environment",
+	"created_at",
+	"modified_at",
+	"created_by",
+	"modified_by",
+	"run_checks_on_plan",
+}
+
+func resourceTypedDataSource(typeName string) *schema.Resource {
+	connector := dataSourceConnectors[typeName]
+	conn := connector.settings()
+	generic := resourceDataSource()
+
+	rs := make(map[string]*schema.Schema, len(dataSourceCommonAttributes)+len(conn.Attributes)+1)
+	for _, k := range dataSourceCommonAttributes {
+		rs[k] = generic.Schema[k]
+	}
+
+	for name, attr := range nonEmptySchema(connector.settingsSchema().Elem.(*schema.Resource).Schema) {
+		rs[conn.Attribute(name)] = attr
+	}
+
+	if connector.withTable {
+		rs["table"] = requiredSchema(generic.Schema["table"])
+		rs["table"].MinItems = 1
+	}
+
+	customizeDiff := customizeDiffDataSourceChecks
+	if connector.validate != nil {
+		customizeDiff = customdiff.Sequence(connector.validate(conn), customizeDiffDataSourceChecks)
+	}
+
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return resourceTypedDataSourceCreate(ctx, d, meta, connector)
+		},
+		ReadContext: readDefaults(func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return resourceTypedDataSourceRead(ctx, d, meta, connector)
+		}, map[string]any{"run_checks_on_plan": false}),
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return resourceTypedDataSourceUpdate(ctx, d, meta, connector)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return resourceTypedDataSourceDelete(ctx, d, meta, connector)
+		},
+		CustomizeDiff: customizeDiff,
+		Importer:      importByIDOrName("Data Source", lookupDataSourceID, importDefaults(map[string]any{"run_checks_on_plan": false})),
+		Description: fmt.Sprintf("Provides a Propel %s Data Source resource. This can be used to create and manage Propel %s Data Sources.\n\n"+
+			"An existing `propel_data_source` of type `%s` can be moved to this resource with a `moved` block, without re-creating it.",
+			connector.name, connector.name, connector.dataSourceType),
+		Schema:   rs,
+		Timeouts: generic.Timeouts,
+	}
+}
+
+func resourceTypedDataSourceCreate(ctx context.Context, d *schema.ResourceData, meta any, connector dataSourceConnector) diag.Diagnostics {
+	c := meta.(graphql.Client)
+
+	id, err := connector.create(ctx, d, c, connector.settings())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if err := waitForDataSourceConnected(ctx, c, id, timeout); err != nil {
+		return dataSourceDiagnostics(err)
+	}
+
+	return resourceTypedDataSourceRead(ctx, d, meta, connector)
+}
+
+func resourceTypedDataSourceRead(ctx context.Context, d *schema.ResourceData, meta any, connector dataSourceConnector) diag.Diagnostics {
+	c := meta.(graphql.Client)
+
+	response, err := pc.DataSource(ctx, c, d.Id())
+	if err != nil {
+		return readError(d, err)
+	}
+
+	if response.DataSource == nil {
+		return readError(d, fmt.Errorf("Data Source \"%s\" %w", d.Id(), pc.ErrNotFound))
+	}
+
+	if dataSourceType := string(response.DataSource.GetType()); !strings.EqualFold(dataSourceType, connector.dataSourceType) {
+		return diag.Errorf("Data Source \"%s\" is a %s Data Source, not a %s Data Source", d.Id(), strings.ToUpper(dataSourceType), connector.dataSourceType)
+	}
+
+	if err := setDataSourceAttributes(d, response); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := connector.handle(response, d, connector.settings()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceTypedDataSourceUpdate(ctx context.Context, d *schema.ResourceData, meta any, connector dataSourceConnector) diag.Diagnostics {
+	c := meta.(graphql.Client)
+
+	if err := connector.update(ctx, d, c, connector.settings()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if err := waitForDataSourceConnected(ctx, c, d.Id(), timeout); err != nil {
+		return dataSourceDiagnostics(err)
+	}
+
+	return resourceTypedDataSourceRead(ctx, d, meta, connector)
+}
+
+func resourceTypedDataSourceDelete(ctx context.Context, d *schema.ResourceData, meta any, connector dataSourceConnector) diag.Diagnostics {
+	c := meta.(graphql.Client)
+
+	if connector.delete != nil {
+		if err := connector.delete(ctx, d, c, connector.settings()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if _, err := pc.DeleteDataSource(ctx, c, d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutDelete)
+	if err := waitForDataSourceDeletion(ctx, c, d.Id(), timeout); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// requiredSchema returns a copy of an optional block that is required instead, where every required string
+// attribute must also be non-empty.
+func requiredSchema(s *schema.Schema) *schema.Schema {
+	required := *s
+	required.Optional = false
+	required.Computed = false
+	required.Required = true
+
+	if elem, ok := s.Elem.(*schema.Resource); ok {
+		required.Elem = &schema.Resource{
+			Description: elem.Description,
+			Schema:      nonEmptySchema(elem.Schema),
+		}
+	}
+
+	return &required
+}
+
+func nonEmptySchema(rs map[string]*schema.Schema) map[string]*schema.Schema {
+	strict := make(map[string]*schema.Schema, len(rs))
+
+	for k, v := range rs {
+		attr := *v
+
+		if attr.Required && attr.Type == schema.TypeString && attr.ValidateFunc == nil && attr.ValidateDiagFunc == nil {
+			attr.ValidateFunc = validation.StringIsNotEmpty
+		}
+
+		if elem, ok := attr.Elem.(*schema.Resource); ok {
+			attr.Elem = &schema.Resource{
+				Description: elem.Description,
+				Schema:      nonEmptySchema(elem.Schema),
+			}
+		}
+
+		strict[k] = &attr
+	}
+
+	return strict
+}
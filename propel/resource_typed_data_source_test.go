@@ -0,0 +1,87 @@
+package propel
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPropelHttpDataSource(t *testing.T) {
+	t.Parallel()
+
+	ctx := map[string]any{
+		"unique_name": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckPropelDataSourceDestroy,
+		Steps: []resource.TestStep{
+			// should reject an empty basic auth username at plan time
+			{
+				Config: Nprintf(`
+				resource "propel_http_data_source" "test" {
+					unique_name = "%{unique_name}"
+
+					basic_auth {
+						username = ""
+						password = "bar"
+					}
+
+					table {
+						name = "CLUSTER_TEST_TABLE_1"
+
+						column {
+							name = "timestamp_tz"
+							type = "TIMESTAMP"
+							nullable = false
+						}
+					}
+				}`, ctx),
+				ExpectError: regexp.MustCompile(`expected "basic_auth.0.username" to not be an empty string`),
+			},
+			// should create the HTTP Data Source
+			{
+				Config: testAccCheckPropelHttpDataSourceConfig(ctx),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPropelDataSourceExists("propel_http_data_source.test"),
+					resource.TestCheckResourceAttr("propel_http_data_source.test", "status", "CONNECTED"),
+					resource.TestCheckResourceAttr("propel_http_data_source.test", "table.0.column.#", "1"),
+				),
+			},
+			// should import the HTTP Data Source
+			{
+				ResourceName:            "propel_http_data_source.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"basic_auth", "table"},
+			},
+		},
+	})
+}
+
+func testAccCheckPropelHttpDataSourceConfig(ctx map[string]any) string {
+	// language=hcl-terraform
+	return Nprintf(`
+	resource "propel_http_data_source" "test" {
+		unique_name = "%{unique_name}"
+
+		basic_auth {
+			username = "foo"
+			password = "bar"
+		}
+
+		table {
+			name = "CLUSTER_TEST_TABLE_1"
+
+			column {
+				name = "timestamp_tz"
+				type = "TIMESTAMP"
+				nullable = false
+			}
+		}
+	}`, ctx)
+}
@@ -0,0 +1,40 @@
+package propel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// NewProviderServer returns the gRPC server of the Propel provider, using protocol version 6. It serves the
+// resources built on terraform-plugin-sdk along with the ones migrated to terraform-plugin-framework, so resources
+// can be migrated one at a time.
+func NewProviderServer(ctx context.Context) (func() tfprotov6.ProviderServer, error) {
+	return newProviderServer(ctx, Provider())
+}
+
+func newProviderServer(ctx context.Context, p *schema.Provider) (func() tfprotov6.ProviderServer, error) {
+	sdkServer, err := tf5to6server.UpgradeServer(ctx, func() tfprotov5.ProviderServer {
+		return &providerServer{GRPCProviderServer: schema.NewGRPCProviderServer(p)}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The SDK server must come first: it configures the client that the framework provider shares.
+	muxServer, err := tf6muxserver.NewMuxServer(
+		ctx,
+		func() tfprotov6.ProviderServer { return sdkServer },
+		providerserver.NewProtocol6(newFrameworkProvider(p)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return muxServer.ProviderServer, nil
+}
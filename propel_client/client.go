@@ -1,7 +1,16 @@
 package client
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"os"
+	"time"
 
 	"github.com/Khan/genqlient/graphql"
 )
@@ -9,8 +18,52 @@ import (
 const (
 	defaultApiURL   = "https://api.us-east-2.propeldata.com/graphql"
 	defaultOauthURL = "https://auth.us-east-2.propeldata.com/oauth2/token"
+
+	defaultRequestTimeout = 60 * time.Second
 )
 
+// Option configures optional behavior of the Propel client.
+type Option func(*options)
+
+type options struct {
+	maxRetries     int
+	maxBackoff     time.Duration
+	requestTimeout time.Duration
+	proxyURL       string
+	caBundle       string
+}
+
+// WithRetries sets how many times a request failing with a transient error is retried, and the maximum delay
+// between two attempts. Setting maxRetries to 0 disables retries.
+func WithRetries(maxRetries int, maxBackoff time.Duration) Option {
+	return func(o *options) {
+		o.maxRetries = maxRetries
+		o.maxBackoff = maxBackoff
+	}
+}
+
+// WithRequestTimeout sets how long a single attempt of a request may take, including reading the response
+// body. Setting it to 0 disables the timeout.
+func WithRequestTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.requestTimeout = timeout
+	}
+}
+
+// WithProxy sends every request through the given proxy instead of the one configured in the environment.
+func WithProxy(proxyURL string) Option {
+	return func(o *options) {
+		o.proxyURL = proxyURL
+	}
+}
+
+// WithCABundle trusts the PEM encoded certificates in the given file in addition to the system's certificates.
+func WithCABundle(path string) Option {
+	return func(o *options) {
+		o.caBundle = path
+	}
+}
+
 type withHeaders struct {
 	headers   map[string]string
 	transport http.RoundTripper
@@ -23,33 +76,127 @@ func (wh *withHeaders) RoundTrip(req *http.Request) (*http.Response, error) {
 	return wh.transport.RoundTrip(req)
 }
 
-// NewAuthenticatedHttpClientWithHeaders returns a new, authenticated HTTP client if the user is authenticated;
-// otherwise, it prompts the user to authenticate before exiting with exit code 1.
+// withTimeout bounds every request sent through it, so each attempt of a retried request gets its own timeout.
+type withTimeout struct {
+	timeout   time.Duration
+	transport http.RoundTripper
+}
+
+func (wt *withTimeout) RoundTrip(req *http.Request) (*http.Response, error) {
+	if wt.timeout <= 0 {
+		return wt.transport.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), wt.timeout)
+
+	resp, err := wt.transport.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// The context must outlive RoundTrip until the body has been read.
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+
+	return resp, nil
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	defer c.cancel()
+	return c.ReadCloser.Close()
+}
+
+// newTransport returns a transport that is not shared with the rest of the process, configured with the proxy
+// and CA bundle from the options.
+func newTransport(opts *options) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if opts.proxyURL != "" {
+		proxyURL, err := url.Parse(opts.proxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if opts.caBundle != "" {
+		pem, err := os.ReadFile(opts.caBundle)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read CA bundle: %w", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.New("CA bundle does not contain any PEM encoded certificate")
+		}
+
+		transport.TLSClientConfig = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			RootCAs:    pool,
+		}
+	}
+
+	return transport, nil
+}
+
+// NewAuthenticatedHttpClientWithHeaders returns a new HTTP client that authenticates every request with an
+// access token from the given token source.
 //
-// Additionally, it allows including default headers.
-func newAuthenticatedHttpClientWithHeaders(headers map[string]string) *http.Client {
-	client := http.DefaultClient
-	client.Transport = &withHeaders{
-		headers:   headers,
-		transport: http.DefaultTransport,
+// Additionally, it allows including default headers and retries requests failing with a transient error.
+func newAuthenticatedHttpClientWithHeaders(transport http.RoundTripper, tokens *tokenSource, headers map[string]string, opts *options) *http.Client {
+	return &http.Client{
+		Transport: &withHeaders{
+			headers: headers,
+			transport: newWithRetry(opts.maxRetries, opts.maxBackoff, &withToken{
+				source: tokens,
+				transport: &withTimeout{
+					timeout:   opts.requestTimeout,
+					transport: transport,
+				},
+			}),
+		},
 	}
-	return client
 }
 
-func NewPropelClient(clientId string, secret string, userAgent string, oauthURL string, apiURL string) (graphql.Client, error) {
+func NewPropelClient(clientId string, secret string, userAgent string, oauthURL string, apiURL string, opts ...Option) (graphql.Client, error) {
+	o := &options{
+		maxRetries:     defaultMaxRetries,
+		maxBackoff:     defaultMaxBackoff,
+		requestTimeout: defaultRequestTimeout,
+	}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
 	if oauthURL == "" {
 		oauthURL = defaultOauthURL
 	}
 
-	token, err := getToken(oauthURL, clientId, secret)
+	transport, err := newTransport(o)
 	if err != nil {
 		return nil, err
 	}
 
-	httpClient := newAuthenticatedHttpClientWithHeaders(map[string]string{
-		"Authorization": "Bearer " + token,
-		"User-Agent":    userAgent,
-	})
+	// Fetch the first token right away so invalid credentials are reported when the client is created.
+	tokens := newTokenSource(&http.Client{Transport: transport, Timeout: o.requestTimeout}, oauthURL, clientId, secret)
+	if _, err := tokens.Token(""); err != nil {
+		return nil, err
+	}
+
+	httpClient := newAuthenticatedHttpClientWithHeaders(transport, tokens, map[string]string{
+		"User-Agent": userAgent,
+	}, o)
 
 	if apiURL == "" {
 		apiURL = defaultApiURL
@@ -57,7 +204,7 @@ func NewPropelClient(clientId string, secret string, userAgent string, oauthURL
 
 	gqlClient := graphql.NewClient(apiURL, httpClient)
 
-	return gqlClient, nil
+	return &withErrors{Client: gqlClient}, nil
 }
 
 //go:generate go run github.com/Khan/genqlient genqlient.yaml
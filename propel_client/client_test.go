@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewPropelClientIsolation(t *testing.T) {
+	a := assert.New(t)
+
+	var authorizations []string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth2/token" {
+			_ = r.ParseForm()
+			_ = json.NewEncoder(w).Encode(credentials{AccessToken: "token-" + r.Form.Get("client_id"), ExpiresIn: 3600})
+			return
+		}
+
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	caBundle := filepath.Join(t.TempDir(), "ca.pem")
+	a.NoError(os.WriteFile(caBundle, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0o600))
+
+	defaultTransport := http.DefaultClient.Transport
+
+	clients := make([]graphql.Client, 0, 2)
+	for _, clientId := range []string{"first", "second"} {
+		c, err := NewPropelClient(clientId, "secret", "test", server.URL+"/oauth2/token", server.URL+"/graphql", WithCABundle(caBundle))
+		a.NoError(err)
+
+		clients = append(clients, c)
+	}
+
+	for _, c := range clients {
+		err := c.MakeRequest(context.Background(), &graphql.Request{Query: "query Test { test }", OpName: "Test"}, &graphql.Response{})
+		a.NoError(err)
+	}
+
+	a.Equal([]string{"Bearer token-first", "Bearer token-second"}, authorizations, "each client should use its own credentials")
+	a.Equal(defaultTransport, http.DefaultClient.Transport, "http.DefaultClient should not be modified")
+}
+
+func Test_NewPropelClientUntrustedCertificate(t *testing.T) {
+	a := assert.New(t)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	_, err := NewPropelClient("id", "secret", "test", server.URL+"/oauth2/token", server.URL+"/graphql", WithRetries(0, time.Second))
+	a.ErrorContains(err, "certificate")
+}
+
+func Test_withTimeout(t *testing.T) {
+	a := assert.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/slow") {
+			time.Sleep(200 * time.Millisecond)
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &withTimeout{timeout: 50 * time.Millisecond, transport: http.DefaultTransport}}
+
+	resp, err := client.Get(server.URL + "/fast")
+	a.NoError(err)
+	a.NoError(resp.Body.Close())
+
+	_, err = client.Get(server.URL + "/slow")
+	a.ErrorIs(err, context.DeadlineExceeded)
+}
@@ -0,0 +1,132 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// The kinds of errors returned by the Propel API. Match them with errors.Is.
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrValidation   = errors.New("invalid input")
+	ErrRateLimited  = errors.New("rate limited")
+)
+
+// errorKinds maps the codes found in the GraphQL error extensions to the kind of error they report.
+var errorKinds = map[string]error{
+	"NOT_FOUND":                 ErrNotFound,
+	"UNAUTHENTICATED":           ErrUnauthorized,
+	"UNAUTHORIZED":              ErrUnauthorized,
+	"FORBIDDEN":                 ErrUnauthorized,
+	"BAD_USER_INPUT":            ErrValidation,
+	"BAD_REQUEST":               ErrValidation,
+	"VALIDATION_ERROR":          ErrValidation,
+	"GRAPHQL_VALIDATION_FAILED": ErrValidation,
+	"RATE_LIMITED":              ErrRateLimited,
+	"TOO_MANY_REQUESTS":         ErrRateLimited,
+}
+
+// Error is an error returned by the Propel API, classified by the code in its GraphQL error extensions.
+type Error struct {
+	// Kind is ErrNotFound, ErrUnauthorized, ErrValidation or ErrRateLimited, or nil if the error is of another kind.
+	Kind error
+	// Code is the code of the classified error, as found in its extensions.
+	Code string
+	// Errors are the GraphQL errors as returned by the API.
+	Errors gqlerror.List
+}
+
+func (e *Error) Error() string {
+	return e.Errors.Error()
+}
+
+func (e *Error) Unwrap() []error {
+	if e.Kind == nil {
+		return []error{e.Errors}
+	}
+
+	return []error{e.Kind, e.Errors}
+}
+
+// IsNotFound reports whether the API responded that the requested object does not exist.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// newError classifies the GraphQL errors returned by the API. The first error of a known kind determines the kind
+// of the whole response. Missing objects are also recognized by their message when the error has no code, but only
+// for the objects a query looks up, so that the errors about other objects, e.g. in a mutation's input, are not
+// mistaken for them.
+func newError(errs gqlerror.List, query bool) *Error {
+	e := &Error{Errors: errs}
+
+	for _, gqlErr := range errs {
+		code := errorCode(gqlErr)
+		if kind, ok := errorKinds[code]; ok {
+			e.Kind, e.Code = kind, code
+			return e
+		}
+	}
+
+	if !query {
+		return e
+	}
+
+	for _, gqlErr := range errs {
+		// The looked up object is the query's top-level field.
+		if len(gqlErr.Path) == 1 && strings.Contains(strings.ToLower(gqlErr.Message), "not found") {
+			e.Kind = ErrNotFound
+			return e
+		}
+	}
+
+	return e
+}
+
+// errorCode returns the normalized code from a GraphQL error's extensions, e.g. "NOT_FOUND" for "NotFound".
+func errorCode(gqlErr *gqlerror.Error) string {
+	code, ok := gqlErr.Extensions["code"]
+	if !ok || code == nil {
+		return ""
+	}
+
+	raw := fmt.Sprint(code)
+	camelCase := raw != strings.ToUpper(raw)
+
+	var b strings.Builder
+	for i, r := range raw {
+		switch {
+		case r == '-' || r == ' ':
+			r = '_'
+		case camelCase && i > 0 && unicode.IsUpper(r):
+			b.WriteRune('_')
+		}
+
+		b.WriteRune(r)
+	}
+
+	return strings.ToUpper(b.String())
+}
+
+// withErrors converts the GraphQL errors returned by the client into an Error.
+type withErrors struct {
+	graphql.Client
+}
+
+func (we *withErrors) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	err := we.Client.MakeRequest(ctx, req, resp)
+
+	var gqlErrs gqlerror.List
+	if errors.As(err, &gqlErrs) {
+		return newError(gqlErrs, strings.HasPrefix(strings.TrimSpace(req.Query), "query"))
+	}
+
+	return err
+}
@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// errorsClient fails every request with the given GraphQL errors.
+type errorsClient struct {
+	errs gqlerror.List
+}
+
+func (c *errorsClient) MakeRequest(_ context.Context, _ *graphql.Request, _ *graphql.Response) error {
+	return c.errs
+}
+
+func Test_withErrors(t *testing.T) {
+	tests := []struct {
+		name         string
+		query        string
+		errors       string
+		expectedKind error
+		expectedCode string
+	}{
+		{
+			name:         "Not found code",
+			errors:       `[{"message": "Data Pool DPO00000000000000000000000000 does not exist", "extensions": {"code": "NOT_FOUND"}}]`,
+			expectedKind: ErrNotFound,
+			expectedCode: "NOT_FOUND",
+		},
+		{
+			name:         "Camel case code",
+			errors:       `[{"message": "Too many requests", "extensions": {"code": "TooManyRequests"}}]`,
+			expectedKind: ErrRateLimited,
+			expectedCode: "TOO_MANY_REQUESTS",
+		},
+		{
+			name:         "Unauthorized",
+			errors:       `[{"message": "Access denied", "extensions": {"code": "FORBIDDEN"}}]`,
+			expectedKind: ErrUnauthorized,
+			expectedCode: "FORBIDDEN",
+		},
+		{
+			name:         "Validation",
+			errors:       `[{"message": "Invalid input"}, {"message": "Unique name is taken", "extensions": {"code": "BAD_USER_INPUT"}}]`,
+			expectedKind: ErrValidation,
+			expectedCode: "BAD_USER_INPUT",
+		},
+		{
+			name:         "Not found message of the queried object",
+			query:        `query Metric($id: ID!) { metric(id: $id) { id } }`,
+			errors:       `[{"message": "Metric not found", "path": ["metric"]}]`,
+			expectedKind: ErrNotFound,
+		},
+		{
+			name:   "Not found message of a nested object",
+			query:  `query Metric($id: ID!) { metric(id: $id) { id dataPool { id } } }`,
+			errors: `[{"message": "Data Pool not found", "path": ["metric", "dataPool"]}]`,
+		},
+		{
+			name:   "Not found message in a mutation",
+			query:  `mutation CreateSumMetric($input: CreateSumMetricInput!) { createSumMetric(input: $input) { metric { id } } }`,
+			errors: `[{"message": "Data Pool not found", "path": ["createSumMetric"]}]`,
+		},
+		{
+			name:   "Unknown error",
+			errors: `[{"message": "Internal server error", "extensions": {"code": "INTERNAL_SERVER_ERROR"}}]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(st *testing.T) {
+			a := assert.New(st)
+
+			var errs gqlerror.List
+			a.NoError(json.Unmarshal([]byte(tt.errors), &errs))
+
+			c := &withErrors{Client: &errorsClient{errs: errs}}
+			err := c.MakeRequest(context.Background(), &graphql.Request{Query: tt.query}, &graphql.Response{})
+
+			var apiErr *Error
+			a.True(errors.As(err, &apiErr))
+			a.Equal(tt.expectedKind, apiErr.Kind)
+			a.Equal(tt.expectedCode, apiErr.Code)
+			a.Equal(errs.Error(), err.Error())
+			a.Equal(tt.expectedKind == ErrNotFound, IsNotFound(err))
+
+			var gqlErrs gqlerror.List
+			a.True(errors.As(err, &gqlErrs), "the GraphQL errors should still be accessible")
+
+			if tt.expectedKind != nil {
+				a.ErrorIs(err, tt.expectedKind)
+			}
+		})
+	}
+}
@@ -112,6 +112,139 @@ func (v *AddColumnToDataPoolJobAddColumnToDataPoolJob) __premarshalJSON() (*__pr
 	return &retval, nil
 }
 
+// AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnection includes the requested fields of the GraphQL type AddColumnToDataPoolJobConnection.
+// The GraphQL type's documentation follows.
+//
+// The Add column to Data Pool Job connection object.
+//
+// Learn more about [pagination in GraphQL](https://www.propeldata.com/docs/api/pagination).
+type AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnection struct {
+	// The Add column to Data Pool Job connection's page info.
+	PageInfo *AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnectionPageInfo `json:"pageInfo"`
+	// The Add column to Data Pool Job connection's nodes.
+	Nodes []*AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnectionNodesAddColumnToDataPoolJob `json:"nodes"`
+}
+
+// GetPageInfo returns AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnection) GetPageInfo() *AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetNodes returns AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnection) GetNodes() []*AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnectionNodesAddColumnToDataPoolJob {
+	return v.Nodes
+}
+
+// AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnectionNodesAddColumnToDataPoolJob includes the requested fields of the GraphQL type AddColumnToDataPoolJob.
+// The GraphQL type's documentation follows.
+//
+// AddColumnToDataPoolJob scheduled for a specific Data Pool.
+//
+// The Add Column Job represents the asynchronous process of adding a column,
+// given its name and type, to a Data Pool. It tracks the process of adding a column
+// until it is finished, showing the progress and the outcome when it is finished.
+type AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnectionNodesAddColumnToDataPoolJob struct {
+	// The AddColumnToDataPoolJob's ID.
+	Id string `json:"id"`
+}
+
+// GetId returns AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnectionNodesAddColumnToDataPoolJob.Id, and is useful for accessing the field via an interface.
+func (v *AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnectionNodesAddColumnToDataPoolJob) GetId() string {
+	return v.Id
+}
+
+// AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// The page info object used for pagination.
+type AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnectionPageInfo struct {
+	PageInfoData `json:"-"`
+}
+
+// GetStartCursor returns AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnectionPageInfo.StartCursor, and is useful for accessing the field via an interface.
+func (v *AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnectionPageInfo) GetStartCursor() *string {
+	return v.PageInfoData.StartCursor
+}
+
+// GetEndCursor returns AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnectionPageInfo) GetEndCursor() *string {
+	return v.PageInfoData.EndCursor
+}
+
+// GetHasNextPage returns AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnectionPageInfo) GetHasNextPage() bool {
+	return v.PageInfoData.HasNextPage
+}
+
+// GetHasPreviousPage returns AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnectionPageInfo.HasPreviousPage, and is useful for accessing the field via an interface.
+func (v *AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnectionPageInfo) GetHasPreviousPage() bool {
+	return v.PageInfoData.HasPreviousPage
+}
+
+func (v *AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnectionPageInfo) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnectionPageInfo
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnectionPageInfo = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PageInfoData)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnectionPageInfo struct {
+	StartCursor *string `json:"startCursor"`
+
+	EndCursor *string `json:"endCursor"`
+
+	HasNextPage bool `json:"hasNextPage"`
+
+	HasPreviousPage bool `json:"hasPreviousPage"`
+}
+
+func (v *AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnectionPageInfo) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnectionPageInfo) __premarshalJSON() (*__premarshalAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnectionPageInfo, error) {
+	var retval __premarshalAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnectionPageInfo
+
+	retval.StartCursor = v.PageInfoData.StartCursor
+	retval.EndCursor = v.PageInfoData.EndCursor
+	retval.HasNextPage = v.PageInfoData.HasNextPage
+	retval.HasPreviousPage = v.PageInfoData.HasPreviousPage
+	return &retval, nil
+}
+
+// AddColumnToDataPoolJobByStatusResponse is returned by AddColumnToDataPoolJobByStatus on success.
+type AddColumnToDataPoolJobByStatusResponse struct {
+	// Returns the AddColumnToDataPool Job specified by a given status.
+	AddColumnToDataPoolJobByStatus *AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnection `json:"addColumnToDataPoolJobByStatus"`
+}
+
+// GetAddColumnToDataPoolJobByStatus returns AddColumnToDataPoolJobByStatusResponse.AddColumnToDataPoolJobByStatus, and is useful for accessing the field via an interface.
+func (v *AddColumnToDataPoolJobByStatusResponse) GetAddColumnToDataPoolJobByStatus() *AddColumnToDataPoolJobByStatusAddColumnToDataPoolJobByStatusAddColumnToDataPoolJobConnection {
+	return v.AddColumnToDataPoolJobByStatus
+}
+
 // AddColumnToDataPoolJobData includes the GraphQL fields of AddColumnToDataPoolJob requested by the fragment AddColumnToDataPoolJobData.
 // The GraphQL type's documentation follows.
 //
@@ -404,6 +537,172 @@ func (v *ApplicationApplication) __premarshalJSON() (*__premarshalApplicationApp
 	return &retval, nil
 }
 
+// ApplicationByNameApplication includes the requested fields of the GraphQL type Application.
+// The GraphQL type's documentation follows.
+//
+// The Application object.
+//
+// Propel Applications represent the web or mobile app you are building. They provide the API credentials that allow your client- or server-side app to access the Propel API. The Application's Propeller determines the speed and cost of your Metric Queries.
+type ApplicationByNameApplication struct {
+	ApplicationData `json:"-"`
+}
+
+// GetId returns ApplicationByNameApplication.Id, and is useful for accessing the field via an interface.
+func (v *ApplicationByNameApplication) GetId() string { return v.ApplicationData.Id }
+
+// GetClientId returns ApplicationByNameApplication.ClientId, and is useful for accessing the field via an interface.
+func (v *ApplicationByNameApplication) GetClientId() string { return v.ApplicationData.ClientId }
+
+// GetSecret returns ApplicationByNameApplication.Secret, and is useful for accessing the field via an interface.
+func (v *ApplicationByNameApplication) GetSecret() *string { return v.ApplicationData.Secret }
+
+// GetScopes returns ApplicationByNameApplication.Scopes, and is useful for accessing the field via an interface.
+func (v *ApplicationByNameApplication) GetScopes() []ApplicationScope {
+	return v.ApplicationData.Scopes
+}
+
+// GetPropeller returns ApplicationByNameApplication.Propeller, and is useful for accessing the field via an interface.
+func (v *ApplicationByNameApplication) GetPropeller() Propeller { return v.ApplicationData.Propeller }
+
+// GetDataPoolAccessPolicies returns ApplicationByNameApplication.DataPoolAccessPolicies, and is useful for accessing the field via an interface.
+func (v *ApplicationByNameApplication) GetDataPoolAccessPolicies() *ApplicationDataDataPoolAccessPoliciesDataPoolAccessPolicyConnection {
+	return v.ApplicationData.DataPoolAccessPolicies
+}
+
+// GetUniqueName returns ApplicationByNameApplication.UniqueName, and is useful for accessing the field via an interface.
+func (v *ApplicationByNameApplication) GetUniqueName() string {
+	return v.ApplicationData.CommonDataApplication.UniqueName
+}
+
+// GetDescription returns ApplicationByNameApplication.Description, and is useful for accessing the field via an interface.
+func (v *ApplicationByNameApplication) GetDescription() string {
+	return v.ApplicationData.CommonDataApplication.Description
+}
+
+// GetAccount returns ApplicationByNameApplication.Account, and is useful for accessing the field via an interface.
+func (v *ApplicationByNameApplication) GetAccount() *CommonDataAccount {
+	return v.ApplicationData.CommonDataApplication.Account
+}
+
+// GetEnvironment returns ApplicationByNameApplication.Environment, and is useful for accessing the field via an interface.
+func (v *ApplicationByNameApplication) GetEnvironment() *CommonDataEnvironment {
+	return v.ApplicationData.CommonDataApplication.Environment
+}
+
+// GetCreatedAt returns ApplicationByNameApplication.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ApplicationByNameApplication) GetCreatedAt() time.Time {
+	return v.ApplicationData.CommonDataApplication.CreatedAt
+}
+
+// GetModifiedAt returns ApplicationByNameApplication.ModifiedAt, and is useful for accessing the field via an interface.
+func (v *ApplicationByNameApplication) GetModifiedAt() time.Time {
+	return v.ApplicationData.CommonDataApplication.ModifiedAt
+}
+
+// GetCreatedBy returns ApplicationByNameApplication.CreatedBy, and is useful for accessing the field via an interface.
+func (v *ApplicationByNameApplication) GetCreatedBy() string {
+	return v.ApplicationData.CommonDataApplication.CreatedBy
+}
+
+// GetModifiedBy returns ApplicationByNameApplication.ModifiedBy, and is useful for accessing the field via an interface.
+func (v *ApplicationByNameApplication) GetModifiedBy() string {
+	return v.ApplicationData.CommonDataApplication.ModifiedBy
+}
+
+func (v *ApplicationByNameApplication) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ApplicationByNameApplication
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ApplicationByNameApplication = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ApplicationData)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalApplicationByNameApplication struct {
+	Id string `json:"id"`
+
+	ClientId string `json:"clientId"`
+
+	Secret *string `json:"secret"`
+
+	Scopes []ApplicationScope `json:"scopes"`
+
+	Propeller Propeller `json:"propeller"`
+
+	DataPoolAccessPolicies *ApplicationDataDataPoolAccessPoliciesDataPoolAccessPolicyConnection `json:"dataPoolAccessPolicies"`
+
+	UniqueName string `json:"uniqueName"`
+
+	Description string `json:"description"`
+
+	Account *CommonDataAccount `json:"account"`
+
+	Environment *CommonDataEnvironment `json:"environment"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	ModifiedAt time.Time `json:"modifiedAt"`
+
+	CreatedBy string `json:"createdBy"`
+
+	ModifiedBy string `json:"modifiedBy"`
+}
+
+func (v *ApplicationByNameApplication) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ApplicationByNameApplication) __premarshalJSON() (*__premarshalApplicationByNameApplication, error) {
+	var retval __premarshalApplicationByNameApplication
+
+	retval.Id = v.ApplicationData.Id
+	retval.ClientId = v.ApplicationData.ClientId
+	retval.Secret = v.ApplicationData.Secret
+	retval.Scopes = v.ApplicationData.Scopes
+	retval.Propeller = v.ApplicationData.Propeller
+	retval.DataPoolAccessPolicies = v.ApplicationData.DataPoolAccessPolicies
+	retval.UniqueName = v.ApplicationData.CommonDataApplication.UniqueName
+	retval.Description = v.ApplicationData.CommonDataApplication.Description
+	retval.Account = v.ApplicationData.CommonDataApplication.Account
+	retval.Environment = v.ApplicationData.CommonDataApplication.Environment
+	retval.CreatedAt = v.ApplicationData.CommonDataApplication.CreatedAt
+	retval.ModifiedAt = v.ApplicationData.CommonDataApplication.ModifiedAt
+	retval.CreatedBy = v.ApplicationData.CommonDataApplication.CreatedBy
+	retval.ModifiedBy = v.ApplicationData.CommonDataApplication.ModifiedBy
+	return &retval, nil
+}
+
+// ApplicationByNameResponse is returned by ApplicationByName on success.
+type ApplicationByNameResponse struct {
+	// Returns the Application with the given unique name.
+	Application *ApplicationByNameApplication `json:"application"`
+}
+
+// GetApplication returns ApplicationByNameResponse.Application, and is useful for accessing the field via an interface.
+func (v *ApplicationByNameResponse) GetApplication() *ApplicationByNameApplication {
+	return v.Application
+}
+
 // ApplicationData includes the GraphQL fields of Application requested by the fragment ApplicationData.
 // The GraphQL type's documentation follows.
 //
@@ -591,6 +890,11 @@ func (v *ApplicationDataDataPoolAccessPoliciesDataPoolAccessPolicyConnectionNode
 	return v.DataPoolAccessPolicyData.Rows
 }
 
+// GetFilterSql returns ApplicationDataDataPoolAccessPoliciesDataPoolAccessPolicyConnectionNodesDataPoolAccessPolicy.FilterSql, and is useful for accessing the field via an interface.
+func (v *ApplicationDataDataPoolAccessPoliciesDataPoolAccessPolicyConnectionNodesDataPoolAccessPolicy) GetFilterSql() *string {
+	return v.DataPoolAccessPolicyData.FilterSql
+}
+
 // GetDataPool returns ApplicationDataDataPoolAccessPoliciesDataPoolAccessPolicyConnectionNodesDataPoolAccessPolicy.DataPool, and is useful for accessing the field via an interface.
 func (v *ApplicationDataDataPoolAccessPoliciesDataPoolAccessPolicyConnectionNodesDataPoolAccessPolicy) GetDataPool() *DataPoolAccessPolicyDataDataPool {
 	return v.DataPoolAccessPolicyData.DataPool
@@ -668,6 +972,8 @@ type __premarshalApplicationDataDataPoolAccessPoliciesDataPoolAccessPolicyConnec
 
 	Rows []*DataPoolAccessPolicyDataRowsFilter `json:"rows"`
 
+	FilterSql *string `json:"filterSql"`
+
 	DataPool *DataPoolAccessPolicyDataDataPool `json:"dataPool"`
 
 	UniqueName string `json:"uniqueName"`
@@ -701,6 +1007,7 @@ func (v *ApplicationDataDataPoolAccessPoliciesDataPoolAccessPolicyConnectionNode
 	retval.Id = v.DataPoolAccessPolicyData.Id
 	retval.Columns = v.DataPoolAccessPolicyData.Columns
 	retval.Rows = v.DataPoolAccessPolicyData.Rows
+	retval.FilterSql = v.DataPoolAccessPolicyData.FilterSql
 	retval.DataPool = v.DataPoolAccessPolicyData.DataPool
 	retval.UniqueName = v.DataPoolAccessPolicyData.CommonDataDataPoolAccessPolicy.UniqueName
 	retval.Description = v.DataPoolAccessPolicyData.CommonDataDataPoolAccessPolicy.Description
@@ -776,6 +1083,435 @@ type BackfillOptionsInput struct {
 // GetBackfill returns BackfillOptionsInput.Backfill, and is useful for accessing the field via an interface.
 func (v *BackfillOptionsInput) GetBackfill() *bool { return v.Backfill }
 
+// BoosterBooster includes the requested fields of the GraphQL type Booster.
+// The GraphQL type's documentation follows.
+//
+// Boosters allow you to optimize Metric Queries for a subset of commonly used Dimensions. A Metric can have one or many Boosters to optimize for the different Query patterns.
+//
+// Boosters can be understood as an aggregating index. The index is formed from left to right as follows:
+//
+// 1. The Data Pool's Tenant ID column (if present)
+// 2. Metric Filter columns (if present)
+// 3. Query Filter Dimensions (see `dimensions`)
+// 4. The Data Pool's timestamp column
+type BoosterBooster struct {
+	BoosterData `json:"-"`
+}
+
+// GetId returns BoosterBooster.Id, and is useful for accessing the field via an interface.
+func (v *BoosterBooster) GetId() string { return v.BoosterData.Id }
+
+// GetAccount returns BoosterBooster.Account, and is useful for accessing the field via an interface.
+func (v *BoosterBooster) GetAccount() *BoosterDataAccount { return v.BoosterData.Account }
+
+// GetEnvironment returns BoosterBooster.Environment, and is useful for accessing the field via an interface.
+func (v *BoosterBooster) GetEnvironment() *BoosterDataEnvironment { return v.BoosterData.Environment }
+
+// GetMetric returns BoosterBooster.Metric, and is useful for accessing the field via an interface.
+func (v *BoosterBooster) GetMetric() *BoosterDataMetric { return v.BoosterData.Metric }
+
+// GetStatus returns BoosterBooster.Status, and is useful for accessing the field via an interface.
+func (v *BoosterBooster) GetStatus() BoosterStatus { return v.BoosterData.Status }
+
+// GetError returns BoosterBooster.Error, and is useful for accessing the field via an interface.
+func (v *BoosterBooster) GetError() *BoosterDataError { return v.BoosterData.Error }
+
+// GetProgress returns BoosterBooster.Progress, and is useful for accessing the field via an interface.
+func (v *BoosterBooster) GetProgress() *float64 { return v.BoosterData.Progress }
+
+// GetDimensions returns BoosterBooster.Dimensions, and is useful for accessing the field via an interface.
+func (v *BoosterBooster) GetDimensions() []*BoosterDataDimensionsDimension {
+	return v.BoosterData.Dimensions
+}
+
+// GetRecordCount returns BoosterBooster.RecordCount, and is useful for accessing the field via an interface.
+func (v *BoosterBooster) GetRecordCount() *string { return v.BoosterData.RecordCount }
+
+// GetSizeInTerabytes returns BoosterBooster.SizeInTerabytes, and is useful for accessing the field via an interface.
+func (v *BoosterBooster) GetSizeInTerabytes() *float64 { return v.BoosterData.SizeInTerabytes }
+
+// GetCreatedAt returns BoosterBooster.CreatedAt, and is useful for accessing the field via an interface.
+func (v *BoosterBooster) GetCreatedAt() time.Time { return v.BoosterData.CreatedAt }
+
+// GetModifiedAt returns BoosterBooster.ModifiedAt, and is useful for accessing the field via an interface.
+func (v *BoosterBooster) GetModifiedAt() time.Time { return v.BoosterData.ModifiedAt }
+
+// GetCreatedBy returns BoosterBooster.CreatedBy, and is useful for accessing the field via an interface.
+func (v *BoosterBooster) GetCreatedBy() string { return v.BoosterData.CreatedBy }
+
+// GetModifiedBy returns BoosterBooster.ModifiedBy, and is useful for accessing the field via an interface.
+func (v *BoosterBooster) GetModifiedBy() string { return v.BoosterData.ModifiedBy }
+
+func (v *BoosterBooster) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*BoosterBooster
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.BoosterBooster = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.BoosterData)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalBoosterBooster struct {
+	Id string `json:"id"`
+
+	Account *BoosterDataAccount `json:"account"`
+
+	Environment *BoosterDataEnvironment `json:"environment"`
+
+	Metric *BoosterDataMetric `json:"metric"`
+
+	Status BoosterStatus `json:"status"`
+
+	Error *BoosterDataError `json:"error"`
+
+	Progress *float64 `json:"progress"`
+
+	Dimensions []*BoosterDataDimensionsDimension `json:"dimensions"`
+
+	RecordCount *string `json:"recordCount"`
+
+	SizeInTerabytes *float64 `json:"sizeInTerabytes"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	ModifiedAt time.Time `json:"modifiedAt"`
+
+	CreatedBy string `json:"createdBy"`
+
+	ModifiedBy string `json:"modifiedBy"`
+}
+
+func (v *BoosterBooster) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *BoosterBooster) __premarshalJSON() (*__premarshalBoosterBooster, error) {
+	var retval __premarshalBoosterBooster
+
+	retval.Id = v.BoosterData.Id
+	retval.Account = v.BoosterData.Account
+	retval.Environment = v.BoosterData.Environment
+	retval.Metric = v.BoosterData.Metric
+	retval.Status = v.BoosterData.Status
+	retval.Error = v.BoosterData.Error
+	retval.Progress = v.BoosterData.Progress
+	retval.Dimensions = v.BoosterData.Dimensions
+	retval.RecordCount = v.BoosterData.RecordCount
+	retval.SizeInTerabytes = v.BoosterData.SizeInTerabytes
+	retval.CreatedAt = v.BoosterData.CreatedAt
+	retval.ModifiedAt = v.BoosterData.ModifiedAt
+	retval.CreatedBy = v.BoosterData.CreatedBy
+	retval.ModifiedBy = v.BoosterData.ModifiedBy
+	return &retval, nil
+}
+
+// BoosterData includes the GraphQL fields of Booster requested by the fragment BoosterData.
+// The GraphQL type's documentation follows.
+//
+// Boosters allow you to optimize Metric Queries for a subset of commonly used Dimensions. A Metric can have one or many Boosters to optimize for the different Query patterns.
+//
+// Boosters can be understood as an aggregating index. The index is formed from left to right as follows:
+//
+// 1. The Data Pool's Tenant ID column (if present)
+// 2. Metric Filter columns (if present)
+// 3. Query Filter Dimensions (see `dimensions`)
+// 4. The Data Pool's timestamp column
+type BoosterData struct {
+	// The Booster's unique identifier.
+	Id string `json:"id"`
+	// The Booster's Account.
+	Account *BoosterDataAccount `json:"account"`
+	// The Booster's Environment.
+	Environment *BoosterDataEnvironment `json:"environment"`
+	// The Metric this Booster is associated to.
+	Metric *BoosterDataMetric `json:"metric"`
+	// The status of the Booster (once LIVE it will be available for speeding up Metric queries).
+	Status BoosterStatus `json:"status"`
+	// If the Booster fails during the optimization process, this field includes a descriptive
+	// error message.
+	Error *BoosterDataError `json:"error"`
+	// When the Booster is OPTIMIZING, this represents its progress as a number from 0 to 1.
+	// In all other states, progress is null.
+	Progress *float64 `json:"progress"`
+	// Dimensions included in the Booster.
+	Dimensions []*BoosterDataDimensionsDimension `json:"dimensions"`
+	// The number of records in the Booster.
+	RecordCount *string `json:"recordCount"`
+	// The amount of storage in terabytes used by the Booster.
+	SizeInTerabytes *float64 `json:"sizeInTerabytes"`
+	// The Booster's creation date and time in UTC.
+	CreatedAt time.Time `json:"createdAt"`
+	// The Booster's last modification date and time in UTC.
+	ModifiedAt time.Time `json:"modifiedAt"`
+	// The Booster's creator. It can be either a User ID, an Application ID, or "system" if it was created by Propel.
+	CreatedBy string `json:"createdBy"`
+	// The Booster's last modifier. It can be either a User ID, an Application ID, or "system" if it was modified by Propel.
+	ModifiedBy string `json:"modifiedBy"`
+}
+
+// GetId returns BoosterData.Id, and is useful for accessing the field via an interface.
+func (v *BoosterData) GetId() string { return v.Id }
+
+// GetAccount returns BoosterData.Account, and is useful for accessing the field via an interface.
+func (v *BoosterData) GetAccount() *BoosterDataAccount { return v.Account }
+
+// GetEnvironment returns BoosterData.Environment, and is useful for accessing the field via an interface.
+func (v *BoosterData) GetEnvironment() *BoosterDataEnvironment { return v.Environment }
+
+// GetMetric returns BoosterData.Metric, and is useful for accessing the field via an interface.
+func (v *BoosterData) GetMetric() *BoosterDataMetric { return v.Metric }
+
+// GetStatus returns BoosterData.Status, and is useful for accessing the field via an interface.
+func (v *BoosterData) GetStatus() BoosterStatus { return v.Status }
+
+// GetError returns BoosterData.Error, and is useful for accessing the field via an interface.
+func (v *BoosterData) GetError() *BoosterDataError { return v.Error }
+
+// GetProgress returns BoosterData.Progress, and is useful for accessing the field via an interface.
+func (v *BoosterData) GetProgress() *float64 { return v.Progress }
+
+// GetDimensions returns BoosterData.Dimensions, and is useful for accessing the field via an interface.
+func (v *BoosterData) GetDimensions() []*BoosterDataDimensionsDimension { return v.Dimensions }
+
+// GetRecordCount returns BoosterData.RecordCount, and is useful for accessing the field via an interface.
+func (v *BoosterData) GetRecordCount() *string { return v.RecordCount }
+
+// GetSizeInTerabytes returns BoosterData.SizeInTerabytes, and is useful for accessing the field via an interface.
+func (v *BoosterData) GetSizeInTerabytes() *float64 { return v.SizeInTerabytes }
+
+// GetCreatedAt returns BoosterData.CreatedAt, and is useful for accessing the field via an interface.
+func (v *BoosterData) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetModifiedAt returns BoosterData.ModifiedAt, and is useful for accessing the field via an interface.
+func (v *BoosterData) GetModifiedAt() time.Time { return v.ModifiedAt }
+
+// GetCreatedBy returns BoosterData.CreatedBy, and is useful for accessing the field via an interface.
+func (v *BoosterData) GetCreatedBy() string { return v.CreatedBy }
+
+// GetModifiedBy returns BoosterData.ModifiedBy, and is useful for accessing the field via an interface.
+func (v *BoosterData) GetModifiedBy() string { return v.ModifiedBy }
+
+// BoosterDataAccount includes the requested fields of the GraphQL type Account.
+// The GraphQL type's documentation follows.
+//
+// The Account object.
+type BoosterDataAccount struct {
+	// The Account's unique identifier.
+	Id string `json:"id"`
+}
+
+// GetId returns BoosterDataAccount.Id, and is useful for accessing the field via an interface.
+func (v *BoosterDataAccount) GetId() string { return v.Id }
+
+// BoosterDataDimensionsDimension includes the requested fields of the GraphQL type Dimension.
+// The GraphQL type's documentation follows.
+//
+// The Dimension object that represents a column in a table.
+type BoosterDataDimensionsDimension struct {
+	DimensionData `json:"-"`
+}
+
+// GetColumnName returns BoosterDataDimensionsDimension.ColumnName, and is useful for accessing the field via an interface.
+func (v *BoosterDataDimensionsDimension) GetColumnName() string { return v.DimensionData.ColumnName }
+
+// GetType returns BoosterDataDimensionsDimension.Type, and is useful for accessing the field via an interface.
+func (v *BoosterDataDimensionsDimension) GetType() string { return v.DimensionData.Type }
+
+// GetIsNullable returns BoosterDataDimensionsDimension.IsNullable, and is useful for accessing the field via an interface.
+func (v *BoosterDataDimensionsDimension) GetIsNullable() *bool { return v.DimensionData.IsNullable }
+
+// GetIsUniqueKey returns BoosterDataDimensionsDimension.IsUniqueKey, and is useful for accessing the field via an interface.
+func (v *BoosterDataDimensionsDimension) GetIsUniqueKey() *bool { return v.DimensionData.IsUniqueKey }
+
+func (v *BoosterDataDimensionsDimension) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*BoosterDataDimensionsDimension
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.BoosterDataDimensionsDimension = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.DimensionData)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalBoosterDataDimensionsDimension struct {
+	ColumnName string `json:"columnName"`
+
+	Type string `json:"type"`
+
+	IsNullable *bool `json:"isNullable"`
+
+	IsUniqueKey *bool `json:"isUniqueKey"`
+}
+
+func (v *BoosterDataDimensionsDimension) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *BoosterDataDimensionsDimension) __premarshalJSON() (*__premarshalBoosterDataDimensionsDimension, error) {
+	var retval __premarshalBoosterDataDimensionsDimension
+
+	retval.ColumnName = v.DimensionData.ColumnName
+	retval.Type = v.DimensionData.Type
+	retval.IsNullable = v.DimensionData.IsNullable
+	retval.IsUniqueKey = v.DimensionData.IsUniqueKey
+	return &retval, nil
+}
+
+// BoosterDataEnvironment includes the requested fields of the GraphQL type Environment.
+// The GraphQL type's documentation follows.
+//
+// The Environments object.
+//
+// Environments are independent and isolated Propel workspaces for development, staging (testing), and production workloads. Environments are hosted in a specific region, initially in us-east-2 only.
+type BoosterDataEnvironment struct {
+	// The Environment's unique identifier.
+	Id string `json:"id"`
+}
+
+// GetId returns BoosterDataEnvironment.Id, and is useful for accessing the field via an interface.
+func (v *BoosterDataEnvironment) GetId() string { return v.Id }
+
+// BoosterDataError includes the requested fields of the GraphQL type Error.
+// The GraphQL type's documentation follows.
+//
+// The error object.
+type BoosterDataError struct {
+	GqlError `json:"-"`
+}
+
+// GetCode returns BoosterDataError.Code, and is useful for accessing the field via an interface.
+func (v *BoosterDataError) GetCode() *int { return v.GqlError.Code }
+
+// GetMessage returns BoosterDataError.Message, and is useful for accessing the field via an interface.
+func (v *BoosterDataError) GetMessage() string { return v.GqlError.Message }
+
+func (v *BoosterDataError) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*BoosterDataError
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.BoosterDataError = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.GqlError)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalBoosterDataError struct {
+	Code *int `json:"code"`
+
+	Message string `json:"message"`
+}
+
+func (v *BoosterDataError) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *BoosterDataError) __premarshalJSON() (*__premarshalBoosterDataError, error) {
+	var retval __premarshalBoosterDataError
+
+	retval.Code = v.GqlError.Code
+	retval.Message = v.GqlError.Message
+	return &retval, nil
+}
+
+// BoosterDataMetric includes the requested fields of the GraphQL type Metric.
+// The GraphQL type's documentation follows.
+//
+// The Metric object.
+//
+// A Metric is a business indicator measured over time.
+type BoosterDataMetric struct {
+	// The Metric's unique identifier.
+	Id string `json:"id"`
+}
+
+// GetId returns BoosterDataMetric.Id, and is useful for accessing the field via an interface.
+func (v *BoosterDataMetric) GetId() string { return v.Id }
+
+// BoosterResponse is returned by Booster on success.
+type BoosterResponse struct {
+	// Returns the Booster specified by the given ID.
+	//
+	// A Booster significantly improves the query performance for a Metric.
+	Booster *BoosterBooster `json:"booster"`
+}
+
+// GetBooster returns BoosterResponse.Booster, and is useful for accessing the field via an interface.
+func (v *BoosterResponse) GetBooster() *BoosterBooster { return v.Booster }
+
+// The Booster status.
+type BoosterStatus string
+
+const (
+	// The Booster has been created. Propel will start optimizing the Data Pool soon.
+	BoosterStatusCreated BoosterStatus = "CREATED"
+	// Propel is setting up the Booster and optimizing the Data Pool.
+	BoosterStatusOptimizing BoosterStatus = "OPTIMIZING"
+	// The Booster is now live and available to speed up Metric queries.
+	BoosterStatusLive BoosterStatus = "LIVE"
+	// Propel failed to setup the Booster. Please write to support. Alternatively, you can delete the Booster and try again.
+	BoosterStatusFailed BoosterStatus = "FAILED"
+	// Propel is deleting the Booster and all of its associated data.
+	BoosterStatusDeleting BoosterStatus = "DELETING"
+)
+
 // The ClickHouse Data Source connection settings.
 type ClickHouseConnectionSettingsInput struct {
 	// Which database to connect to
@@ -2234,6 +2970,225 @@ func (v *CreateAverageMetricResponse) GetCreateAverageMetric() *CreateAverageMet
 	return v.CreateAverageMetric
 }
 
+// CreateBoosterCreateBoosterBoosterResponse includes the requested fields of the GraphQL type BoosterResponse.
+// The GraphQL type's documentation follows.
+//
+// The result of a mutation which creates or modifies a Booster.
+type CreateBoosterCreateBoosterBoosterResponse struct {
+	// The Booster which was created or modified.
+	Booster *CreateBoosterCreateBoosterBoosterResponseBooster `json:"booster"`
+}
+
+// GetBooster returns CreateBoosterCreateBoosterBoosterResponse.Booster, and is useful for accessing the field via an interface.
+func (v *CreateBoosterCreateBoosterBoosterResponse) GetBooster() *CreateBoosterCreateBoosterBoosterResponseBooster {
+	return v.Booster
+}
+
+// CreateBoosterCreateBoosterBoosterResponseBooster includes the requested fields of the GraphQL type Booster.
+// The GraphQL type's documentation follows.
+//
+// Boosters allow you to optimize Metric Queries for a subset of commonly used Dimensions. A Metric can have one or many Boosters to optimize for the different Query patterns.
+//
+// Boosters can be understood as an aggregating index. The index is formed from left to right as follows:
+//
+// 1. The Data Pool's Tenant ID column (if present)
+// 2. Metric Filter columns (if present)
+// 3. Query Filter Dimensions (see `dimensions`)
+// 4. The Data Pool's timestamp column
+type CreateBoosterCreateBoosterBoosterResponseBooster struct {
+	BoosterData `json:"-"`
+}
+
+// GetId returns CreateBoosterCreateBoosterBoosterResponseBooster.Id, and is useful for accessing the field via an interface.
+func (v *CreateBoosterCreateBoosterBoosterResponseBooster) GetId() string { return v.BoosterData.Id }
+
+// GetAccount returns CreateBoosterCreateBoosterBoosterResponseBooster.Account, and is useful for accessing the field via an interface.
+func (v *CreateBoosterCreateBoosterBoosterResponseBooster) GetAccount() *BoosterDataAccount {
+	return v.BoosterData.Account
+}
+
+// GetEnvironment returns CreateBoosterCreateBoosterBoosterResponseBooster.Environment, and is useful for accessing the field via an interface.
+func (v *CreateBoosterCreateBoosterBoosterResponseBooster) GetEnvironment() *BoosterDataEnvironment {
+	return v.BoosterData.Environment
+}
+
+// GetMetric returns CreateBoosterCreateBoosterBoosterResponseBooster.Metric, and is useful for accessing the field via an interface.
+func (v *CreateBoosterCreateBoosterBoosterResponseBooster) GetMetric() *BoosterDataMetric {
+	return v.BoosterData.Metric
+}
+
+// GetStatus returns CreateBoosterCreateBoosterBoosterResponseBooster.Status, and is useful for accessing the field via an interface.
+func (v *CreateBoosterCreateBoosterBoosterResponseBooster) GetStatus() BoosterStatus {
+	return v.BoosterData.Status
+}
+
+// GetError returns CreateBoosterCreateBoosterBoosterResponseBooster.Error, and is useful for accessing the field via an interface.
+func (v *CreateBoosterCreateBoosterBoosterResponseBooster) GetError() *BoosterDataError {
+	return v.BoosterData.Error
+}
+
+// GetProgress returns CreateBoosterCreateBoosterBoosterResponseBooster.Progress, and is useful for accessing the field via an interface.
+func (v *CreateBoosterCreateBoosterBoosterResponseBooster) GetProgress() *float64 {
+	return v.BoosterData.Progress
+}
+
+// GetDimensions returns CreateBoosterCreateBoosterBoosterResponseBooster.Dimensions, and is useful for accessing the field via an interface.
+func (v *CreateBoosterCreateBoosterBoosterResponseBooster) GetDimensions() []*BoosterDataDimensionsDimension {
+	return v.BoosterData.Dimensions
+}
+
+// GetRecordCount returns CreateBoosterCreateBoosterBoosterResponseBooster.RecordCount, and is useful for accessing the field via an interface.
+func (v *CreateBoosterCreateBoosterBoosterResponseBooster) GetRecordCount() *string {
+	return v.BoosterData.RecordCount
+}
+
+// GetSizeInTerabytes returns CreateBoosterCreateBoosterBoosterResponseBooster.SizeInTerabytes, and is useful for accessing the field via an interface.
+func (v *CreateBoosterCreateBoosterBoosterResponseBooster) GetSizeInTerabytes() *float64 {
+	return v.BoosterData.SizeInTerabytes
+}
+
+// GetCreatedAt returns CreateBoosterCreateBoosterBoosterResponseBooster.CreatedAt, and is useful for accessing the field via an interface.
+func (v *CreateBoosterCreateBoosterBoosterResponseBooster) GetCreatedAt() time.Time {
+	return v.BoosterData.CreatedAt
+}
+
+// GetModifiedAt returns CreateBoosterCreateBoosterBoosterResponseBooster.ModifiedAt, and is useful for accessing the field via an interface.
+func (v *CreateBoosterCreateBoosterBoosterResponseBooster) GetModifiedAt() time.Time {
+	return v.BoosterData.ModifiedAt
+}
+
+// GetCreatedBy returns CreateBoosterCreateBoosterBoosterResponseBooster.CreatedBy, and is useful for accessing the field via an interface.
+func (v *CreateBoosterCreateBoosterBoosterResponseBooster) GetCreatedBy() string {
+	return v.BoosterData.CreatedBy
+}
+
+// GetModifiedBy returns CreateBoosterCreateBoosterBoosterResponseBooster.ModifiedBy, and is useful for accessing the field via an interface.
+func (v *CreateBoosterCreateBoosterBoosterResponseBooster) GetModifiedBy() string {
+	return v.BoosterData.ModifiedBy
+}
+
+func (v *CreateBoosterCreateBoosterBoosterResponseBooster) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*CreateBoosterCreateBoosterBoosterResponseBooster
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.CreateBoosterCreateBoosterBoosterResponseBooster = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.BoosterData)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalCreateBoosterCreateBoosterBoosterResponseBooster struct {
+	Id string `json:"id"`
+
+	Account *BoosterDataAccount `json:"account"`
+
+	Environment *BoosterDataEnvironment `json:"environment"`
+
+	Metric *BoosterDataMetric `json:"metric"`
+
+	Status BoosterStatus `json:"status"`
+
+	Error *BoosterDataError `json:"error"`
+
+	Progress *float64 `json:"progress"`
+
+	Dimensions []*BoosterDataDimensionsDimension `json:"dimensions"`
+
+	RecordCount *string `json:"recordCount"`
+
+	SizeInTerabytes *float64 `json:"sizeInTerabytes"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	ModifiedAt time.Time `json:"modifiedAt"`
+
+	CreatedBy string `json:"createdBy"`
+
+	ModifiedBy string `json:"modifiedBy"`
+}
+
+func (v *CreateBoosterCreateBoosterBoosterResponseBooster) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *CreateBoosterCreateBoosterBoosterResponseBooster) __premarshalJSON() (*__premarshalCreateBoosterCreateBoosterBoosterResponseBooster, error) {
+	var retval __premarshalCreateBoosterCreateBoosterBoosterResponseBooster
+
+	retval.Id = v.BoosterData.Id
+	retval.Account = v.BoosterData.Account
+	retval.Environment = v.BoosterData.Environment
+	retval.Metric = v.BoosterData.Metric
+	retval.Status = v.BoosterData.Status
+	retval.Error = v.BoosterData.Error
+	retval.Progress = v.BoosterData.Progress
+	retval.Dimensions = v.BoosterData.Dimensions
+	retval.RecordCount = v.BoosterData.RecordCount
+	retval.SizeInTerabytes = v.BoosterData.SizeInTerabytes
+	retval.CreatedAt = v.BoosterData.CreatedAt
+	retval.ModifiedAt = v.BoosterData.ModifiedAt
+	retval.CreatedBy = v.BoosterData.CreatedBy
+	retval.ModifiedBy = v.BoosterData.ModifiedBy
+	return &retval, nil
+}
+
+// The fields for creating a new Booster.
+//
+// Boosters can be understood as an aggregating index. The index is formed from left to right as follows:
+//
+// 1. The Data Pool's Tenant ID column (if present)
+// 2. Metric Filter columns (if present)
+// 3. Query Filter Dimensions (see `dimensions`)
+// 4. The Data Pool's timestamp column
+type CreateBoosterInput struct {
+	// The Booster's Metric.
+	Metric string `json:"metric"`
+	// Dimensions to include in the Booster.
+	//
+	// Follow these guidelines when specifying Dimensions:
+	//
+	// 1. Specify Dimensions in descending order of importance for filtering and in ascending order of cardinality.
+	// 2. Take into consideration hierarchical relationships as well (for example, a "country" Dimension should appear before a "state" Dimension).
+	Dimensions []*DimensionInput `json:"dimensions,omitempty"`
+}
+
+// GetMetric returns CreateBoosterInput.Metric, and is useful for accessing the field via an interface.
+func (v *CreateBoosterInput) GetMetric() string { return v.Metric }
+
+// GetDimensions returns CreateBoosterInput.Dimensions, and is useful for accessing the field via an interface.
+func (v *CreateBoosterInput) GetDimensions() []*DimensionInput { return v.Dimensions }
+
+// CreateBoosterResponse is returned by CreateBooster on success.
+type CreateBoosterResponse struct {
+	// Creates a new Booster for the given Metric and returns the newly created Booster.
+	//
+	// A Booster significantly improves the query performance for a Metric.
+	CreateBooster *CreateBoosterCreateBoosterBoosterResponse `json:"createBooster"`
+}
+
+// GetCreateBooster returns CreateBoosterResponse.CreateBooster, and is useful for accessing the field via an interface.
+func (v *CreateBoosterResponse) GetCreateBooster() *CreateBoosterCreateBoosterBoosterResponse {
+	return v.CreateBooster
+}
+
 // CreateClickHouseDataSourceCreateClickHouseDataSourceDataSourceResponse includes the requested fields of the GraphQL type DataSourceResponse.
 // The GraphQL type's documentation follows.
 //
@@ -3281,6 +4236,11 @@ func (v *CreateDataPoolAccessPolicyCreateDataPoolAccessPolicyDataPoolAccessPolic
 	return v.DataPoolAccessPolicyData.Rows
 }
 
+// GetFilterSql returns CreateDataPoolAccessPolicyCreateDataPoolAccessPolicyDataPoolAccessPolicyResponseDataPoolAccessPolicy.FilterSql, and is useful for accessing the field via an interface.
+func (v *CreateDataPoolAccessPolicyCreateDataPoolAccessPolicyDataPoolAccessPolicyResponseDataPoolAccessPolicy) GetFilterSql() *string {
+	return v.DataPoolAccessPolicyData.FilterSql
+}
+
 // GetDataPool returns CreateDataPoolAccessPolicyCreateDataPoolAccessPolicyDataPoolAccessPolicyResponseDataPoolAccessPolicy.DataPool, and is useful for accessing the field via an interface.
 func (v *CreateDataPoolAccessPolicyCreateDataPoolAccessPolicyDataPoolAccessPolicyResponseDataPoolAccessPolicy) GetDataPool() *DataPoolAccessPolicyDataDataPool {
 	return v.DataPoolAccessPolicyData.DataPool
@@ -3358,6 +4318,8 @@ type __premarshalCreateDataPoolAccessPolicyCreateDataPoolAccessPolicyDataPoolAcc
 
 	Rows []*DataPoolAccessPolicyDataRowsFilter `json:"rows"`
 
+	FilterSql *string `json:"filterSql"`
+
 	DataPool *DataPoolAccessPolicyDataDataPool `json:"dataPool"`
 
 	UniqueName string `json:"uniqueName"`
@@ -3391,6 +4353,7 @@ func (v *CreateDataPoolAccessPolicyCreateDataPoolAccessPolicyDataPoolAccessPolic
 	retval.Id = v.DataPoolAccessPolicyData.Id
 	retval.Columns = v.DataPoolAccessPolicyData.Columns
 	retval.Rows = v.DataPoolAccessPolicyData.Rows
+	retval.FilterSql = v.DataPoolAccessPolicyData.FilterSql
 	retval.DataPool = v.DataPoolAccessPolicyData.DataPool
 	retval.UniqueName = v.DataPoolAccessPolicyData.CommonDataDataPoolAccessPolicy.UniqueName
 	retval.Description = v.DataPoolAccessPolicyData.CommonDataDataPoolAccessPolicy.Description
@@ -3500,6 +4463,11 @@ func (v *CreateDataPoolCreateDataPoolV2DataPoolResponseDataPool) GetTable() stri
 	return v.DataPoolData.Table
 }
 
+// GetRecordCount returns CreateDataPoolCreateDataPoolV2DataPoolResponseDataPool.RecordCount, and is useful for accessing the field via an interface.
+func (v *CreateDataPoolCreateDataPoolV2DataPoolResponseDataPool) GetRecordCount() *string {
+	return v.DataPoolData.RecordCount
+}
+
 // GetTenant returns CreateDataPoolCreateDataPoolV2DataPoolResponseDataPool.Tenant, and is useful for accessing the field via an interface.
 func (v *CreateDataPoolCreateDataPoolV2DataPoolResponseDataPool) GetTenant() *DataPoolDataTenant {
 	return v.DataPoolData.Tenant
@@ -3626,6 +4594,8 @@ type __premarshalCreateDataPoolCreateDataPoolV2DataPoolResponseDataPool struct {
 
 	Table string `json:"table"`
 
+	RecordCount *string `json:"recordCount"`
+
 	Tenant *DataPoolDataTenant `json:"tenant"`
 
 	Timestamp *DataPoolDataTimestamp `json:"timestamp"`
@@ -3679,6 +4649,7 @@ func (v *CreateDataPoolCreateDataPoolV2DataPoolResponseDataPool) __premarshalJSO
 	retval.Status = v.DataPoolData.Status
 	retval.Error = v.DataPoolData.Error
 	retval.Table = v.DataPoolData.Table
+	retval.RecordCount = v.DataPoolData.RecordCount
 	retval.Tenant = v.DataPoolData.Tenant
 	retval.Timestamp = v.DataPoolData.Timestamp
 	retval.Columns = v.DataPoolData.Columns
@@ -3857,6 +4828,329 @@ func (v *CreateDataPoolResponse) GetCreateDataPoolV2() *CreateDataPoolCreateData
 	return v.CreateDataPoolV2
 }
 
+// CreateDeletionJobCreateDeletionJobDeletionJobResponse includes the requested fields of the GraphQL type DeletionJobResponse.
+// The GraphQL type's documentation follows.
+//
+// The response returned by the Deletion Job.
+type CreateDeletionJobCreateDeletionJobDeletionJobResponse struct {
+	// The Deletion Job that was just created.
+	Job *CreateDeletionJobCreateDeletionJobDeletionJobResponseJobDeletionJob `json:"job"`
+}
+
+// GetJob returns CreateDeletionJobCreateDeletionJobDeletionJobResponse.Job, and is useful for accessing the field via an interface.
+func (v *CreateDeletionJobCreateDeletionJobDeletionJobResponse) GetJob() *CreateDeletionJobCreateDeletionJobDeletionJobResponseJobDeletionJob {
+	return v.Job
+}
+
+// CreateDeletionJobCreateDeletionJobDeletionJobResponseJobDeletionJob includes the requested fields of the GraphQL type DeletionJob.
+// The GraphQL type's documentation follows.
+//
+// Deletion Job scheduled for a specific Data Pool.
+//
+// The Deletion Job represents the asynchronous process of deleting data
+// given some filters inside a Data Pool. It tracks the deletion process
+// until it is finished, showing the progress and the outcome when it is finished.
+type CreateDeletionJobCreateDeletionJobDeletionJobResponseJobDeletionJob struct {
+	DeletionJobData `json:"-"`
+}
+
+// GetId returns CreateDeletionJobCreateDeletionJobDeletionJobResponseJobDeletionJob.Id, and is useful for accessing the field via an interface.
+func (v *CreateDeletionJobCreateDeletionJobDeletionJobResponseJobDeletionJob) GetId() string {
+	return v.DeletionJobData.Id
+}
+
+// GetDataPool returns CreateDeletionJobCreateDeletionJobDeletionJobResponseJobDeletionJob.DataPool, and is useful for accessing the field via an interface.
+func (v *CreateDeletionJobCreateDeletionJobDeletionJobResponseJobDeletionJob) GetDataPool() *DeletionJobDataDataPool {
+	return v.DeletionJobData.DataPool
+}
+
+// GetStatus returns CreateDeletionJobCreateDeletionJobDeletionJobResponseJobDeletionJob.Status, and is useful for accessing the field via an interface.
+func (v *CreateDeletionJobCreateDeletionJobDeletionJobResponseJobDeletionJob) GetStatus() JobStatus {
+	return v.DeletionJobData.Status
+}
+
+// GetFilterSql returns CreateDeletionJobCreateDeletionJobDeletionJobResponseJobDeletionJob.FilterSql, and is useful for accessing the field via an interface.
+func (v *CreateDeletionJobCreateDeletionJobDeletionJobResponseJobDeletionJob) GetFilterSql() *string {
+	return v.DeletionJobData.FilterSql
+}
+
+// GetError returns CreateDeletionJobCreateDeletionJobDeletionJobResponseJobDeletionJob.Error, and is useful for accessing the field via an interface.
+func (v *CreateDeletionJobCreateDeletionJobDeletionJobResponseJobDeletionJob) GetError() *DeletionJobDataError {
+	return v.DeletionJobData.Error
+}
+
+// GetProgress returns CreateDeletionJobCreateDeletionJobDeletionJobResponseJobDeletionJob.Progress, and is useful for accessing the field via an interface.
+func (v *CreateDeletionJobCreateDeletionJobDeletionJobResponseJobDeletionJob) GetProgress() float64 {
+	return v.DeletionJobData.Progress
+}
+
+// GetStartedAt returns CreateDeletionJobCreateDeletionJobDeletionJobResponseJobDeletionJob.StartedAt, and is useful for accessing the field via an interface.
+func (v *CreateDeletionJobCreateDeletionJobDeletionJobResponseJobDeletionJob) GetStartedAt() *time.Time {
+	return v.DeletionJobData.StartedAt
+}
+
+// GetSucceededAt returns CreateDeletionJobCreateDeletionJobDeletionJobResponseJobDeletionJob.SucceededAt, and is useful for accessing the field via an interface.
+func (v *CreateDeletionJobCreateDeletionJobDeletionJobResponseJobDeletionJob) GetSucceededAt() *time.Time {
+	return v.DeletionJobData.SucceededAt
+}
+
+// GetFailedAt returns CreateDeletionJobCreateDeletionJobDeletionJobResponseJobDeletionJob.FailedAt, and is useful for accessing the field via an interface.
+func (v *CreateDeletionJobCreateDeletionJobDeletionJobResponseJobDeletionJob) GetFailedAt() *time.Time {
+	return v.DeletionJobData.FailedAt
+}
+
+func (v *CreateDeletionJobCreateDeletionJobDeletionJobResponseJobDeletionJob) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*CreateDeletionJobCreateDeletionJobDeletionJobResponseJobDeletionJob
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.CreateDeletionJobCreateDeletionJobDeletionJobResponseJobDeletionJob = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.DeletionJobData)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalCreateDeletionJobCreateDeletionJobDeletionJobResponseJobDeletionJob struct {
+	Id string `json:"id"`
+
+	DataPool *DeletionJobDataDataPool `json:"dataPool"`
+
+	Status JobStatus `json:"status"`
+
+	FilterSql *string `json:"filterSql"`
+
+	Error *DeletionJobDataError `json:"error"`
+
+	Progress float64 `json:"progress"`
+
+	StartedAt *time.Time `json:"startedAt"`
+
+	SucceededAt *time.Time `json:"succeededAt"`
+
+	FailedAt *time.Time `json:"failedAt"`
+}
+
+func (v *CreateDeletionJobCreateDeletionJobDeletionJobResponseJobDeletionJob) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *CreateDeletionJobCreateDeletionJobDeletionJobResponseJobDeletionJob) __premarshalJSON() (*__premarshalCreateDeletionJobCreateDeletionJobDeletionJobResponseJobDeletionJob, error) {
+	var retval __premarshalCreateDeletionJobCreateDeletionJobDeletionJobResponseJobDeletionJob
+
+	retval.Id = v.DeletionJobData.Id
+	retval.DataPool = v.DeletionJobData.DataPool
+	retval.Status = v.DeletionJobData.Status
+	retval.FilterSql = v.DeletionJobData.FilterSql
+	retval.Error = v.DeletionJobData.Error
+	retval.Progress = v.DeletionJobData.Progress
+	retval.StartedAt = v.DeletionJobData.StartedAt
+	retval.SucceededAt = v.DeletionJobData.SucceededAt
+	retval.FailedAt = v.DeletionJobData.FailedAt
+	return &retval, nil
+}
+
+// The fields for creating a Deletion Job.
+type CreateDeletionJobInput struct {
+	// The Data Pool that is going to get the data deleted
+	DataPool string `json:"dataPool"`
+	// The list of filters that will be used for deleting data. Data matching these filters will be deleted.
+	Filters []*FilterInput `json:"filters,omitempty"`
+	// The filters that will be used for deleting data, in the form of SQL. Data matching these filters will be deleted.
+	FilterSql *string `json:"filterSql"`
+}
+
+// GetDataPool returns CreateDeletionJobInput.DataPool, and is useful for accessing the field via an interface.
+func (v *CreateDeletionJobInput) GetDataPool() string { return v.DataPool }
+
+// GetFilters returns CreateDeletionJobInput.Filters, and is useful for accessing the field via an interface.
+func (v *CreateDeletionJobInput) GetFilters() []*FilterInput { return v.Filters }
+
+// GetFilterSql returns CreateDeletionJobInput.FilterSql, and is useful for accessing the field via an interface.
+func (v *CreateDeletionJobInput) GetFilterSql() *string { return v.FilterSql }
+
+// CreateDeletionJobResponse is returned by CreateDeletionJob on success.
+type CreateDeletionJobResponse struct {
+	// Schedules a new Deletion Job on the specified Data Pool.
+	CreateDeletionJob *CreateDeletionJobCreateDeletionJobDeletionJobResponse `json:"createDeletionJob"`
+}
+
+// GetCreateDeletionJob returns CreateDeletionJobResponse.CreateDeletionJob, and is useful for accessing the field via an interface.
+func (v *CreateDeletionJobResponse) GetCreateDeletionJob() *CreateDeletionJobCreateDeletionJobDeletionJobResponse {
+	return v.CreateDeletionJob
+}
+
+// CreateEnvironmentCreateEnvironmentEnvironmentResponse includes the requested fields of the GraphQL type EnvironmentResponse.
+// The GraphQL type's documentation follows.
+//
+// The result of a mutation which creates or modifies an Environment.
+type CreateEnvironmentCreateEnvironmentEnvironmentResponse struct {
+	// The Environment which was created or modified.
+	Environment *CreateEnvironmentCreateEnvironmentEnvironmentResponseEnvironment `json:"environment"`
+}
+
+// GetEnvironment returns CreateEnvironmentCreateEnvironmentEnvironmentResponse.Environment, and is useful for accessing the field via an interface.
+func (v *CreateEnvironmentCreateEnvironmentEnvironmentResponse) GetEnvironment() *CreateEnvironmentCreateEnvironmentEnvironmentResponseEnvironment {
+	return v.Environment
+}
+
+// CreateEnvironmentCreateEnvironmentEnvironmentResponseEnvironment includes the requested fields of the GraphQL type Environment.
+// The GraphQL type's documentation follows.
+//
+// The Environments object.
+//
+// Environments are independent and isolated Propel workspaces for development, staging (testing), and production workloads. Environments are hosted in a specific region, initially in us-east-2 only.
+type CreateEnvironmentCreateEnvironmentEnvironmentResponseEnvironment struct {
+	EnvironmentData `json:"-"`
+}
+
+// GetId returns CreateEnvironmentCreateEnvironmentEnvironmentResponseEnvironment.Id, and is useful for accessing the field via an interface.
+func (v *CreateEnvironmentCreateEnvironmentEnvironmentResponseEnvironment) GetId() string {
+	return v.EnvironmentData.Id
+}
+
+// GetUniqueName returns CreateEnvironmentCreateEnvironmentEnvironmentResponseEnvironment.UniqueName, and is useful for accessing the field via an interface.
+func (v *CreateEnvironmentCreateEnvironmentEnvironmentResponseEnvironment) GetUniqueName() *string {
+	return v.EnvironmentData.UniqueName
+}
+
+// GetDescription returns CreateEnvironmentCreateEnvironmentEnvironmentResponseEnvironment.Description, and is useful for accessing the field via an interface.
+func (v *CreateEnvironmentCreateEnvironmentEnvironmentResponseEnvironment) GetDescription() *string {
+	return v.EnvironmentData.Description
+}
+
+// GetAccount returns CreateEnvironmentCreateEnvironmentEnvironmentResponseEnvironment.Account, and is useful for accessing the field via an interface.
+func (v *CreateEnvironmentCreateEnvironmentEnvironmentResponseEnvironment) GetAccount() *EnvironmentDataAccount {
+	return v.EnvironmentData.Account
+}
+
+// GetCreatedAt returns CreateEnvironmentCreateEnvironmentEnvironmentResponseEnvironment.CreatedAt, and is useful for accessing the field via an interface.
+func (v *CreateEnvironmentCreateEnvironmentEnvironmentResponseEnvironment) GetCreatedAt() *time.Time {
+	return v.EnvironmentData.CreatedAt
+}
+
+// GetModifiedAt returns CreateEnvironmentCreateEnvironmentEnvironmentResponseEnvironment.ModifiedAt, and is useful for accessing the field via an interface.
+func (v *CreateEnvironmentCreateEnvironmentEnvironmentResponseEnvironment) GetModifiedAt() *time.Time {
+	return v.EnvironmentData.ModifiedAt
+}
+
+// GetCreatedBy returns CreateEnvironmentCreateEnvironmentEnvironmentResponseEnvironment.CreatedBy, and is useful for accessing the field via an interface.
+func (v *CreateEnvironmentCreateEnvironmentEnvironmentResponseEnvironment) GetCreatedBy() *string {
+	return v.EnvironmentData.CreatedBy
+}
+
+// GetModifiedBy returns CreateEnvironmentCreateEnvironmentEnvironmentResponseEnvironment.ModifiedBy, and is useful for accessing the field via an interface.
+func (v *CreateEnvironmentCreateEnvironmentEnvironmentResponseEnvironment) GetModifiedBy() *string {
+	return v.EnvironmentData.ModifiedBy
+}
+
+func (v *CreateEnvironmentCreateEnvironmentEnvironmentResponseEnvironment) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*CreateEnvironmentCreateEnvironmentEnvironmentResponseEnvironment
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.CreateEnvironmentCreateEnvironmentEnvironmentResponseEnvironment = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.EnvironmentData)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalCreateEnvironmentCreateEnvironmentEnvironmentResponseEnvironment struct {
+	Id string `json:"id"`
+
+	UniqueName *string `json:"uniqueName"`
+
+	Description *string `json:"description"`
+
+	Account *EnvironmentDataAccount `json:"account"`
+
+	CreatedAt *time.Time `json:"createdAt"`
+
+	ModifiedAt *time.Time `json:"modifiedAt"`
+
+	CreatedBy *string `json:"createdBy"`
+
+	ModifiedBy *string `json:"modifiedBy"`
+}
+
+func (v *CreateEnvironmentCreateEnvironmentEnvironmentResponseEnvironment) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *CreateEnvironmentCreateEnvironmentEnvironmentResponseEnvironment) __premarshalJSON() (*__premarshalCreateEnvironmentCreateEnvironmentEnvironmentResponseEnvironment, error) {
+	var retval __premarshalCreateEnvironmentCreateEnvironmentEnvironmentResponseEnvironment
+
+	retval.Id = v.EnvironmentData.Id
+	retval.UniqueName = v.EnvironmentData.UniqueName
+	retval.Description = v.EnvironmentData.Description
+	retval.Account = v.EnvironmentData.Account
+	retval.CreatedAt = v.EnvironmentData.CreatedAt
+	retval.ModifiedAt = v.EnvironmentData.ModifiedAt
+	retval.CreatedBy = v.EnvironmentData.CreatedBy
+	retval.ModifiedBy = v.EnvironmentData.ModifiedBy
+	return &retval, nil
+}
+
+// The fields for creating an Environment.
+type CreateEnvironmentInput struct {
+	// The Environment's unique name.
+	UniqueName string `json:"uniqueName"`
+	// The Environment's description.
+	Description *string `json:"description"`
+}
+
+// GetUniqueName returns CreateEnvironmentInput.UniqueName, and is useful for accessing the field via an interface.
+func (v *CreateEnvironmentInput) GetUniqueName() string { return v.UniqueName }
+
+// GetDescription returns CreateEnvironmentInput.Description, and is useful for accessing the field via an interface.
+func (v *CreateEnvironmentInput) GetDescription() *string { return v.Description }
+
+// CreateEnvironmentResponse is returned by CreateEnvironment on success.
+type CreateEnvironmentResponse struct {
+	// Creates an Environment and returns the newly created Environment (or an error if creating the Environment fails).
+	CreateEnvironment *CreateEnvironmentCreateEnvironmentEnvironmentResponse `json:"createEnvironment"`
+}
+
+// GetCreateEnvironment returns CreateEnvironmentResponse.CreateEnvironment, and is useful for accessing the field via an interface.
+func (v *CreateEnvironmentResponse) GetCreateEnvironment() *CreateEnvironmentCreateEnvironmentEnvironmentResponse {
+	return v.CreateEnvironment
+}
+
 // CreateHttpDataSourceCreateHttpDataSourceDataSourceResponse includes the requested fields of the GraphQL type DataSourceResponse.
 // The GraphQL type's documentation follows.
 //
@@ -5268,6 +6562,276 @@ func (v *CreatePolicyResponse) GetCreatePolicy() *CreatePolicyCreatePolicyPolicy
 	return v.CreatePolicy
 }
 
+// CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponse includes the requested fields of the GraphQL type DataSourceResponse.
+// The GraphQL type's documentation follows.
+//
+// The result of a mutation which creates or modifies a Data Source.
+type CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponse struct {
+	// The Data Source which was created or modified.
+	DataSource *CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource `json:"dataSource"`
+}
+
+// GetDataSource returns CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponse.DataSource, and is useful for accessing the field via an interface.
+func (v *CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponse) GetDataSource() *CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource {
+	return v.DataSource
+}
+
+// CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource includes the requested fields of the GraphQL type DataSource.
+// The GraphQL type's documentation follows.
+//
+// The Data Source object.
+//
+// A Data Source is a connection to your data warehouse. It has the necessary connection details for Propel to access Snowflake or any other supported Data Source.
+type CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource struct {
+	DataSourceData `json:"-"`
+}
+
+// GetId returns CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource.Id, and is useful for accessing the field via an interface.
+func (v *CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource) GetId() string {
+	return v.DataSourceData.Id
+}
+
+// GetType returns CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource.Type, and is useful for accessing the field via an interface.
+func (v *CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource) GetType() DataSourceType {
+	return v.DataSourceData.Type
+}
+
+// GetStatus returns CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource.Status, and is useful for accessing the field via an interface.
+func (v *CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource) GetStatus() DataSourceStatus {
+	return v.DataSourceData.Status
+}
+
+// GetError returns CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource.Error, and is useful for accessing the field via an interface.
+func (v *CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource) GetError() *DataSourceDataError {
+	return v.DataSourceData.Error
+}
+
+// GetDataPools returns CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource.DataPools, and is useful for accessing the field via an interface.
+func (v *CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource) GetDataPools() *DataSourceDataDataPoolsDataPoolConnection {
+	return v.DataSourceData.DataPools
+}
+
+// GetConnectionSettings returns CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource.ConnectionSettings, and is useful for accessing the field via an interface.
+func (v *CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource) GetConnectionSettings() DataSourceDataConnectionSettings {
+	return v.DataSourceData.ConnectionSettings
+}
+
+// GetTables returns CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource.Tables, and is useful for accessing the field via an interface.
+func (v *CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource) GetTables() *DataSourceDataTablesTableConnection {
+	return v.DataSourceData.Tables
+}
+
+// GetChecks returns CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource.Checks, and is useful for accessing the field via an interface.
+func (v *CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource) GetChecks() []*DataSourceDataChecksDataSourceCheck {
+	return v.DataSourceData.Checks
+}
+
+// GetTableIntrospections returns CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource.TableIntrospections, and is useful for accessing the field via an interface.
+func (v *CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource) GetTableIntrospections() *DataSourceDataTableIntrospectionsTableIntrospectionConnection {
+	return v.DataSourceData.TableIntrospections
+}
+
+// GetUniqueName returns CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource.UniqueName, and is useful for accessing the field via an interface.
+func (v *CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource) GetUniqueName() string {
+	return v.DataSourceData.CommonDataDataSource.UniqueName
+}
+
+// GetDescription returns CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource.Description, and is useful for accessing the field via an interface.
+func (v *CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource) GetDescription() string {
+	return v.DataSourceData.CommonDataDataSource.Description
+}
+
+// GetAccount returns CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource.Account, and is useful for accessing the field via an interface.
+func (v *CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource) GetAccount() *CommonDataAccount {
+	return v.DataSourceData.CommonDataDataSource.Account
+}
+
+// GetEnvironment returns CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource.Environment, and is useful for accessing the field via an interface.
+func (v *CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource) GetEnvironment() *CommonDataEnvironment {
+	return v.DataSourceData.CommonDataDataSource.Environment
+}
+
+// GetCreatedAt returns CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource.CreatedAt, and is useful for accessing the field via an interface.
+func (v *CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource) GetCreatedAt() time.Time {
+	return v.DataSourceData.CommonDataDataSource.CreatedAt
+}
+
+// GetModifiedAt returns CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource.ModifiedAt, and is useful for accessing the field via an interface.
+func (v *CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource) GetModifiedAt() time.Time {
+	return v.DataSourceData.CommonDataDataSource.ModifiedAt
+}
+
+// GetCreatedBy returns CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource.CreatedBy, and is useful for accessing the field via an interface.
+func (v *CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource) GetCreatedBy() string {
+	return v.DataSourceData.CommonDataDataSource.CreatedBy
+}
+
+// GetModifiedBy returns CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource.ModifiedBy, and is useful for accessing the field via an interface.
+func (v *CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource) GetModifiedBy() string {
+	return v.DataSourceData.CommonDataDataSource.ModifiedBy
+}
+
+func (v *CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.DataSourceData)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalCreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource struct {
+	Id string `json:"id"`
+
+	Type DataSourceType `json:"type"`
+
+	Status DataSourceStatus `json:"status"`
+
+	Error *DataSourceDataError `json:"error"`
+
+	DataPools *DataSourceDataDataPoolsDataPoolConnection `json:"dataPools"`
+
+	ConnectionSettings json.RawMessage `json:"connectionSettings"`
+
+	Tables *DataSourceDataTablesTableConnection `json:"tables"`
+
+	Checks []*DataSourceDataChecksDataSourceCheck `json:"checks"`
+
+	TableIntrospections *DataSourceDataTableIntrospectionsTableIntrospectionConnection `json:"tableIntrospections"`
+
+	UniqueName string `json:"uniqueName"`
+
+	Description string `json:"description"`
+
+	Account *CommonDataAccount `json:"account"`
+
+	Environment *CommonDataEnvironment `json:"environment"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	ModifiedAt time.Time `json:"modifiedAt"`
+
+	CreatedBy string `json:"createdBy"`
+
+	ModifiedBy string `json:"modifiedBy"`
+}
+
+func (v *CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource) __premarshalJSON() (*__premarshalCreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource, error) {
+	var retval __premarshalCreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource
+
+	retval.Id = v.DataSourceData.Id
+	retval.Type = v.DataSourceData.Type
+	retval.Status = v.DataSourceData.Status
+	retval.Error = v.DataSourceData.Error
+	retval.DataPools = v.DataSourceData.DataPools
+	{
+
+		dst := &retval.ConnectionSettings
+		src := v.DataSourceData.ConnectionSettings
+		var err error
+		*dst, err = __marshalDataSourceDataConnectionSettings(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponseDataSource.DataSourceData.ConnectionSettings: %w", err)
+		}
+	}
+	retval.Tables = v.DataSourceData.Tables
+	retval.Checks = v.DataSourceData.Checks
+	retval.TableIntrospections = v.DataSourceData.TableIntrospections
+	retval.UniqueName = v.DataSourceData.CommonDataDataSource.UniqueName
+	retval.Description = v.DataSourceData.CommonDataDataSource.Description
+	retval.Account = v.DataSourceData.CommonDataDataSource.Account
+	retval.Environment = v.DataSourceData.CommonDataDataSource.Environment
+	retval.CreatedAt = v.DataSourceData.CommonDataDataSource.CreatedAt
+	retval.ModifiedAt = v.DataSourceData.CommonDataDataSource.ModifiedAt
+	retval.CreatedBy = v.DataSourceData.CommonDataDataSource.CreatedBy
+	retval.ModifiedBy = v.DataSourceData.CommonDataDataSource.ModifiedBy
+	return &retval, nil
+}
+
+type CreatePostgreSqlDataSourceInput struct {
+	// The PostgreSQL Data Source's connection settings
+	ConnectionSettings *PostgreSqlConnectionSettingsInput `json:"connectionSettings,omitempty"`
+	// The PostgreSQL Data Source's description.
+	Description *string `json:"description"`
+	// The PostgreSQL Data Source's unique name. If not specified, Propel will set the ID as unique name.
+	UniqueName *string `json:"uniqueName"`
+}
+
+// GetConnectionSettings returns CreatePostgreSqlDataSourceInput.ConnectionSettings, and is useful for accessing the field via an interface.
+func (v *CreatePostgreSqlDataSourceInput) GetConnectionSettings() *PostgreSqlConnectionSettingsInput {
+	return v.ConnectionSettings
+}
+
+// GetDescription returns CreatePostgreSqlDataSourceInput.Description, and is useful for accessing the field via an interface.
+func (v *CreatePostgreSqlDataSourceInput) GetDescription() *string { return v.Description }
+
+// GetUniqueName returns CreatePostgreSqlDataSourceInput.UniqueName, and is useful for accessing the field via an interface.
+func (v *CreatePostgreSqlDataSourceInput) GetUniqueName() *string { return v.UniqueName }
+
+// CreatePostgreSqlDataSourceResponse is returned by CreatePostgreSqlDataSource on success.
+type CreatePostgreSqlDataSourceResponse struct {
+	// Creates a new PostgreSQL Data Source.
+	//
+	// Returns the newly created Data Source (or an error message if creating the Data Source fails).
+	//
+	// Example:
+	//
+	// ```graphql
+	// mutation {
+	// createPostgreSqlDataSource(input: {
+	// uniqueName: "example_postgresql_data_source"
+	// description: "My PostgreSQL Data Source"
+	// connectionSettings: {
+	// database: "example_database"
+	// schema: "public"
+	// host: "postgresql.example.com"
+	// port: 5432
+	// user: "user"
+	// password: "password"
+	// }
+	// }) {
+	// dataSource {
+	// id
+	// uniqueName
+	// }
+	// }
+	// }
+	// ```
+	// Replace the placeholder values with your actual configuration.
+	CreatePostgreSqlDataSource *CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponse `json:"createPostgreSqlDataSource"`
+}
+
+// GetCreatePostgreSqlDataSource returns CreatePostgreSqlDataSourceResponse.CreatePostgreSqlDataSource, and is useful for accessing the field via an interface.
+func (v *CreatePostgreSqlDataSourceResponse) GetCreatePostgreSqlDataSource() *CreatePostgreSqlDataSourceCreatePostgreSqlDataSourceDataSourceResponse {
+	return v.CreatePostgreSqlDataSource
+}
+
 // CreateS3DataSourceCreateS3DataSourceDataSourceResponse includes the requested fields of the GraphQL type DataSourceResponse.
 // The GraphQL type's documentation follows.
 //
@@ -6251,6 +7815,189 @@ func (v *CreateSumMetricResponse) GetCreateSumMetric() *CreateSumMetricCreateSum
 	return v.CreateSumMetric
 }
 
+// CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponse includes the requested fields of the GraphQL type UpdateDataPoolRecordsJobResponse.
+// The GraphQL type's documentation follows.
+//
+// The response returned by the Update Data Pool Records Job.
+type CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponse struct {
+	// The UpdateDataPoolRecords Job that was just created.
+	Job *CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob `json:"job"`
+}
+
+// GetJob returns CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponse.Job, and is useful for accessing the field via an interface.
+func (v *CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponse) GetJob() *CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob {
+	return v.Job
+}
+
+// CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob includes the requested fields of the GraphQL type UpdateDataPoolRecordsJob.
+// The GraphQL type's documentation follows.
+//
+// UpdateDataPoolRecords Job scheduled for a specific Data Pool.
+// The Update Data Pool Records Job represents the asynchronous process of updating records
+// given some filters, inside a Data Pool. It tracks the process of updating records
+// until it is finished, showing the progress and the outcome when it is finished.
+type CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob struct {
+	UpdateDataPoolRecordsJobData `json:"-"`
+}
+
+// GetId returns CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob.Id, and is useful for accessing the field via an interface.
+func (v *CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob) GetId() string {
+	return v.UpdateDataPoolRecordsJobData.Id
+}
+
+// GetDataPool returns CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob.DataPool, and is useful for accessing the field via an interface.
+func (v *CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob) GetDataPool() *UpdateDataPoolRecordsJobDataDataPool {
+	return v.UpdateDataPoolRecordsJobData.DataPool
+}
+
+// GetStatus returns CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob.Status, and is useful for accessing the field via an interface.
+func (v *CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob) GetStatus() JobStatus {
+	return v.UpdateDataPoolRecordsJobData.Status
+}
+
+// GetFilterSql returns CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob.FilterSql, and is useful for accessing the field via an interface.
+func (v *CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob) GetFilterSql() *string {
+	return v.UpdateDataPoolRecordsJobData.FilterSql
+}
+
+// GetSet returns CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob.Set, and is useful for accessing the field via an interface.
+func (v *CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob) GetSet() []*UpdateDataPoolRecordsJobDataSetUpdateDataPoolRecordsJobSetColumn {
+	return v.UpdateDataPoolRecordsJobData.Set
+}
+
+// GetError returns CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob.Error, and is useful for accessing the field via an interface.
+func (v *CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob) GetError() *UpdateDataPoolRecordsJobDataError {
+	return v.UpdateDataPoolRecordsJobData.Error
+}
+
+// GetProgress returns CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob.Progress, and is useful for accessing the field via an interface.
+func (v *CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob) GetProgress() float64 {
+	return v.UpdateDataPoolRecordsJobData.Progress
+}
+
+// GetStartedAt returns CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob.StartedAt, and is useful for accessing the field via an interface.
+func (v *CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob) GetStartedAt() *time.Time {
+	return v.UpdateDataPoolRecordsJobData.StartedAt
+}
+
+// GetSucceededAt returns CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob.SucceededAt, and is useful for accessing the field via an interface.
+func (v *CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob) GetSucceededAt() *time.Time {
+	return v.UpdateDataPoolRecordsJobData.SucceededAt
+}
+
+// GetFailedAt returns CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob.FailedAt, and is useful for accessing the field via an interface.
+func (v *CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob) GetFailedAt() *time.Time {
+	return v.UpdateDataPoolRecordsJobData.FailedAt
+}
+
+func (v *CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.UpdateDataPoolRecordsJobData)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalCreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob struct {
+	Id string `json:"id"`
+
+	DataPool *UpdateDataPoolRecordsJobDataDataPool `json:"dataPool"`
+
+	Status JobStatus `json:"status"`
+
+	FilterSql *string `json:"filterSql"`
+
+	Set []*UpdateDataPoolRecordsJobDataSetUpdateDataPoolRecordsJobSetColumn `json:"set"`
+
+	Error *UpdateDataPoolRecordsJobDataError `json:"error"`
+
+	Progress float64 `json:"progress"`
+
+	StartedAt *time.Time `json:"startedAt"`
+
+	SucceededAt *time.Time `json:"succeededAt"`
+
+	FailedAt *time.Time `json:"failedAt"`
+}
+
+func (v *CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob) __premarshalJSON() (*__premarshalCreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob, error) {
+	var retval __premarshalCreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponseJobUpdateDataPoolRecordsJob
+
+	retval.Id = v.UpdateDataPoolRecordsJobData.Id
+	retval.DataPool = v.UpdateDataPoolRecordsJobData.DataPool
+	retval.Status = v.UpdateDataPoolRecordsJobData.Status
+	retval.FilterSql = v.UpdateDataPoolRecordsJobData.FilterSql
+	retval.Set = v.UpdateDataPoolRecordsJobData.Set
+	retval.Error = v.UpdateDataPoolRecordsJobData.Error
+	retval.Progress = v.UpdateDataPoolRecordsJobData.Progress
+	retval.StartedAt = v.UpdateDataPoolRecordsJobData.StartedAt
+	retval.SucceededAt = v.UpdateDataPoolRecordsJobData.SucceededAt
+	retval.FailedAt = v.UpdateDataPoolRecordsJobData.FailedAt
+	return &retval, nil
+}
+
+// The fields for creating an Update Data Pool Records Job.
+type CreateUpdateDataPoolRecordsJobInput struct {
+	// The Data Pool that is going to get its records updated.
+	DataPool string `json:"dataPool"`
+	// The list of filters that will be used for updating records. Records matching these filters will be updated.
+	Filters []*FilterInput `json:"filters,omitempty"`
+	// The filters that will be used for updating records, in the form of SQL. Records matching these filters will be updated.
+	FilterSql *string `json:"filterSql"`
+	// Describes how the job will update the records.
+	Set []*UpdateDataPoolRecordsJobSetColumnInput `json:"set,omitempty"`
+}
+
+// GetDataPool returns CreateUpdateDataPoolRecordsJobInput.DataPool, and is useful for accessing the field via an interface.
+func (v *CreateUpdateDataPoolRecordsJobInput) GetDataPool() string { return v.DataPool }
+
+// GetFilters returns CreateUpdateDataPoolRecordsJobInput.Filters, and is useful for accessing the field via an interface.
+func (v *CreateUpdateDataPoolRecordsJobInput) GetFilters() []*FilterInput { return v.Filters }
+
+// GetFilterSql returns CreateUpdateDataPoolRecordsJobInput.FilterSql, and is useful for accessing the field via an interface.
+func (v *CreateUpdateDataPoolRecordsJobInput) GetFilterSql() *string { return v.FilterSql }
+
+// GetSet returns CreateUpdateDataPoolRecordsJobInput.Set, and is useful for accessing the field via an interface.
+func (v *CreateUpdateDataPoolRecordsJobInput) GetSet() []*UpdateDataPoolRecordsJobSetColumnInput {
+	return v.Set
+}
+
+// CreateUpdateDataPoolRecordsJobResponse is returned by CreateUpdateDataPoolRecordsJob on success.
+type CreateUpdateDataPoolRecordsJobResponse struct {
+	// Schedules a new UpdateDataPoolRecords Job on the specified Data Pool.
+	CreateUpdateDataPoolRecordsJob *CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponse `json:"createUpdateDataPoolRecordsJob"`
+}
+
+// GetCreateUpdateDataPoolRecordsJob returns CreateUpdateDataPoolRecordsJobResponse.CreateUpdateDataPoolRecordsJob, and is useful for accessing the field via an interface.
+func (v *CreateUpdateDataPoolRecordsJobResponse) GetCreateUpdateDataPoolRecordsJob() *CreateUpdateDataPoolRecordsJobCreateUpdateDataPoolRecordsJobUpdateDataPoolRecordsJobResponse {
+	return v.CreateUpdateDataPoolRecordsJob
+}
+
 // CreateWebhookDataSourceCreateWebhookDataSourceDataSourceResponse includes the requested fields of the GraphQL type DataSourceResponse.
 // The GraphQL type's documentation follows.
 //
@@ -6496,6 +8243,160 @@ func (v *CreateWebhookDataSourceResponse) GetCreateWebhookDataSource() *CreateWe
 	return v.CreateWebhookDataSource
 }
 
+// DataPoolAccessPoliciesDataPool includes the requested fields of the GraphQL type DataPool.
+// The GraphQL type's documentation follows.
+//
+// The Data Pool object. Data Pools are Propel's high-speed data store and cache
+type DataPoolAccessPoliciesDataPool struct {
+	// The Data Pool's unique identifier.
+	Id string `json:"id"`
+	// A paginated list of Data Pool Access Policies available on the Data Pool.
+	DataPoolAccessPolicies *DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnection `json:"dataPoolAccessPolicies"`
+}
+
+// GetId returns DataPoolAccessPoliciesDataPool.Id, and is useful for accessing the field via an interface.
+func (v *DataPoolAccessPoliciesDataPool) GetId() string { return v.Id }
+
+// GetDataPoolAccessPolicies returns DataPoolAccessPoliciesDataPool.DataPoolAccessPolicies, and is useful for accessing the field via an interface.
+func (v *DataPoolAccessPoliciesDataPool) GetDataPoolAccessPolicies() *DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnection {
+	return v.DataPoolAccessPolicies
+}
+
+// DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnection includes the requested fields of the GraphQL type DataPoolAccessPolicyConnection.
+// The GraphQL type's documentation follows.
+//
+// The Data Pool Access Policy connection object.
+//
+// Learn more about [pagination in GraphQL](https://www.propeldata.com/docs/api/pagination).
+type DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnection struct {
+	// The Data Pool Access Policy connection's page info.
+	PageInfo *DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnectionPageInfo `json:"pageInfo"`
+	// The Data Pool Access Policy connection's nodes.
+	Nodes []*DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnectionNodesDataPoolAccessPolicy `json:"nodes"`
+}
+
+// GetPageInfo returns DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnection) GetPageInfo() *DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetNodes returns DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnection) GetNodes() []*DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnectionNodesDataPoolAccessPolicy {
+	return v.Nodes
+}
+
+// DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnectionNodesDataPoolAccessPolicy includes the requested fields of the GraphQL type DataPoolAccessPolicy.
+type DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnectionNodesDataPoolAccessPolicy struct {
+	// The ID of the Data Pool Access Policy.
+	Id string `json:"id"`
+	// The Data Pool Access Policy's unique name.
+	UniqueName string `json:"uniqueName"`
+}
+
+// GetId returns DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnectionNodesDataPoolAccessPolicy.Id, and is useful for accessing the field via an interface.
+func (v *DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnectionNodesDataPoolAccessPolicy) GetId() string {
+	return v.Id
+}
+
+// GetUniqueName returns DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnectionNodesDataPoolAccessPolicy.UniqueName, and is useful for accessing the field via an interface.
+func (v *DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnectionNodesDataPoolAccessPolicy) GetUniqueName() string {
+	return v.UniqueName
+}
+
+// DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// The page info object used for pagination.
+type DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnectionPageInfo struct {
+	PageInfoData `json:"-"`
+}
+
+// GetStartCursor returns DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnectionPageInfo.StartCursor, and is useful for accessing the field via an interface.
+func (v *DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnectionPageInfo) GetStartCursor() *string {
+	return v.PageInfoData.StartCursor
+}
+
+// GetEndCursor returns DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnectionPageInfo) GetEndCursor() *string {
+	return v.PageInfoData.EndCursor
+}
+
+// GetHasNextPage returns DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnectionPageInfo) GetHasNextPage() bool {
+	return v.PageInfoData.HasNextPage
+}
+
+// GetHasPreviousPage returns DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnectionPageInfo.HasPreviousPage, and is useful for accessing the field via an interface.
+func (v *DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnectionPageInfo) GetHasPreviousPage() bool {
+	return v.PageInfoData.HasPreviousPage
+}
+
+func (v *DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnectionPageInfo) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnectionPageInfo
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnectionPageInfo = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PageInfoData)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalDataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnectionPageInfo struct {
+	StartCursor *string `json:"startCursor"`
+
+	EndCursor *string `json:"endCursor"`
+
+	HasNextPage bool `json:"hasNextPage"`
+
+	HasPreviousPage bool `json:"hasPreviousPage"`
+}
+
+func (v *DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnectionPageInfo) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *DataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnectionPageInfo) __premarshalJSON() (*__premarshalDataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnectionPageInfo, error) {
+	var retval __premarshalDataPoolAccessPoliciesDataPoolDataPoolAccessPoliciesDataPoolAccessPolicyConnectionPageInfo
+
+	retval.StartCursor = v.PageInfoData.StartCursor
+	retval.EndCursor = v.PageInfoData.EndCursor
+	retval.HasNextPage = v.PageInfoData.HasNextPage
+	retval.HasPreviousPage = v.PageInfoData.HasPreviousPage
+	return &retval, nil
+}
+
+// DataPoolAccessPoliciesResponse is returned by DataPoolAccessPolicies on success.
+type DataPoolAccessPoliciesResponse struct {
+	// Returns the Data Pool specified by the given ID.
+	//
+	// A Data Pool is a cached table hydrated from your data warehouse optimized for high-concurrency and low-latency queries.
+	DataPool *DataPoolAccessPoliciesDataPool `json:"dataPool"`
+}
+
+// GetDataPool returns DataPoolAccessPoliciesResponse.DataPool, and is useful for accessing the field via an interface.
+func (v *DataPoolAccessPoliciesResponse) GetDataPool() *DataPoolAccessPoliciesDataPool {
+	return v.DataPool
+}
+
 // DataPoolAccessPolicyData includes the GraphQL fields of DataPoolAccessPolicy requested by the fragment DataPoolAccessPolicyData.
 type DataPoolAccessPolicyData struct {
 	// The ID of the Data Pool Access Policy.
@@ -6505,6 +8406,8 @@ type DataPoolAccessPolicyData struct {
 	Columns []string `json:"columns"`
 	// Row-level filters that the Access Policy applies before executing queries.
 	Rows []*DataPoolAccessPolicyDataRowsFilter `json:"rows"`
+	// Row-level filters that the Access Policy applies before executing queries, in the form of SQL.
+	FilterSql *string `json:"filterSql"`
 	// The Data Pool to which the Access Policy belongs.
 	DataPool *DataPoolAccessPolicyDataDataPool `json:"dataPool"`
 }
@@ -6518,6 +8421,9 @@ func (v *DataPoolAccessPolicyData) GetColumns() []string { return v.Columns }
 // GetRows returns DataPoolAccessPolicyData.Rows, and is useful for accessing the field via an interface.
 func (v *DataPoolAccessPolicyData) GetRows() []*DataPoolAccessPolicyDataRowsFilter { return v.Rows }
 
+// GetFilterSql returns DataPoolAccessPolicyData.FilterSql, and is useful for accessing the field via an interface.
+func (v *DataPoolAccessPolicyData) GetFilterSql() *string { return v.FilterSql }
+
 // GetDataPool returns DataPoolAccessPolicyData.DataPool, and is useful for accessing the field via an interface.
 func (v *DataPoolAccessPolicyData) GetDataPool() *DataPoolAccessPolicyDataDataPool { return v.DataPool }
 
@@ -6593,6 +8499,8 @@ type __premarshalDataPoolAccessPolicyData struct {
 
 	Rows []*DataPoolAccessPolicyDataRowsFilter `json:"rows"`
 
+	FilterSql *string `json:"filterSql"`
+
 	DataPool *DataPoolAccessPolicyDataDataPool `json:"dataPool"`
 
 	UniqueName string `json:"uniqueName"`
@@ -6626,6 +8534,7 @@ func (v *DataPoolAccessPolicyData) __premarshalJSON() (*__premarshalDataPoolAcce
 	retval.Id = v.Id
 	retval.Columns = v.Columns
 	retval.Rows = v.Rows
+	retval.FilterSql = v.FilterSql
 	retval.DataPool = v.DataPool
 	retval.UniqueName = v.CommonDataDataPoolAccessPolicy.UniqueName
 	retval.Description = v.CommonDataDataPoolAccessPolicy.Description
@@ -6677,6 +8586,11 @@ func (v *DataPoolAccessPolicyDataPoolAccessPolicy) GetRows() []*DataPoolAccessPo
 	return v.DataPoolAccessPolicyData.Rows
 }
 
+// GetFilterSql returns DataPoolAccessPolicyDataPoolAccessPolicy.FilterSql, and is useful for accessing the field via an interface.
+func (v *DataPoolAccessPolicyDataPoolAccessPolicy) GetFilterSql() *string {
+	return v.DataPoolAccessPolicyData.FilterSql
+}
+
 // GetDataPool returns DataPoolAccessPolicyDataPoolAccessPolicy.DataPool, and is useful for accessing the field via an interface.
 func (v *DataPoolAccessPolicyDataPoolAccessPolicy) GetDataPool() *DataPoolAccessPolicyDataDataPool {
 	return v.DataPoolAccessPolicyData.DataPool
@@ -6756,6 +8670,8 @@ type __premarshalDataPoolAccessPolicyDataPoolAccessPolicy struct {
 
 	Rows []*DataPoolAccessPolicyDataRowsFilter `json:"rows"`
 
+	FilterSql *string `json:"filterSql"`
+
 	DataPool *DataPoolAccessPolicyDataDataPool `json:"dataPool"`
 
 	UniqueName string `json:"uniqueName"`
@@ -6790,6 +8706,7 @@ func (v *DataPoolAccessPolicyDataPoolAccessPolicy) __premarshalJSON() (*__premar
 	retval.Id = v.DataPoolAccessPolicyData.Id
 	retval.Columns = v.DataPoolAccessPolicyData.Columns
 	retval.Rows = v.DataPoolAccessPolicyData.Rows
+	retval.FilterSql = v.DataPoolAccessPolicyData.FilterSql
 	retval.DataPool = v.DataPoolAccessPolicyData.DataPool
 	retval.UniqueName = v.DataPoolAccessPolicyData.CommonDataDataPoolAccessPolicy.UniqueName
 	retval.Description = v.DataPoolAccessPolicyData.CommonDataDataPoolAccessPolicy.Description
@@ -6981,6 +8898,9 @@ func (v *DataPoolByNameDataPool) GetError() *DataPoolDataError { return v.DataPo
 // GetTable returns DataPoolByNameDataPool.Table, and is useful for accessing the field via an interface.
 func (v *DataPoolByNameDataPool) GetTable() string { return v.DataPoolData.Table }
 
+// GetRecordCount returns DataPoolByNameDataPool.RecordCount, and is useful for accessing the field via an interface.
+func (v *DataPoolByNameDataPool) GetRecordCount() *string { return v.DataPoolData.RecordCount }
+
 // GetTenant returns DataPoolByNameDataPool.Tenant, and is useful for accessing the field via an interface.
 func (v *DataPoolByNameDataPool) GetTenant() *DataPoolDataTenant { return v.DataPoolData.Tenant }
 
@@ -7103,6 +9023,8 @@ type __premarshalDataPoolByNameDataPool struct {
 
 	Table string `json:"table"`
 
+	RecordCount *string `json:"recordCount"`
+
 	Tenant *DataPoolDataTenant `json:"tenant"`
 
 	Timestamp *DataPoolDataTimestamp `json:"timestamp"`
@@ -7156,6 +9078,7 @@ func (v *DataPoolByNameDataPool) __premarshalJSON() (*__premarshalDataPoolByName
 	retval.Status = v.DataPoolData.Status
 	retval.Error = v.DataPoolData.Error
 	retval.Table = v.DataPoolData.Table
+	retval.RecordCount = v.DataPoolData.RecordCount
 	retval.Tenant = v.DataPoolData.Tenant
 	retval.Timestamp = v.DataPoolData.Timestamp
 	retval.Columns = v.DataPoolData.Columns
@@ -7250,6 +9173,8 @@ type DataPoolData struct {
 	Error  *DataPoolDataError `json:"error"`
 	// The name of the Data Pool's table.
 	Table string `json:"table"`
+	// The number of records in the Data Pool.
+	RecordCount *string `json:"recordCount"`
 	// The Data Pool's tenant ID, if configured.
 	Tenant *DataPoolDataTenant `json:"tenant"`
 	// The Data Pool's primary timestamp column, if any.
@@ -7290,6 +9215,9 @@ func (v *DataPoolData) GetError() *DataPoolDataError { return v.Error }
 // GetTable returns DataPoolData.Table, and is useful for accessing the field via an interface.
 func (v *DataPoolData) GetTable() string { return v.Table }
 
+// GetRecordCount returns DataPoolData.RecordCount, and is useful for accessing the field via an interface.
+func (v *DataPoolData) GetRecordCount() *string { return v.RecordCount }
+
 // GetTenant returns DataPoolData.Tenant, and is useful for accessing the field via an interface.
 func (v *DataPoolData) GetTenant() *DataPoolDataTenant { return v.Tenant }
 
@@ -7388,6 +9316,8 @@ type __premarshalDataPoolData struct {
 
 	Table string `json:"table"`
 
+	RecordCount *string `json:"recordCount"`
+
 	Tenant *DataPoolDataTenant `json:"tenant"`
 
 	Timestamp *DataPoolDataTimestamp `json:"timestamp"`
@@ -7441,6 +9371,7 @@ func (v *DataPoolData) __premarshalJSON() (*__premarshalDataPoolData, error) {
 	retval.Status = v.Status
 	retval.Error = v.Error
 	retval.Table = v.Table
+	retval.RecordCount = v.RecordCount
 	retval.Tenant = v.Tenant
 	retval.Timestamp = v.Timestamp
 	retval.Columns = v.Columns
@@ -7686,6 +9617,11 @@ func (v *DataPoolDataDataPoolAccessPoliciesDataPoolAccessPolicyConnectionNodesDa
 	return v.DataPoolAccessPolicyData.Rows
 }
 
+// GetFilterSql returns DataPoolDataDataPoolAccessPoliciesDataPoolAccessPolicyConnectionNodesDataPoolAccessPolicy.FilterSql, and is useful for accessing the field via an interface.
+func (v *DataPoolDataDataPoolAccessPoliciesDataPoolAccessPolicyConnectionNodesDataPoolAccessPolicy) GetFilterSql() *string {
+	return v.DataPoolAccessPolicyData.FilterSql
+}
+
 // GetDataPool returns DataPoolDataDataPoolAccessPoliciesDataPoolAccessPolicyConnectionNodesDataPoolAccessPolicy.DataPool, and is useful for accessing the field via an interface.
 func (v *DataPoolDataDataPoolAccessPoliciesDataPoolAccessPolicyConnectionNodesDataPoolAccessPolicy) GetDataPool() *DataPoolAccessPolicyDataDataPool {
 	return v.DataPoolAccessPolicyData.DataPool
@@ -7763,6 +9699,8 @@ type __premarshalDataPoolDataDataPoolAccessPoliciesDataPoolAccessPolicyConnectio
 
 	Rows []*DataPoolAccessPolicyDataRowsFilter `json:"rows"`
 
+	FilterSql *string `json:"filterSql"`
+
 	DataPool *DataPoolAccessPolicyDataDataPool `json:"dataPool"`
 
 	UniqueName string `json:"uniqueName"`
@@ -7796,6 +9734,7 @@ func (v *DataPoolDataDataPoolAccessPoliciesDataPoolAccessPolicyConnectionNodesDa
 	retval.Id = v.DataPoolAccessPolicyData.Id
 	retval.Columns = v.DataPoolAccessPolicyData.Columns
 	retval.Rows = v.DataPoolAccessPolicyData.Rows
+	retval.FilterSql = v.DataPoolAccessPolicyData.FilterSql
 	retval.DataPool = v.DataPoolAccessPolicyData.DataPool
 	retval.UniqueName = v.DataPoolAccessPolicyData.CommonDataDataPoolAccessPolicy.UniqueName
 	retval.Description = v.DataPoolAccessPolicyData.CommonDataDataPoolAccessPolicy.Description
@@ -8033,6 +9972,9 @@ func (v *DataPoolDataPool) GetError() *DataPoolDataError { return v.DataPoolData
 // GetTable returns DataPoolDataPool.Table, and is useful for accessing the field via an interface.
 func (v *DataPoolDataPool) GetTable() string { return v.DataPoolData.Table }
 
+// GetRecordCount returns DataPoolDataPool.RecordCount, and is useful for accessing the field via an interface.
+func (v *DataPoolDataPool) GetRecordCount() *string { return v.DataPoolData.RecordCount }
+
 // GetTenant returns DataPoolDataPool.Tenant, and is useful for accessing the field via an interface.
 func (v *DataPoolDataPool) GetTenant() *DataPoolDataTenant { return v.DataPoolData.Tenant }
 
@@ -8149,6 +10091,8 @@ type __premarshalDataPoolDataPool struct {
 
 	Table string `json:"table"`
 
+	RecordCount *string `json:"recordCount"`
+
 	Tenant *DataPoolDataTenant `json:"tenant"`
 
 	Timestamp *DataPoolDataTimestamp `json:"timestamp"`
@@ -8202,6 +10146,7 @@ func (v *DataPoolDataPool) __premarshalJSON() (*__premarshalDataPoolDataPool, er
 	retval.Status = v.DataPoolData.Status
 	retval.Error = v.DataPoolData.Error
 	retval.Table = v.DataPoolData.Table
+	retval.RecordCount = v.DataPoolData.RecordCount
 	retval.Tenant = v.DataPoolData.Tenant
 	retval.Timestamp = v.DataPoolData.Timestamp
 	retval.Columns = v.DataPoolData.Columns
@@ -8590,6 +10535,161 @@ func (v *DataPoolInput) GetId() *string { return v.Id }
 // GetName returns DataPoolInput.Name, and is useful for accessing the field via an interface.
 func (v *DataPoolInput) GetName() *string { return v.Name }
 
+// DataPoolMetricsDataPool includes the requested fields of the GraphQL type DataPool.
+// The GraphQL type's documentation follows.
+//
+// The Data Pool object. Data Pools are Propel's high-speed data store and cache
+type DataPoolMetricsDataPool struct {
+	// The Data Pool's unique identifier.
+	Id string `json:"id"`
+	// The list of Metrics powered by the Data Pool.
+	Metrics *DataPoolMetricsDataPoolMetricsMetricConnection `json:"metrics"`
+}
+
+// GetId returns DataPoolMetricsDataPool.Id, and is useful for accessing the field via an interface.
+func (v *DataPoolMetricsDataPool) GetId() string { return v.Id }
+
+// GetMetrics returns DataPoolMetricsDataPool.Metrics, and is useful for accessing the field via an interface.
+func (v *DataPoolMetricsDataPool) GetMetrics() *DataPoolMetricsDataPoolMetricsMetricConnection {
+	return v.Metrics
+}
+
+// DataPoolMetricsDataPoolMetricsMetricConnection includes the requested fields of the GraphQL type MetricConnection.
+// The GraphQL type's documentation follows.
+//
+// The Metric connection object.
+//
+// Learn more about [pagination in GraphQL](https://www.propeldata.com/docs/api/pagination).
+type DataPoolMetricsDataPoolMetricsMetricConnection struct {
+	// The Metric connection's page info.
+	PageInfo *DataPoolMetricsDataPoolMetricsMetricConnectionPageInfo `json:"pageInfo"`
+	// The Metric connection's nodes.
+	Nodes []*DataPoolMetricsDataPoolMetricsMetricConnectionNodesMetric `json:"nodes"`
+}
+
+// GetPageInfo returns DataPoolMetricsDataPoolMetricsMetricConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *DataPoolMetricsDataPoolMetricsMetricConnection) GetPageInfo() *DataPoolMetricsDataPoolMetricsMetricConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetNodes returns DataPoolMetricsDataPoolMetricsMetricConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *DataPoolMetricsDataPoolMetricsMetricConnection) GetNodes() []*DataPoolMetricsDataPoolMetricsMetricConnectionNodesMetric {
+	return v.Nodes
+}
+
+// DataPoolMetricsDataPoolMetricsMetricConnectionNodesMetric includes the requested fields of the GraphQL type Metric.
+// The GraphQL type's documentation follows.
+//
+// The Metric object.
+//
+// A Metric is a business indicator measured over time.
+type DataPoolMetricsDataPoolMetricsMetricConnectionNodesMetric struct {
+	// The Metric's unique identifier.
+	Id string `json:"id"`
+	// The Metric's unique name.
+	UniqueName string `json:"uniqueName"`
+}
+
+// GetId returns DataPoolMetricsDataPoolMetricsMetricConnectionNodesMetric.Id, and is useful for accessing the field via an interface.
+func (v *DataPoolMetricsDataPoolMetricsMetricConnectionNodesMetric) GetId() string { return v.Id }
+
+// GetUniqueName returns DataPoolMetricsDataPoolMetricsMetricConnectionNodesMetric.UniqueName, and is useful for accessing the field via an interface.
+func (v *DataPoolMetricsDataPoolMetricsMetricConnectionNodesMetric) GetUniqueName() string {
+	return v.UniqueName
+}
+
+// DataPoolMetricsDataPoolMetricsMetricConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// The page info object used for pagination.
+type DataPoolMetricsDataPoolMetricsMetricConnectionPageInfo struct {
+	PageInfoData `json:"-"`
+}
+
+// GetStartCursor returns DataPoolMetricsDataPoolMetricsMetricConnectionPageInfo.StartCursor, and is useful for accessing the field via an interface.
+func (v *DataPoolMetricsDataPoolMetricsMetricConnectionPageInfo) GetStartCursor() *string {
+	return v.PageInfoData.StartCursor
+}
+
+// GetEndCursor returns DataPoolMetricsDataPoolMetricsMetricConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *DataPoolMetricsDataPoolMetricsMetricConnectionPageInfo) GetEndCursor() *string {
+	return v.PageInfoData.EndCursor
+}
+
+// GetHasNextPage returns DataPoolMetricsDataPoolMetricsMetricConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *DataPoolMetricsDataPoolMetricsMetricConnectionPageInfo) GetHasNextPage() bool {
+	return v.PageInfoData.HasNextPage
+}
+
+// GetHasPreviousPage returns DataPoolMetricsDataPoolMetricsMetricConnectionPageInfo.HasPreviousPage, and is useful for accessing the field via an interface.
+func (v *DataPoolMetricsDataPoolMetricsMetricConnectionPageInfo) GetHasPreviousPage() bool {
+	return v.PageInfoData.HasPreviousPage
+}
+
+func (v *DataPoolMetricsDataPoolMetricsMetricConnectionPageInfo) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*DataPoolMetricsDataPoolMetricsMetricConnectionPageInfo
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.DataPoolMetricsDataPoolMetricsMetricConnectionPageInfo = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PageInfoData)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalDataPoolMetricsDataPoolMetricsMetricConnectionPageInfo struct {
+	StartCursor *string `json:"startCursor"`
+
+	EndCursor *string `json:"endCursor"`
+
+	HasNextPage bool `json:"hasNextPage"`
+
+	HasPreviousPage bool `json:"hasPreviousPage"`
+}
+
+func (v *DataPoolMetricsDataPoolMetricsMetricConnectionPageInfo) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *DataPoolMetricsDataPoolMetricsMetricConnectionPageInfo) __premarshalJSON() (*__premarshalDataPoolMetricsDataPoolMetricsMetricConnectionPageInfo, error) {
+	var retval __premarshalDataPoolMetricsDataPoolMetricsMetricConnectionPageInfo
+
+	retval.StartCursor = v.PageInfoData.StartCursor
+	retval.EndCursor = v.PageInfoData.EndCursor
+	retval.HasNextPage = v.PageInfoData.HasNextPage
+	retval.HasPreviousPage = v.PageInfoData.HasPreviousPage
+	return &retval, nil
+}
+
+// DataPoolMetricsResponse is returned by DataPoolMetrics on success.
+type DataPoolMetricsResponse struct {
+	// Returns the Data Pool specified by the given ID.
+	//
+	// A Data Pool is a cached table hydrated from your data warehouse optimized for high-concurrency and low-latency queries.
+	DataPool *DataPoolMetricsDataPool `json:"dataPool"`
+}
+
+// GetDataPool returns DataPoolMetricsResponse.DataPool, and is useful for accessing the field via an interface.
+func (v *DataPoolMetricsResponse) GetDataPool() *DataPoolMetricsDataPool { return v.DataPool }
+
 // DataPoolResponse is returned by DataPool on success.
 type DataPoolResponse struct {
 	// Returns the Data Pool specified by the given ID.
@@ -8769,6 +10869,11 @@ func (v *DataPoolsDataPoolsDataPoolConnectionEdgesDataPoolEdgeNodeDataPool) GetT
 	return v.DataPoolData.Table
 }
 
+// GetRecordCount returns DataPoolsDataPoolsDataPoolConnectionEdgesDataPoolEdgeNodeDataPool.RecordCount, and is useful for accessing the field via an interface.
+func (v *DataPoolsDataPoolsDataPoolConnectionEdgesDataPoolEdgeNodeDataPool) GetRecordCount() *string {
+	return v.DataPoolData.RecordCount
+}
+
 // GetTenant returns DataPoolsDataPoolsDataPoolConnectionEdgesDataPoolEdgeNodeDataPool.Tenant, and is useful for accessing the field via an interface.
 func (v *DataPoolsDataPoolsDataPoolConnectionEdgesDataPoolEdgeNodeDataPool) GetTenant() *DataPoolDataTenant {
 	return v.DataPoolData.Tenant
@@ -8895,6 +11000,8 @@ type __premarshalDataPoolsDataPoolsDataPoolConnectionEdgesDataPoolEdgeNodeDataPo
 
 	Table string `json:"table"`
 
+	RecordCount *string `json:"recordCount"`
+
 	Tenant *DataPoolDataTenant `json:"tenant"`
 
 	Timestamp *DataPoolDataTimestamp `json:"timestamp"`
@@ -8948,6 +11055,7 @@ func (v *DataPoolsDataPoolsDataPoolConnectionEdgesDataPoolEdgeNodeDataPool) __pr
 	retval.Status = v.DataPoolData.Status
 	retval.Error = v.DataPoolData.Error
 	retval.Table = v.DataPoolData.Table
+	retval.RecordCount = v.DataPoolData.RecordCount
 	retval.Tenant = v.DataPoolData.Tenant
 	retval.Timestamp = v.DataPoolData.Timestamp
 	retval.Columns = v.DataPoolData.Columns
@@ -9909,6 +12017,16 @@ func (v *DataSourceDataConnectionSettingsKafkaConnectionSettings) GetBootstrapSe
 // The PostgreSQL Data Source connection settings.
 type DataSourceDataConnectionSettingsPostgreSqlConnectionSettings struct {
 	Typename *string `json:"__typename"`
+	// The host where PostgreSQL is listening
+	Host string `json:"host"`
+	// The port where PostgreSQL is listening (usually 5432)
+	Port *int `json:"port"`
+	// Which database to connect to
+	PostgreSqlDatabase *string `json:"postgreSqlDatabase"`
+	// Which schema to use
+	PostgreSqlSchema *string `json:"postgreSqlSchema"`
+	// The user for authenticating against PostgreSQL
+	User string `json:"user"`
 }
 
 // GetTypename returns DataSourceDataConnectionSettingsPostgreSqlConnectionSettings.Typename, and is useful for accessing the field via an interface.
@@ -9916,6 +12034,29 @@ func (v *DataSourceDataConnectionSettingsPostgreSqlConnectionSettings) GetTypena
 	return v.Typename
 }
 
+// GetHost returns DataSourceDataConnectionSettingsPostgreSqlConnectionSettings.Host, and is useful for accessing the field via an interface.
+func (v *DataSourceDataConnectionSettingsPostgreSqlConnectionSettings) GetHost() string {
+	return v.Host
+}
+
+// GetPort returns DataSourceDataConnectionSettingsPostgreSqlConnectionSettings.Port, and is useful for accessing the field via an interface.
+func (v *DataSourceDataConnectionSettingsPostgreSqlConnectionSettings) GetPort() *int { return v.Port }
+
+// GetPostgreSqlDatabase returns DataSourceDataConnectionSettingsPostgreSqlConnectionSettings.PostgreSqlDatabase, and is useful for accessing the field via an interface.
+func (v *DataSourceDataConnectionSettingsPostgreSqlConnectionSettings) GetPostgreSqlDatabase() *string {
+	return v.PostgreSqlDatabase
+}
+
+// GetPostgreSqlSchema returns DataSourceDataConnectionSettingsPostgreSqlConnectionSettings.PostgreSqlSchema, and is useful for accessing the field via an interface.
+func (v *DataSourceDataConnectionSettingsPostgreSqlConnectionSettings) GetPostgreSqlSchema() *string {
+	return v.PostgreSqlSchema
+}
+
+// GetUser returns DataSourceDataConnectionSettingsPostgreSqlConnectionSettings.User, and is useful for accessing the field via an interface.
+func (v *DataSourceDataConnectionSettingsPostgreSqlConnectionSettings) GetUser() string {
+	return v.User
+}
+
 // DataSourceDataConnectionSettingsS3ConnectionSettings includes the requested fields of the GraphQL type S3ConnectionSettings.
 // The GraphQL type's documentation follows.
 //
@@ -11264,6 +13405,17 @@ type DeleteApplicationResponse struct {
 // GetDeleteApplication returns DeleteApplicationResponse.DeleteApplication, and is useful for accessing the field via an interface.
 func (v *DeleteApplicationResponse) GetDeleteApplication() *string { return v.DeleteApplication }
 
+// DeleteBoosterResponse is returned by DeleteBooster on success.
+type DeleteBoosterResponse struct {
+	// Deletes a Booster by ID and then returns the same ID if the Booster was deleted successfully.
+	//
+	// A Booster significantly improves the query performance for a Metric.
+	DeleteBooster *string `json:"deleteBooster"`
+}
+
+// GetDeleteBooster returns DeleteBoosterResponse.DeleteBooster, and is useful for accessing the field via an interface.
+func (v *DeleteBoosterResponse) GetDeleteBooster() *string { return v.DeleteBooster }
+
 // DeleteDataPoolAccessPolicyResponse is returned by DeleteDataPoolAccessPolicy on success.
 type DeleteDataPoolAccessPolicyResponse struct {
 	// Deletes a Data Pool Access Policy by ID and returns its ID if the Data Pool Access Policy was deleted successfully.
@@ -11355,6 +13507,320 @@ type DeletePolicyResponse struct {
 // GetDeletePolicy returns DeletePolicyResponse.DeletePolicy, and is useful for accessing the field via an interface.
 func (v *DeletePolicyResponse) GetDeletePolicy() *string { return v.DeletePolicy }
 
+// DeletionJobData includes the GraphQL fields of DeletionJob requested by the fragment DeletionJobData.
+// The GraphQL type's documentation follows.
+//
+// Deletion Job scheduled for a specific Data Pool.
+//
+// The Deletion Job represents the asynchronous process of deleting data
+// given some filters inside a Data Pool. It tracks the deletion process
+// until it is finished, showing the progress and the outcome when it is finished.
+type DeletionJobData struct {
+	// The Deletion Job's ID.
+	Id string `json:"id"`
+	// The Data Pool whose records will be deleted by the Deletion Job.
+	DataPool *DeletionJobDataDataPool `json:"dataPool"`
+	// The current Deletion Job's status.
+	Status JobStatus `json:"status"`
+	// The filters that will be used for deleting data, in the form of SQL. Data matching the filters will be deleted.
+	FilterSql *string `json:"filterSql"`
+	// The error that occurred while deleting data, if any.
+	Error *DeletionJobDataError `json:"error"`
+	// The current progress of the Deletion Job, from 0.0 to 1.0.
+	Progress float64 `json:"progress"`
+	// The time at which the Deletion Job started.
+	StartedAt *time.Time `json:"startedAt"`
+	// The time at which the Deletion Job succeeded.
+	SucceededAt *time.Time `json:"succeededAt"`
+	// The time at which the Deletion Job failed.
+	FailedAt *time.Time `json:"failedAt"`
+}
+
+// GetId returns DeletionJobData.Id, and is useful for accessing the field via an interface.
+func (v *DeletionJobData) GetId() string { return v.Id }
+
+// GetDataPool returns DeletionJobData.DataPool, and is useful for accessing the field via an interface.
+func (v *DeletionJobData) GetDataPool() *DeletionJobDataDataPool { return v.DataPool }
+
+// GetStatus returns DeletionJobData.Status, and is useful for accessing the field via an interface.
+func (v *DeletionJobData) GetStatus() JobStatus { return v.Status }
+
+// GetFilterSql returns DeletionJobData.FilterSql, and is useful for accessing the field via an interface.
+func (v *DeletionJobData) GetFilterSql() *string { return v.FilterSql }
+
+// GetError returns DeletionJobData.Error, and is useful for accessing the field via an interface.
+func (v *DeletionJobData) GetError() *DeletionJobDataError { return v.Error }
+
+// GetProgress returns DeletionJobData.Progress, and is useful for accessing the field via an interface.
+func (v *DeletionJobData) GetProgress() float64 { return v.Progress }
+
+// GetStartedAt returns DeletionJobData.StartedAt, and is useful for accessing the field via an interface.
+func (v *DeletionJobData) GetStartedAt() *time.Time { return v.StartedAt }
+
+// GetSucceededAt returns DeletionJobData.SucceededAt, and is useful for accessing the field via an interface.
+func (v *DeletionJobData) GetSucceededAt() *time.Time { return v.SucceededAt }
+
+// GetFailedAt returns DeletionJobData.FailedAt, and is useful for accessing the field via an interface.
+func (v *DeletionJobData) GetFailedAt() *time.Time { return v.FailedAt }
+
+// DeletionJobDataDataPool includes the requested fields of the GraphQL type DataPool.
+// The GraphQL type's documentation follows.
+//
+// The Data Pool object. Data Pools are Propel's high-speed data store and cache
+type DeletionJobDataDataPool struct {
+	// The Data Pool's unique identifier.
+	Id string `json:"id"`
+}
+
+// GetId returns DeletionJobDataDataPool.Id, and is useful for accessing the field via an interface.
+func (v *DeletionJobDataDataPool) GetId() string { return v.Id }
+
+// DeletionJobDataError includes the requested fields of the GraphQL type Error.
+// The GraphQL type's documentation follows.
+//
+// The error object.
+type DeletionJobDataError struct {
+	GqlError `json:"-"`
+}
+
+// GetCode returns DeletionJobDataError.Code, and is useful for accessing the field via an interface.
+func (v *DeletionJobDataError) GetCode() *int { return v.GqlError.Code }
+
+// GetMessage returns DeletionJobDataError.Message, and is useful for accessing the field via an interface.
+func (v *DeletionJobDataError) GetMessage() string { return v.GqlError.Message }
+
+func (v *DeletionJobDataError) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*DeletionJobDataError
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.DeletionJobDataError = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.GqlError)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalDeletionJobDataError struct {
+	Code *int `json:"code"`
+
+	Message string `json:"message"`
+}
+
+func (v *DeletionJobDataError) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *DeletionJobDataError) __premarshalJSON() (*__premarshalDeletionJobDataError, error) {
+	var retval __premarshalDeletionJobDataError
+
+	retval.Code = v.GqlError.Code
+	retval.Message = v.GqlError.Message
+	return &retval, nil
+}
+
+// DeletionJobDeletionJob includes the requested fields of the GraphQL type DeletionJob.
+// The GraphQL type's documentation follows.
+//
+// Deletion Job scheduled for a specific Data Pool.
+//
+// The Deletion Job represents the asynchronous process of deleting data
+// given some filters inside a Data Pool. It tracks the deletion process
+// until it is finished, showing the progress and the outcome when it is finished.
+type DeletionJobDeletionJob struct {
+	DeletionJobData `json:"-"`
+}
+
+// GetId returns DeletionJobDeletionJob.Id, and is useful for accessing the field via an interface.
+func (v *DeletionJobDeletionJob) GetId() string { return v.DeletionJobData.Id }
+
+// GetDataPool returns DeletionJobDeletionJob.DataPool, and is useful for accessing the field via an interface.
+func (v *DeletionJobDeletionJob) GetDataPool() *DeletionJobDataDataPool {
+	return v.DeletionJobData.DataPool
+}
+
+// GetStatus returns DeletionJobDeletionJob.Status, and is useful for accessing the field via an interface.
+func (v *DeletionJobDeletionJob) GetStatus() JobStatus { return v.DeletionJobData.Status }
+
+// GetFilterSql returns DeletionJobDeletionJob.FilterSql, and is useful for accessing the field via an interface.
+func (v *DeletionJobDeletionJob) GetFilterSql() *string { return v.DeletionJobData.FilterSql }
+
+// GetError returns DeletionJobDeletionJob.Error, and is useful for accessing the field via an interface.
+func (v *DeletionJobDeletionJob) GetError() *DeletionJobDataError { return v.DeletionJobData.Error }
+
+// GetProgress returns DeletionJobDeletionJob.Progress, and is useful for accessing the field via an interface.
+func (v *DeletionJobDeletionJob) GetProgress() float64 { return v.DeletionJobData.Progress }
+
+// GetStartedAt returns DeletionJobDeletionJob.StartedAt, and is useful for accessing the field via an interface.
+func (v *DeletionJobDeletionJob) GetStartedAt() *time.Time { return v.DeletionJobData.StartedAt }
+
+// GetSucceededAt returns DeletionJobDeletionJob.SucceededAt, and is useful for accessing the field via an interface.
+func (v *DeletionJobDeletionJob) GetSucceededAt() *time.Time { return v.DeletionJobData.SucceededAt }
+
+// GetFailedAt returns DeletionJobDeletionJob.FailedAt, and is useful for accessing the field via an interface.
+func (v *DeletionJobDeletionJob) GetFailedAt() *time.Time { return v.DeletionJobData.FailedAt }
+
+func (v *DeletionJobDeletionJob) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*DeletionJobDeletionJob
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.DeletionJobDeletionJob = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.DeletionJobData)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalDeletionJobDeletionJob struct {
+	Id string `json:"id"`
+
+	DataPool *DeletionJobDataDataPool `json:"dataPool"`
+
+	Status JobStatus `json:"status"`
+
+	FilterSql *string `json:"filterSql"`
+
+	Error *DeletionJobDataError `json:"error"`
+
+	Progress float64 `json:"progress"`
+
+	StartedAt *time.Time `json:"startedAt"`
+
+	SucceededAt *time.Time `json:"succeededAt"`
+
+	FailedAt *time.Time `json:"failedAt"`
+}
+
+func (v *DeletionJobDeletionJob) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *DeletionJobDeletionJob) __premarshalJSON() (*__premarshalDeletionJobDeletionJob, error) {
+	var retval __premarshalDeletionJobDeletionJob
+
+	retval.Id = v.DeletionJobData.Id
+	retval.DataPool = v.DeletionJobData.DataPool
+	retval.Status = v.DeletionJobData.Status
+	retval.FilterSql = v.DeletionJobData.FilterSql
+	retval.Error = v.DeletionJobData.Error
+	retval.Progress = v.DeletionJobData.Progress
+	retval.StartedAt = v.DeletionJobData.StartedAt
+	retval.SucceededAt = v.DeletionJobData.SucceededAt
+	retval.FailedAt = v.DeletionJobData.FailedAt
+	return &retval, nil
+}
+
+// DeletionJobResponse is returned by DeletionJob on success.
+type DeletionJobResponse struct {
+	// Returns the Deletion Job specified by the given ID.
+	//
+	// The Deletion Job represents the asynchronous process of deleting data
+	// given some filters inside a Data Pool.
+	DeletionJob *DeletionJobDeletionJob `json:"deletionJob"`
+}
+
+// GetDeletionJob returns DeletionJobResponse.DeletionJob, and is useful for accessing the field via an interface.
+func (v *DeletionJobResponse) GetDeletionJob() *DeletionJobDeletionJob { return v.DeletionJob }
+
+// DescribeSqlDescribeSqlV1DescribeSqlResponse includes the requested fields of the GraphQL type DescribeSqlResponse.
+// The GraphQL type's documentation follows.
+//
+// Response from the describe SQL API.
+type DescribeSqlDescribeSqlV1DescribeSqlResponse struct {
+	// The columns that the query would return.
+	Columns []*DescribeSqlDescribeSqlV1DescribeSqlResponseColumnsSqlColumnResponse `json:"columns"`
+}
+
+// GetColumns returns DescribeSqlDescribeSqlV1DescribeSqlResponse.Columns, and is useful for accessing the field via an interface.
+func (v *DescribeSqlDescribeSqlV1DescribeSqlResponse) GetColumns() []*DescribeSqlDescribeSqlV1DescribeSqlResponseColumnsSqlColumnResponse {
+	return v.Columns
+}
+
+// DescribeSqlDescribeSqlV1DescribeSqlResponseColumnsSqlColumnResponse includes the requested fields of the GraphQL type SqlColumnResponse.
+type DescribeSqlDescribeSqlV1DescribeSqlResponseColumnsSqlColumnResponse struct {
+	// The name of the returned column.
+	ColumnName string `json:"columnName"`
+	// The returned column's type.
+	Type ColumnType `json:"type"`
+	// Whether the column is nullable, meaning whether it accepts a null value.
+	IsNullable bool `json:"isNullable"`
+}
+
+// GetColumnName returns DescribeSqlDescribeSqlV1DescribeSqlResponseColumnsSqlColumnResponse.ColumnName, and is useful for accessing the field via an interface.
+func (v *DescribeSqlDescribeSqlV1DescribeSqlResponseColumnsSqlColumnResponse) GetColumnName() string {
+	return v.ColumnName
+}
+
+// GetType returns DescribeSqlDescribeSqlV1DescribeSqlResponseColumnsSqlColumnResponse.Type, and is useful for accessing the field via an interface.
+func (v *DescribeSqlDescribeSqlV1DescribeSqlResponseColumnsSqlColumnResponse) GetType() ColumnType {
+	return v.Type
+}
+
+// GetIsNullable returns DescribeSqlDescribeSqlV1DescribeSqlResponseColumnsSqlColumnResponse.IsNullable, and is useful for accessing the field via an interface.
+func (v *DescribeSqlDescribeSqlV1DescribeSqlResponseColumnsSqlColumnResponse) GetIsNullable() bool {
+	return v.IsNullable
+}
+
+// DescribeSqlResponse is returned by DescribeSql on success.
+type DescribeSqlResponse struct {
+	// Describe SQL statements Data Pools.
+	DescribeSqlV1 *DescribeSqlDescribeSqlV1DescribeSqlResponse `json:"describeSqlV1"`
+}
+
+// GetDescribeSqlV1 returns DescribeSqlResponse.DescribeSqlV1, and is useful for accessing the field via an interface.
+func (v *DescribeSqlResponse) GetDescribeSqlV1() *DescribeSqlDescribeSqlV1DescribeSqlResponse {
+	return v.DescribeSqlV1
+}
+
+// Input for describing SqlV1 inputs.
+type DescribeSqlV1Input struct {
+	// The SQL query.
+	Query string `json:"query"`
+	// The SQL dialect to use. If not provided, the query is parsed on a best-effort basis.
+	Dialect *SqlDialectV1 `json:"dialect"`
+}
+
+// GetQuery returns DescribeSqlV1Input.Query, and is useful for accessing the field via an interface.
+func (v *DescribeSqlV1Input) GetQuery() string { return v.Query }
+
+// GetDialect returns DescribeSqlV1Input.Dialect, and is useful for accessing the field via an interface.
+func (v *DescribeSqlV1Input) GetDialect() *SqlDialectV1 { return v.Dialect }
+
 // DimensionData includes the GraphQL fields of Dimension requested by the fragment DimensionData.
 // The GraphQL type's documentation follows.
 //
@@ -11391,6 +13857,383 @@ type DimensionInput struct {
 // GetColumnName returns DimensionInput.ColumnName, and is useful for accessing the field via an interface.
 func (v *DimensionInput) GetColumnName() string { return v.ColumnName }
 
+// DisableSyncingDisableSyncingDataPool includes the requested fields of the GraphQL type DataPool.
+// The GraphQL type's documentation follows.
+//
+// The Data Pool object. Data Pools are Propel's high-speed data store and cache
+type DisableSyncingDisableSyncingDataPool struct {
+	// The Data Pool's unique identifier.
+	Id string `json:"id"`
+	// Settings related to Data Pool syncing.
+	Syncing *DisableSyncingDisableSyncingDataPoolSyncing `json:"syncing"`
+}
+
+// GetId returns DisableSyncingDisableSyncingDataPool.Id, and is useful for accessing the field via an interface.
+func (v *DisableSyncingDisableSyncingDataPool) GetId() string { return v.Id }
+
+// GetSyncing returns DisableSyncingDisableSyncingDataPool.Syncing, and is useful for accessing the field via an interface.
+func (v *DisableSyncingDisableSyncingDataPool) GetSyncing() *DisableSyncingDisableSyncingDataPoolSyncing {
+	return v.Syncing
+}
+
+// DisableSyncingDisableSyncingDataPoolSyncing includes the requested fields of the GraphQL type DataPoolSyncing.
+// The GraphQL type's documentation follows.
+//
+// Settings related to Data Pool syncing.
+type DisableSyncingDisableSyncingDataPoolSyncing struct {
+	DataPoolSyncingData `json:"-"`
+}
+
+// GetStatus returns DisableSyncingDisableSyncingDataPoolSyncing.Status, and is useful for accessing the field via an interface.
+func (v *DisableSyncingDisableSyncingDataPoolSyncing) GetStatus() DataPoolSyncStatus {
+	return v.DataPoolSyncingData.Status
+}
+
+// GetInterval returns DisableSyncingDisableSyncingDataPoolSyncing.Interval, and is useful for accessing the field via an interface.
+func (v *DisableSyncingDisableSyncingDataPoolSyncing) GetInterval() *DataPoolSyncInterval {
+	return v.DataPoolSyncingData.Interval
+}
+
+// GetLastSyncedAt returns DisableSyncingDisableSyncingDataPoolSyncing.LastSyncedAt, and is useful for accessing the field via an interface.
+func (v *DisableSyncingDisableSyncingDataPoolSyncing) GetLastSyncedAt() *time.Time {
+	return v.DataPoolSyncingData.LastSyncedAt
+}
+
+func (v *DisableSyncingDisableSyncingDataPoolSyncing) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*DisableSyncingDisableSyncingDataPoolSyncing
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.DisableSyncingDisableSyncingDataPoolSyncing = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.DataPoolSyncingData)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalDisableSyncingDisableSyncingDataPoolSyncing struct {
+	Status DataPoolSyncStatus `json:"status"`
+
+	Interval *DataPoolSyncInterval `json:"interval"`
+
+	LastSyncedAt *time.Time `json:"lastSyncedAt"`
+}
+
+func (v *DisableSyncingDisableSyncingDataPoolSyncing) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *DisableSyncingDisableSyncingDataPoolSyncing) __premarshalJSON() (*__premarshalDisableSyncingDisableSyncingDataPoolSyncing, error) {
+	var retval __premarshalDisableSyncingDisableSyncingDataPoolSyncing
+
+	retval.Status = v.DataPoolSyncingData.Status
+	retval.Interval = v.DataPoolSyncingData.Interval
+	retval.LastSyncedAt = v.DataPoolSyncingData.LastSyncedAt
+	return &retval, nil
+}
+
+// DisableSyncingResponse is returned by DisableSyncing on success.
+type DisableSyncingResponse struct {
+	// Disables syncing of a Data Pool.
+	DisableSyncing *DisableSyncingDisableSyncingDataPool `json:"disableSyncing"`
+}
+
+// GetDisableSyncing returns DisableSyncingResponse.DisableSyncing, and is useful for accessing the field via an interface.
+func (v *DisableSyncingResponse) GetDisableSyncing() *DisableSyncingDisableSyncingDataPool {
+	return v.DisableSyncing
+}
+
+// EnableSyncingEnableSyncingDataPool includes the requested fields of the GraphQL type DataPool.
+// The GraphQL type's documentation follows.
+//
+// The Data Pool object. Data Pools are Propel's high-speed data store and cache
+type EnableSyncingEnableSyncingDataPool struct {
+	// The Data Pool's unique identifier.
+	Id string `json:"id"`
+	// Settings related to Data Pool syncing.
+	Syncing *EnableSyncingEnableSyncingDataPoolSyncing `json:"syncing"`
+}
+
+// GetId returns EnableSyncingEnableSyncingDataPool.Id, and is useful for accessing the field via an interface.
+func (v *EnableSyncingEnableSyncingDataPool) GetId() string { return v.Id }
+
+// GetSyncing returns EnableSyncingEnableSyncingDataPool.Syncing, and is useful for accessing the field via an interface.
+func (v *EnableSyncingEnableSyncingDataPool) GetSyncing() *EnableSyncingEnableSyncingDataPoolSyncing {
+	return v.Syncing
+}
+
+// EnableSyncingEnableSyncingDataPoolSyncing includes the requested fields of the GraphQL type DataPoolSyncing.
+// The GraphQL type's documentation follows.
+//
+// Settings related to Data Pool syncing.
+type EnableSyncingEnableSyncingDataPoolSyncing struct {
+	DataPoolSyncingData `json:"-"`
+}
+
+// GetStatus returns EnableSyncingEnableSyncingDataPoolSyncing.Status, and is useful for accessing the field via an interface.
+func (v *EnableSyncingEnableSyncingDataPoolSyncing) GetStatus() DataPoolSyncStatus {
+	return v.DataPoolSyncingData.Status
+}
+
+// GetInterval returns EnableSyncingEnableSyncingDataPoolSyncing.Interval, and is useful for accessing the field via an interface.
+func (v *EnableSyncingEnableSyncingDataPoolSyncing) GetInterval() *DataPoolSyncInterval {
+	return v.DataPoolSyncingData.Interval
+}
+
+// GetLastSyncedAt returns EnableSyncingEnableSyncingDataPoolSyncing.LastSyncedAt, and is useful for accessing the field via an interface.
+func (v *EnableSyncingEnableSyncingDataPoolSyncing) GetLastSyncedAt() *time.Time {
+	return v.DataPoolSyncingData.LastSyncedAt
+}
+
+func (v *EnableSyncingEnableSyncingDataPoolSyncing) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*EnableSyncingEnableSyncingDataPoolSyncing
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.EnableSyncingEnableSyncingDataPoolSyncing = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.DataPoolSyncingData)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalEnableSyncingEnableSyncingDataPoolSyncing struct {
+	Status DataPoolSyncStatus `json:"status"`
+
+	Interval *DataPoolSyncInterval `json:"interval"`
+
+	LastSyncedAt *time.Time `json:"lastSyncedAt"`
+}
+
+func (v *EnableSyncingEnableSyncingDataPoolSyncing) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *EnableSyncingEnableSyncingDataPoolSyncing) __premarshalJSON() (*__premarshalEnableSyncingEnableSyncingDataPoolSyncing, error) {
+	var retval __premarshalEnableSyncingEnableSyncingDataPoolSyncing
+
+	retval.Status = v.DataPoolSyncingData.Status
+	retval.Interval = v.DataPoolSyncingData.Interval
+	retval.LastSyncedAt = v.DataPoolSyncingData.LastSyncedAt
+	return &retval, nil
+}
+
+// EnableSyncingResponse is returned by EnableSyncing on success.
+type EnableSyncingResponse struct {
+	// Re-enables syncing of a Data Pool.
+	EnableSyncing *EnableSyncingEnableSyncingDataPool `json:"enableSyncing"`
+}
+
+// GetEnableSyncing returns EnableSyncingResponse.EnableSyncing, and is useful for accessing the field via an interface.
+func (v *EnableSyncingResponse) GetEnableSyncing() *EnableSyncingEnableSyncingDataPool {
+	return v.EnableSyncing
+}
+
+// EnvironmentData includes the GraphQL fields of Environment requested by the fragment EnvironmentData.
+// The GraphQL type's documentation follows.
+//
+// The Environments object.
+//
+// Environments are independent and isolated Propel workspaces for development, staging (testing), and production workloads. Environments are hosted in a specific region, initially in us-east-2 only.
+type EnvironmentData struct {
+	// The Environment's unique identifier.
+	Id string `json:"id"`
+	// The Environment's unique name.
+	UniqueName *string `json:"uniqueName"`
+	// The Environment's description.
+	Description *string `json:"description"`
+	// The Environment's Account.
+	Account *EnvironmentDataAccount `json:"account"`
+	// The Environment's creation date and time in UTC.
+	CreatedAt *time.Time `json:"createdAt"`
+	// The Environment's last modification date and time in UTC.
+	ModifiedAt *time.Time `json:"modifiedAt"`
+	// The Environment's creator. It can be either a User ID, an Environment ID, or "system" if it was created by Propel.
+	CreatedBy *string `json:"createdBy"`
+	// The Environment's last modifier. It can be either a User ID, an Environment ID, or "system" if it was modified by Propel.
+	ModifiedBy *string `json:"modifiedBy"`
+}
+
+// GetId returns EnvironmentData.Id, and is useful for accessing the field via an interface.
+func (v *EnvironmentData) GetId() string { return v.Id }
+
+// GetUniqueName returns EnvironmentData.UniqueName, and is useful for accessing the field via an interface.
+func (v *EnvironmentData) GetUniqueName() *string { return v.UniqueName }
+
+// GetDescription returns EnvironmentData.Description, and is useful for accessing the field via an interface.
+func (v *EnvironmentData) GetDescription() *string { return v.Description }
+
+// GetAccount returns EnvironmentData.Account, and is useful for accessing the field via an interface.
+func (v *EnvironmentData) GetAccount() *EnvironmentDataAccount { return v.Account }
+
+// GetCreatedAt returns EnvironmentData.CreatedAt, and is useful for accessing the field via an interface.
+func (v *EnvironmentData) GetCreatedAt() *time.Time { return v.CreatedAt }
+
+// GetModifiedAt returns EnvironmentData.ModifiedAt, and is useful for accessing the field via an interface.
+func (v *EnvironmentData) GetModifiedAt() *time.Time { return v.ModifiedAt }
+
+// GetCreatedBy returns EnvironmentData.CreatedBy, and is useful for accessing the field via an interface.
+func (v *EnvironmentData) GetCreatedBy() *string { return v.CreatedBy }
+
+// GetModifiedBy returns EnvironmentData.ModifiedBy, and is useful for accessing the field via an interface.
+func (v *EnvironmentData) GetModifiedBy() *string { return v.ModifiedBy }
+
+// EnvironmentDataAccount includes the requested fields of the GraphQL type Account.
+// The GraphQL type's documentation follows.
+//
+// The Account object.
+type EnvironmentDataAccount struct {
+	// The Account's unique identifier.
+	Id string `json:"id"`
+}
+
+// GetId returns EnvironmentDataAccount.Id, and is useful for accessing the field via an interface.
+func (v *EnvironmentDataAccount) GetId() string { return v.Id }
+
+// EnvironmentEnvironment includes the requested fields of the GraphQL type Environment.
+// The GraphQL type's documentation follows.
+//
+// The Environments object.
+//
+// Environments are independent and isolated Propel workspaces for development, staging (testing), and production workloads. Environments are hosted in a specific region, initially in us-east-2 only.
+type EnvironmentEnvironment struct {
+	EnvironmentData `json:"-"`
+}
+
+// GetId returns EnvironmentEnvironment.Id, and is useful for accessing the field via an interface.
+func (v *EnvironmentEnvironment) GetId() string { return v.EnvironmentData.Id }
+
+// GetUniqueName returns EnvironmentEnvironment.UniqueName, and is useful for accessing the field via an interface.
+func (v *EnvironmentEnvironment) GetUniqueName() *string { return v.EnvironmentData.UniqueName }
+
+// GetDescription returns EnvironmentEnvironment.Description, and is useful for accessing the field via an interface.
+func (v *EnvironmentEnvironment) GetDescription() *string { return v.EnvironmentData.Description }
+
+// GetAccount returns EnvironmentEnvironment.Account, and is useful for accessing the field via an interface.
+func (v *EnvironmentEnvironment) GetAccount() *EnvironmentDataAccount {
+	return v.EnvironmentData.Account
+}
+
+// GetCreatedAt returns EnvironmentEnvironment.CreatedAt, and is useful for accessing the field via an interface.
+func (v *EnvironmentEnvironment) GetCreatedAt() *time.Time { return v.EnvironmentData.CreatedAt }
+
+// GetModifiedAt returns EnvironmentEnvironment.ModifiedAt, and is useful for accessing the field via an interface.
+func (v *EnvironmentEnvironment) GetModifiedAt() *time.Time { return v.EnvironmentData.ModifiedAt }
+
+// GetCreatedBy returns EnvironmentEnvironment.CreatedBy, and is useful for accessing the field via an interface.
+func (v *EnvironmentEnvironment) GetCreatedBy() *string { return v.EnvironmentData.CreatedBy }
+
+// GetModifiedBy returns EnvironmentEnvironment.ModifiedBy, and is useful for accessing the field via an interface.
+func (v *EnvironmentEnvironment) GetModifiedBy() *string { return v.EnvironmentData.ModifiedBy }
+
+func (v *EnvironmentEnvironment) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*EnvironmentEnvironment
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.EnvironmentEnvironment = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.EnvironmentData)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalEnvironmentEnvironment struct {
+	Id string `json:"id"`
+
+	UniqueName *string `json:"uniqueName"`
+
+	Description *string `json:"description"`
+
+	Account *EnvironmentDataAccount `json:"account"`
+
+	CreatedAt *time.Time `json:"createdAt"`
+
+	ModifiedAt *time.Time `json:"modifiedAt"`
+
+	CreatedBy *string `json:"createdBy"`
+
+	ModifiedBy *string `json:"modifiedBy"`
+}
+
+func (v *EnvironmentEnvironment) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *EnvironmentEnvironment) __premarshalJSON() (*__premarshalEnvironmentEnvironment, error) {
+	var retval __premarshalEnvironmentEnvironment
+
+	retval.Id = v.EnvironmentData.Id
+	retval.UniqueName = v.EnvironmentData.UniqueName
+	retval.Description = v.EnvironmentData.Description
+	retval.Account = v.EnvironmentData.Account
+	retval.CreatedAt = v.EnvironmentData.CreatedAt
+	retval.ModifiedAt = v.EnvironmentData.ModifiedAt
+	retval.CreatedBy = v.EnvironmentData.CreatedBy
+	retval.ModifiedBy = v.EnvironmentData.ModifiedBy
+	return &retval, nil
+}
+
+// EnvironmentResponse is returned by Environment on success.
+type EnvironmentResponse struct {
+	// Returns the Environment specified by the given ID.
+	Environment *EnvironmentEnvironment `json:"environment"`
+}
+
+// GetEnvironment returns EnvironmentResponse.Environment, and is useful for accessing the field via an interface.
+func (v *EnvironmentResponse) GetEnvironment() *EnvironmentEnvironment { return v.Environment }
+
 // FilterData includes the GraphQL fields of Filter requested by the fragment FilterData.
 // The GraphQL type's documentation follows.
 //
@@ -11484,22 +14327,79 @@ func (v *FilterData) GetOr() []*FilterDataOrFilter { return v.Or }
 //
 // Note that `and` takes precedence over `or`.
 type FilterDataAndFilter struct {
-	// The name of the column to filter on.
-	Column string `json:"column"`
-	// The operation to perform when comparing the column and filter values.
-	Operator FilterOperator `json:"operator"`
-	// The value to compare the column to.
-	Value *string `json:"value"`
+	NestedFilterData `json:"-"`
 }
 
 // GetColumn returns FilterDataAndFilter.Column, and is useful for accessing the field via an interface.
-func (v *FilterDataAndFilter) GetColumn() string { return v.Column }
+func (v *FilterDataAndFilter) GetColumn() string { return v.NestedFilterData.Column }
 
 // GetOperator returns FilterDataAndFilter.Operator, and is useful for accessing the field via an interface.
-func (v *FilterDataAndFilter) GetOperator() FilterOperator { return v.Operator }
+func (v *FilterDataAndFilter) GetOperator() FilterOperator { return v.NestedFilterData.Operator }
 
 // GetValue returns FilterDataAndFilter.Value, and is useful for accessing the field via an interface.
-func (v *FilterDataAndFilter) GetValue() *string { return v.Value }
+func (v *FilterDataAndFilter) GetValue() *string { return v.NestedFilterData.Value }
+
+// GetAnd returns FilterDataAndFilter.And, and is useful for accessing the field via an interface.
+func (v *FilterDataAndFilter) GetAnd() []*NestedFilterDataAndFilter { return v.NestedFilterData.And }
+
+// GetOr returns FilterDataAndFilter.Or, and is useful for accessing the field via an interface.
+func (v *FilterDataAndFilter) GetOr() []*NestedFilterDataOrFilter { return v.NestedFilterData.Or }
+
+func (v *FilterDataAndFilter) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*FilterDataAndFilter
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.FilterDataAndFilter = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.NestedFilterData)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalFilterDataAndFilter struct {
+	Column string `json:"column"`
+
+	Operator FilterOperator `json:"operator"`
+
+	Value *string `json:"value"`
+
+	And []*NestedFilterDataAndFilter `json:"and"`
+
+	Or []*NestedFilterDataOrFilter `json:"or"`
+}
+
+func (v *FilterDataAndFilter) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *FilterDataAndFilter) __premarshalJSON() (*__premarshalFilterDataAndFilter, error) {
+	var retval __premarshalFilterDataAndFilter
+
+	retval.Column = v.NestedFilterData.Column
+	retval.Operator = v.NestedFilterData.Operator
+	retval.Value = v.NestedFilterData.Value
+	retval.And = v.NestedFilterData.And
+	retval.Or = v.NestedFilterData.Or
+	return &retval, nil
+}
 
 // FilterDataOrFilter includes the requested fields of the GraphQL type Filter.
 // The GraphQL type's documentation follows.
@@ -11534,22 +14434,79 @@ func (v *FilterDataAndFilter) GetValue() *string { return v.Value }
 //
 // Note that `and` takes precedence over `or`.
 type FilterDataOrFilter struct {
-	// The name of the column to filter on.
-	Column string `json:"column"`
-	// The operation to perform when comparing the column and filter values.
-	Operator FilterOperator `json:"operator"`
-	// The value to compare the column to.
-	Value *string `json:"value"`
+	NestedFilterData `json:"-"`
 }
 
 // GetColumn returns FilterDataOrFilter.Column, and is useful for accessing the field via an interface.
-func (v *FilterDataOrFilter) GetColumn() string { return v.Column }
+func (v *FilterDataOrFilter) GetColumn() string { return v.NestedFilterData.Column }
 
 // GetOperator returns FilterDataOrFilter.Operator, and is useful for accessing the field via an interface.
-func (v *FilterDataOrFilter) GetOperator() FilterOperator { return v.Operator }
+func (v *FilterDataOrFilter) GetOperator() FilterOperator { return v.NestedFilterData.Operator }
 
 // GetValue returns FilterDataOrFilter.Value, and is useful for accessing the field via an interface.
-func (v *FilterDataOrFilter) GetValue() *string { return v.Value }
+func (v *FilterDataOrFilter) GetValue() *string { return v.NestedFilterData.Value }
+
+// GetAnd returns FilterDataOrFilter.And, and is useful for accessing the field via an interface.
+func (v *FilterDataOrFilter) GetAnd() []*NestedFilterDataAndFilter { return v.NestedFilterData.And }
+
+// GetOr returns FilterDataOrFilter.Or, and is useful for accessing the field via an interface.
+func (v *FilterDataOrFilter) GetOr() []*NestedFilterDataOrFilter { return v.NestedFilterData.Or }
+
+func (v *FilterDataOrFilter) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*FilterDataOrFilter
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.FilterDataOrFilter = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.NestedFilterData)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalFilterDataOrFilter struct {
+	Column string `json:"column"`
+
+	Operator FilterOperator `json:"operator"`
+
+	Value *string `json:"value"`
+
+	And []*NestedFilterDataAndFilter `json:"and"`
+
+	Or []*NestedFilterDataOrFilter `json:"or"`
+}
+
+func (v *FilterDataOrFilter) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *FilterDataOrFilter) __premarshalJSON() (*__premarshalFilterDataOrFilter, error) {
+	var retval __premarshalFilterDataOrFilter
+
+	retval.Column = v.NestedFilterData.Column
+	retval.Operator = v.NestedFilterData.Operator
+	retval.Value = v.NestedFilterData.Value
+	retval.And = v.NestedFilterData.And
+	retval.Or = v.NestedFilterData.Or
+	return &retval, nil
+}
 
 // The fields of a filter.
 //
@@ -11777,17 +14734,174 @@ func (v *KafkaConnectionSettingsInput) GetTls() *bool { return v.Tls }
 // GetUser returns KafkaConnectionSettingsInput.User, and is useful for accessing the field via an interface.
 func (v *KafkaConnectionSettingsInput) GetUser() string { return v.User }
 
-// MaterializedViewData includes the GraphQL fields of MaterializedView requested by the fragment MaterializedViewData.
-type MaterializedViewData struct {
-	// The Materialized View's unique identifier.
-	Id                         string `json:"id"`
-	CommonDataMaterializedView `json:"-"`
-	// The SQL that the Materialized View executes.
-	Sql string `json:"sql"`
-	// The Materialized View's destination (AKA "target") Data Pool.
-	Destination *MaterializedViewDataDestinationDataPool `json:"destination"`
-	// The Materialized View's source Data Pool.
-	Source *MaterializedViewDataSourceDataPool `json:"source"`
+// MaterializedViewByNameMaterializedView includes the requested fields of the GraphQL type MaterializedView.
+type MaterializedViewByNameMaterializedView struct {
+	MaterializedViewData `json:"-"`
+}
+
+// GetId returns MaterializedViewByNameMaterializedView.Id, and is useful for accessing the field via an interface.
+func (v *MaterializedViewByNameMaterializedView) GetId() string { return v.MaterializedViewData.Id }
+
+// GetSql returns MaterializedViewByNameMaterializedView.Sql, and is useful for accessing the field via an interface.
+func (v *MaterializedViewByNameMaterializedView) GetSql() string { return v.MaterializedViewData.Sql }
+
+// GetDestination returns MaterializedViewByNameMaterializedView.Destination, and is useful for accessing the field via an interface.
+func (v *MaterializedViewByNameMaterializedView) GetDestination() *MaterializedViewDataDestinationDataPool {
+	return v.MaterializedViewData.Destination
+}
+
+// GetSource returns MaterializedViewByNameMaterializedView.Source, and is useful for accessing the field via an interface.
+func (v *MaterializedViewByNameMaterializedView) GetSource() *MaterializedViewDataSourceDataPool {
+	return v.MaterializedViewData.Source
+}
+
+// GetOthers returns MaterializedViewByNameMaterializedView.Others, and is useful for accessing the field via an interface.
+func (v *MaterializedViewByNameMaterializedView) GetOthers() []*MaterializedViewDataOthersDataPool {
+	return v.MaterializedViewData.Others
+}
+
+// GetUniqueName returns MaterializedViewByNameMaterializedView.UniqueName, and is useful for accessing the field via an interface.
+func (v *MaterializedViewByNameMaterializedView) GetUniqueName() string {
+	return v.MaterializedViewData.CommonDataMaterializedView.UniqueName
+}
+
+// GetDescription returns MaterializedViewByNameMaterializedView.Description, and is useful for accessing the field via an interface.
+func (v *MaterializedViewByNameMaterializedView) GetDescription() string {
+	return v.MaterializedViewData.CommonDataMaterializedView.Description
+}
+
+// GetAccount returns MaterializedViewByNameMaterializedView.Account, and is useful for accessing the field via an interface.
+func (v *MaterializedViewByNameMaterializedView) GetAccount() *CommonDataAccount {
+	return v.MaterializedViewData.CommonDataMaterializedView.Account
+}
+
+// GetEnvironment returns MaterializedViewByNameMaterializedView.Environment, and is useful for accessing the field via an interface.
+func (v *MaterializedViewByNameMaterializedView) GetEnvironment() *CommonDataEnvironment {
+	return v.MaterializedViewData.CommonDataMaterializedView.Environment
+}
+
+// GetCreatedAt returns MaterializedViewByNameMaterializedView.CreatedAt, and is useful for accessing the field via an interface.
+func (v *MaterializedViewByNameMaterializedView) GetCreatedAt() time.Time {
+	return v.MaterializedViewData.CommonDataMaterializedView.CreatedAt
+}
+
+// GetModifiedAt returns MaterializedViewByNameMaterializedView.ModifiedAt, and is useful for accessing the field via an interface.
+func (v *MaterializedViewByNameMaterializedView) GetModifiedAt() time.Time {
+	return v.MaterializedViewData.CommonDataMaterializedView.ModifiedAt
+}
+
+// GetCreatedBy returns MaterializedViewByNameMaterializedView.CreatedBy, and is useful for accessing the field via an interface.
+func (v *MaterializedViewByNameMaterializedView) GetCreatedBy() string {
+	return v.MaterializedViewData.CommonDataMaterializedView.CreatedBy
+}
+
+// GetModifiedBy returns MaterializedViewByNameMaterializedView.ModifiedBy, and is useful for accessing the field via an interface.
+func (v *MaterializedViewByNameMaterializedView) GetModifiedBy() string {
+	return v.MaterializedViewData.CommonDataMaterializedView.ModifiedBy
+}
+
+func (v *MaterializedViewByNameMaterializedView) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*MaterializedViewByNameMaterializedView
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.MaterializedViewByNameMaterializedView = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.MaterializedViewData)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalMaterializedViewByNameMaterializedView struct {
+	Id string `json:"id"`
+
+	Sql string `json:"sql"`
+
+	Destination *MaterializedViewDataDestinationDataPool `json:"destination"`
+
+	Source *MaterializedViewDataSourceDataPool `json:"source"`
+
+	Others []*MaterializedViewDataOthersDataPool `json:"others"`
+
+	UniqueName string `json:"uniqueName"`
+
+	Description string `json:"description"`
+
+	Account *CommonDataAccount `json:"account"`
+
+	Environment *CommonDataEnvironment `json:"environment"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	ModifiedAt time.Time `json:"modifiedAt"`
+
+	CreatedBy string `json:"createdBy"`
+
+	ModifiedBy string `json:"modifiedBy"`
+}
+
+func (v *MaterializedViewByNameMaterializedView) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *MaterializedViewByNameMaterializedView) __premarshalJSON() (*__premarshalMaterializedViewByNameMaterializedView, error) {
+	var retval __premarshalMaterializedViewByNameMaterializedView
+
+	retval.Id = v.MaterializedViewData.Id
+	retval.Sql = v.MaterializedViewData.Sql
+	retval.Destination = v.MaterializedViewData.Destination
+	retval.Source = v.MaterializedViewData.Source
+	retval.Others = v.MaterializedViewData.Others
+	retval.UniqueName = v.MaterializedViewData.CommonDataMaterializedView.UniqueName
+	retval.Description = v.MaterializedViewData.CommonDataMaterializedView.Description
+	retval.Account = v.MaterializedViewData.CommonDataMaterializedView.Account
+	retval.Environment = v.MaterializedViewData.CommonDataMaterializedView.Environment
+	retval.CreatedAt = v.MaterializedViewData.CommonDataMaterializedView.CreatedAt
+	retval.ModifiedAt = v.MaterializedViewData.CommonDataMaterializedView.ModifiedAt
+	retval.CreatedBy = v.MaterializedViewData.CommonDataMaterializedView.CreatedBy
+	retval.ModifiedBy = v.MaterializedViewData.CommonDataMaterializedView.ModifiedBy
+	return &retval, nil
+}
+
+// MaterializedViewByNameResponse is returned by MaterializedViewByName on success.
+type MaterializedViewByNameResponse struct {
+	// Returns the Materialized View specified by its unique name.
+	MaterializedView *MaterializedViewByNameMaterializedView `json:"materializedView"`
+}
+
+// GetMaterializedView returns MaterializedViewByNameResponse.MaterializedView, and is useful for accessing the field via an interface.
+func (v *MaterializedViewByNameResponse) GetMaterializedView() *MaterializedViewByNameMaterializedView {
+	return v.MaterializedView
+}
+
+// MaterializedViewData includes the GraphQL fields of MaterializedView requested by the fragment MaterializedViewData.
+type MaterializedViewData struct {
+	// The Materialized View's unique identifier.
+	Id                         string `json:"id"`
+	CommonDataMaterializedView `json:"-"`
+	// The SQL that the Materialized View executes.
+	Sql string `json:"sql"`
+	// The Materialized View's destination (AKA "target") Data Pool.
+	Destination *MaterializedViewDataDestinationDataPool `json:"destination"`
+	// The Materialized View's source Data Pool.
+	Source *MaterializedViewDataSourceDataPool `json:"source"`
 	// Other Data Pools queried by the Materialized View.
 	Others []*MaterializedViewDataOthersDataPool `json:"others"`
 }
@@ -12116,6 +15230,277 @@ func (v *MaterializedViewResponse) GetMaterializedView() *MaterializedViewMateri
 	return v.MaterializedView
 }
 
+// MaterializedViewsMaterializedViewsMaterializedViewConnection includes the requested fields of the GraphQL type MaterializedViewConnection.
+// The GraphQL type's documentation follows.
+//
+// The Materialized View connection object.
+//
+// Learn more about [pagination in GraphQL](https://www.propeldata.com/docs/api/pagination).
+type MaterializedViewsMaterializedViewsMaterializedViewConnection struct {
+	// The Materialized View connection's page info.
+	PageInfo *MaterializedViewsMaterializedViewsMaterializedViewConnectionPageInfo `json:"pageInfo"`
+	// The Materialized View connection's nodes.
+	Nodes []*MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView `json:"nodes"`
+}
+
+// GetPageInfo returns MaterializedViewsMaterializedViewsMaterializedViewConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *MaterializedViewsMaterializedViewsMaterializedViewConnection) GetPageInfo() *MaterializedViewsMaterializedViewsMaterializedViewConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetNodes returns MaterializedViewsMaterializedViewsMaterializedViewConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *MaterializedViewsMaterializedViewsMaterializedViewConnection) GetNodes() []*MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView {
+	return v.Nodes
+}
+
+// MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView includes the requested fields of the GraphQL type MaterializedView.
+type MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView struct {
+	MaterializedViewData `json:"-"`
+}
+
+// GetId returns MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView.Id, and is useful for accessing the field via an interface.
+func (v *MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView) GetId() string {
+	return v.MaterializedViewData.Id
+}
+
+// GetSql returns MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView.Sql, and is useful for accessing the field via an interface.
+func (v *MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView) GetSql() string {
+	return v.MaterializedViewData.Sql
+}
+
+// GetDestination returns MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView.Destination, and is useful for accessing the field via an interface.
+func (v *MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView) GetDestination() *MaterializedViewDataDestinationDataPool {
+	return v.MaterializedViewData.Destination
+}
+
+// GetSource returns MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView.Source, and is useful for accessing the field via an interface.
+func (v *MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView) GetSource() *MaterializedViewDataSourceDataPool {
+	return v.MaterializedViewData.Source
+}
+
+// GetOthers returns MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView.Others, and is useful for accessing the field via an interface.
+func (v *MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView) GetOthers() []*MaterializedViewDataOthersDataPool {
+	return v.MaterializedViewData.Others
+}
+
+// GetUniqueName returns MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView.UniqueName, and is useful for accessing the field via an interface.
+func (v *MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView) GetUniqueName() string {
+	return v.MaterializedViewData.CommonDataMaterializedView.UniqueName
+}
+
+// GetDescription returns MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView.Description, and is useful for accessing the field via an interface.
+func (v *MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView) GetDescription() string {
+	return v.MaterializedViewData.CommonDataMaterializedView.Description
+}
+
+// GetAccount returns MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView.Account, and is useful for accessing the field via an interface.
+func (v *MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView) GetAccount() *CommonDataAccount {
+	return v.MaterializedViewData.CommonDataMaterializedView.Account
+}
+
+// GetEnvironment returns MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView.Environment, and is useful for accessing the field via an interface.
+func (v *MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView) GetEnvironment() *CommonDataEnvironment {
+	return v.MaterializedViewData.CommonDataMaterializedView.Environment
+}
+
+// GetCreatedAt returns MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView.CreatedAt, and is useful for accessing the field via an interface.
+func (v *MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView) GetCreatedAt() time.Time {
+	return v.MaterializedViewData.CommonDataMaterializedView.CreatedAt
+}
+
+// GetModifiedAt returns MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView.ModifiedAt, and is useful for accessing the field via an interface.
+func (v *MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView) GetModifiedAt() time.Time {
+	return v.MaterializedViewData.CommonDataMaterializedView.ModifiedAt
+}
+
+// GetCreatedBy returns MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView.CreatedBy, and is useful for accessing the field via an interface.
+func (v *MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView) GetCreatedBy() string {
+	return v.MaterializedViewData.CommonDataMaterializedView.CreatedBy
+}
+
+// GetModifiedBy returns MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView.ModifiedBy, and is useful for accessing the field via an interface.
+func (v *MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView) GetModifiedBy() string {
+	return v.MaterializedViewData.CommonDataMaterializedView.ModifiedBy
+}
+
+func (v *MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.MaterializedViewData)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalMaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView struct {
+	Id string `json:"id"`
+
+	Sql string `json:"sql"`
+
+	Destination *MaterializedViewDataDestinationDataPool `json:"destination"`
+
+	Source *MaterializedViewDataSourceDataPool `json:"source"`
+
+	Others []*MaterializedViewDataOthersDataPool `json:"others"`
+
+	UniqueName string `json:"uniqueName"`
+
+	Description string `json:"description"`
+
+	Account *CommonDataAccount `json:"account"`
+
+	Environment *CommonDataEnvironment `json:"environment"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	ModifiedAt time.Time `json:"modifiedAt"`
+
+	CreatedBy string `json:"createdBy"`
+
+	ModifiedBy string `json:"modifiedBy"`
+}
+
+func (v *MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *MaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView) __premarshalJSON() (*__premarshalMaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView, error) {
+	var retval __premarshalMaterializedViewsMaterializedViewsMaterializedViewConnectionNodesMaterializedView
+
+	retval.Id = v.MaterializedViewData.Id
+	retval.Sql = v.MaterializedViewData.Sql
+	retval.Destination = v.MaterializedViewData.Destination
+	retval.Source = v.MaterializedViewData.Source
+	retval.Others = v.MaterializedViewData.Others
+	retval.UniqueName = v.MaterializedViewData.CommonDataMaterializedView.UniqueName
+	retval.Description = v.MaterializedViewData.CommonDataMaterializedView.Description
+	retval.Account = v.MaterializedViewData.CommonDataMaterializedView.Account
+	retval.Environment = v.MaterializedViewData.CommonDataMaterializedView.Environment
+	retval.CreatedAt = v.MaterializedViewData.CommonDataMaterializedView.CreatedAt
+	retval.ModifiedAt = v.MaterializedViewData.CommonDataMaterializedView.ModifiedAt
+	retval.CreatedBy = v.MaterializedViewData.CommonDataMaterializedView.CreatedBy
+	retval.ModifiedBy = v.MaterializedViewData.CommonDataMaterializedView.ModifiedBy
+	return &retval, nil
+}
+
+// MaterializedViewsMaterializedViewsMaterializedViewConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// The page info object used for pagination.
+type MaterializedViewsMaterializedViewsMaterializedViewConnectionPageInfo struct {
+	PageInfoData `json:"-"`
+}
+
+// GetStartCursor returns MaterializedViewsMaterializedViewsMaterializedViewConnectionPageInfo.StartCursor, and is useful for accessing the field via an interface.
+func (v *MaterializedViewsMaterializedViewsMaterializedViewConnectionPageInfo) GetStartCursor() *string {
+	return v.PageInfoData.StartCursor
+}
+
+// GetEndCursor returns MaterializedViewsMaterializedViewsMaterializedViewConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *MaterializedViewsMaterializedViewsMaterializedViewConnectionPageInfo) GetEndCursor() *string {
+	return v.PageInfoData.EndCursor
+}
+
+// GetHasNextPage returns MaterializedViewsMaterializedViewsMaterializedViewConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *MaterializedViewsMaterializedViewsMaterializedViewConnectionPageInfo) GetHasNextPage() bool {
+	return v.PageInfoData.HasNextPage
+}
+
+// GetHasPreviousPage returns MaterializedViewsMaterializedViewsMaterializedViewConnectionPageInfo.HasPreviousPage, and is useful for accessing the field via an interface.
+func (v *MaterializedViewsMaterializedViewsMaterializedViewConnectionPageInfo) GetHasPreviousPage() bool {
+	return v.PageInfoData.HasPreviousPage
+}
+
+func (v *MaterializedViewsMaterializedViewsMaterializedViewConnectionPageInfo) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*MaterializedViewsMaterializedViewsMaterializedViewConnectionPageInfo
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.MaterializedViewsMaterializedViewsMaterializedViewConnectionPageInfo = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PageInfoData)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalMaterializedViewsMaterializedViewsMaterializedViewConnectionPageInfo struct {
+	StartCursor *string `json:"startCursor"`
+
+	EndCursor *string `json:"endCursor"`
+
+	HasNextPage bool `json:"hasNextPage"`
+
+	HasPreviousPage bool `json:"hasPreviousPage"`
+}
+
+func (v *MaterializedViewsMaterializedViewsMaterializedViewConnectionPageInfo) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *MaterializedViewsMaterializedViewsMaterializedViewConnectionPageInfo) __premarshalJSON() (*__premarshalMaterializedViewsMaterializedViewsMaterializedViewConnectionPageInfo, error) {
+	var retval __premarshalMaterializedViewsMaterializedViewsMaterializedViewConnectionPageInfo
+
+	retval.StartCursor = v.PageInfoData.StartCursor
+	retval.EndCursor = v.PageInfoData.EndCursor
+	retval.HasNextPage = v.PageInfoData.HasNextPage
+	retval.HasPreviousPage = v.PageInfoData.HasPreviousPage
+	return &retval, nil
+}
+
+// MaterializedViewsResponse is returned by MaterializedViews on success.
+type MaterializedViewsResponse struct {
+	// Returns the Materialized Views within the Environment.
+	//
+	// The `materializedViews` query uses cursor-based pagination typical of GraphQL APIs. You can use the pairs of parameters `first` and `after` or `last` and `before` to page forward or backward through the results, respectively.
+	//
+	// For forward pagination, the `first` parameter defines the number of results to return, and the `after` parameter defines the cursor to continue from. You should pass the cursor for the _last_ result of the current page to `after`.
+	//
+	// For backward pagination, the `last` parameter defines the number of results to return, and the `before` parameter defines the cursor to continue from. You should pass the cursor for the _first_ result of the current page to `before`.
+	MaterializedViews *MaterializedViewsMaterializedViewsMaterializedViewConnection `json:"materializedViews"`
+}
+
+// GetMaterializedViews returns MaterializedViewsResponse.MaterializedViews, and is useful for accessing the field via an interface.
+func (v *MaterializedViewsResponse) GetMaterializedViews() *MaterializedViewsMaterializedViewsMaterializedViewConnection {
+	return v.MaterializedViews
+}
+
 // Parameters for the MergeTree table engine.
 type MergeTreeTableEngineInput struct {
 	// The type is always `MERGE_TREE`.
@@ -12521,6 +15906,9 @@ func (v *MetricDataDataPool) GetError() *DataPoolDataError { return v.DataPoolDa
 // GetTable returns MetricDataDataPool.Table, and is useful for accessing the field via an interface.
 func (v *MetricDataDataPool) GetTable() string { return v.DataPoolData.Table }
 
+// GetRecordCount returns MetricDataDataPool.RecordCount, and is useful for accessing the field via an interface.
+func (v *MetricDataDataPool) GetRecordCount() *string { return v.DataPoolData.RecordCount }
+
 // GetTenant returns MetricDataDataPool.Tenant, and is useful for accessing the field via an interface.
 func (v *MetricDataDataPool) GetTenant() *DataPoolDataTenant { return v.DataPoolData.Tenant }
 
@@ -12641,6 +16029,8 @@ type __premarshalMetricDataDataPool struct {
 
 	Table string `json:"table"`
 
+	RecordCount *string `json:"recordCount"`
+
 	Tenant *DataPoolDataTenant `json:"tenant"`
 
 	Timestamp *DataPoolDataTimestamp `json:"timestamp"`
@@ -12694,6 +16084,7 @@ func (v *MetricDataDataPool) __premarshalJSON() (*__premarshalMetricDataDataPool
 	retval.Status = v.DataPoolData.Status
 	retval.Error = v.DataPoolData.Error
 	retval.Table = v.DataPoolData.Table
+	retval.RecordCount = v.DataPoolData.RecordCount
 	retval.Tenant = v.DataPoolData.Tenant
 	retval.Timestamp = v.DataPoolData.Timestamp
 	retval.Columns = v.DataPoolData.Columns
@@ -12869,6 +16260,10 @@ type MetricDataSettingsAverageMetricSettings struct {
 	Typename *string `json:"__typename"`
 	// Metric Filters allow defining a Metric with a subset of records from the given Data Pool. If no Metric Filters are present, all records will be included. To filter at query time, add Dimensions and use the `filters` property on the `timeSeriesInput`, `counterInput`, or `leaderboardInput` objects. There is no need to add `filters` to be able to filter at query time.
 	Filters []*MetricDataSettingsAverageMetricSettingsFiltersFilter `json:"filters"`
+	// Metric Filters allow defining a Metric with a subset of records from the given Data Pool. If no Metric Filters are present, all records will be included. To filter at query time, add Dimensions and use the `filters` property on the `timeSeriesInput`, `counterInput`, or `leaderboardInput` objects. There is no need to add `filters` to be able to filter at query time.
+	//
+	// You can provide the filters in the form of SQL.
+	FilterSql *string `json:"filterSql"`
 	// The Dimension to be averaged.
 	Measure *MetricDataSettingsAverageMetricSettingsMeasureDimension `json:"measure"`
 }
@@ -12881,6 +16276,9 @@ func (v *MetricDataSettingsAverageMetricSettings) GetFilters() []*MetricDataSett
 	return v.Filters
 }
 
+// GetFilterSql returns MetricDataSettingsAverageMetricSettings.FilterSql, and is useful for accessing the field via an interface.
+func (v *MetricDataSettingsAverageMetricSettings) GetFilterSql() *string { return v.FilterSql }
+
 // GetMeasure returns MetricDataSettingsAverageMetricSettings.Measure, and is useful for accessing the field via an interface.
 func (v *MetricDataSettingsAverageMetricSettings) GetMeasure() *MetricDataSettingsAverageMetricSettingsMeasureDimension {
 	return v.Measure
@@ -13092,6 +16490,10 @@ type MetricDataSettingsCountDistinctMetricSettings struct {
 	Typename *string `json:"__typename"`
 	// Metric Filters allow defining a Metric with a subset of records from the given Data Pool. If no Metric Filters are present, all records will be included. To filter at query time, add Dimensions and use the `filters` property on the `timeSeriesInput`, `counterInput`, or `leaderboardInput` objects. There is no need to add `filters` to be able to filter at query time.
 	Filters []*MetricDataSettingsCountDistinctMetricSettingsFiltersFilter `json:"filters"`
+	// Metric Filters allow defining a Metric with a subset of records from the given Data Pool. If no Metric Filters are present, all records will be included. To filter at query time, add Dimensions and use the `filters` property on the `timeSeriesInput`, `counterInput`, or `leaderboardInput` objects. There is no need to add `filters` to be able to filter at query time.
+	//
+	// You can provide the filters in the form of SQL.
+	FilterSql *string `json:"filterSql"`
 	// The Dimension where the count distinct operation is going to be performed.
 	Dimension *MetricDataSettingsCountDistinctMetricSettingsDimension `json:"dimension"`
 }
@@ -13104,6 +16506,9 @@ func (v *MetricDataSettingsCountDistinctMetricSettings) GetFilters() []*MetricDa
 	return v.Filters
 }
 
+// GetFilterSql returns MetricDataSettingsCountDistinctMetricSettings.FilterSql, and is useful for accessing the field via an interface.
+func (v *MetricDataSettingsCountDistinctMetricSettings) GetFilterSql() *string { return v.FilterSql }
+
 // GetDimension returns MetricDataSettingsCountDistinctMetricSettings.Dimension, and is useful for accessing the field via an interface.
 func (v *MetricDataSettingsCountDistinctMetricSettings) GetDimension() *MetricDataSettingsCountDistinctMetricSettingsDimension {
 	return v.Dimension
@@ -13315,6 +16720,10 @@ type MetricDataSettingsCountMetricSettings struct {
 	Typename *string `json:"__typename"`
 	// Metric Filters allow defining a Metric with a subset of records from the given Data Pool. If no Metric Filters are present, all records will be included. To filter at query time, add Dimensions and use the `filters` property on the `timeSeriesInput`, `counterInput`, or `leaderboardInput` objects. There is no need to add `filters` to be able to filter at query time.
 	Filters []*MetricDataSettingsCountMetricSettingsFiltersFilter `json:"filters"`
+	// Metric Filters allow defining a Metric with a subset of records from the given Data Pool. If no Metric Filters are present, all records will be included. To filter at query time, add Dimensions and use the `filters` property on the `timeSeriesInput`, `counterInput`, or `leaderboardInput` objects. There is no need to add `filters` to be able to filter at query time.
+	//
+	// You can provide the filters in the form of SQL.
+	FilterSql *string `json:"filterSql"`
 }
 
 // GetTypename returns MetricDataSettingsCountMetricSettings.Typename, and is useful for accessing the field via an interface.
@@ -13325,6 +16734,9 @@ func (v *MetricDataSettingsCountMetricSettings) GetFilters() []*MetricDataSettin
 	return v.Filters
 }
 
+// GetFilterSql returns MetricDataSettingsCountMetricSettings.FilterSql, and is useful for accessing the field via an interface.
+func (v *MetricDataSettingsCountMetricSettings) GetFilterSql() *string { return v.FilterSql }
+
 // MetricDataSettingsCountMetricSettingsFiltersFilter includes the requested fields of the GraphQL type Filter.
 // The GraphQL type's documentation follows.
 //
@@ -13450,6 +16862,10 @@ type MetricDataSettingsCustomMetricSettings struct {
 	Typename *string `json:"__typename"`
 	// Metric Filters allow defining a Metric with a subset of records from the given Data Pool. If no Metric Filters are present, all records will be included. To filter at query time, add Dimensions and use the `filters` property on the `timeSeriesInput`, `counterInput`, or `leaderboardInput` objects. There is no need to add `filters` to be able to filter at query time.
 	Filters []*MetricDataSettingsCustomMetricSettingsFiltersFilter `json:"filters"`
+	// Metric Filters allow defining a Metric with a subset of records from the given Data Pool. If no Metric Filters are present, all records will be included. To filter at query time, add Dimensions and use the `filters` property on the `timeSeriesInput`, `counterInput`, or `leaderboardInput` objects. There is no need to add `filters` to be able to filter at query time.
+	//
+	// You can provide the filters in the form of SQL.
+	FilterSql *string `json:"filterSql"`
 	// The expression that defines the aggregation function for this Metric.
 	Expression string `json:"expression"`
 }
@@ -13462,6 +16878,9 @@ func (v *MetricDataSettingsCustomMetricSettings) GetFilters() []*MetricDataSetti
 	return v.Filters
 }
 
+// GetFilterSql returns MetricDataSettingsCustomMetricSettings.FilterSql, and is useful for accessing the field via an interface.
+func (v *MetricDataSettingsCustomMetricSettings) GetFilterSql() *string { return v.FilterSql }
+
 // GetExpression returns MetricDataSettingsCustomMetricSettings.Expression, and is useful for accessing the field via an interface.
 func (v *MetricDataSettingsCustomMetricSettings) GetExpression() string { return v.Expression }
 
@@ -13590,6 +17009,10 @@ type MetricDataSettingsMaxMetricSettings struct {
 	Typename *string `json:"__typename"`
 	// Metric Filters allow defining a Metric with a subset of records from the given Data Pool. If no Metric Filters are present, all records will be included. To filter at query time, add Dimensions and use the `filters` property on the `timeSeriesInput`, `counterInput`, or `leaderboardInput` objects. There is no need to add `filters` to be able to filter at query time.
 	Filters []*MetricDataSettingsMaxMetricSettingsFiltersFilter `json:"filters"`
+	// Metric Filters allow defining a Metric with a subset of records from the given Data Pool. If no Metric Filters are present, all records will be included. To filter at query time, add Dimensions and use the `filters` property on the `timeSeriesInput`, `counterInput`, or `leaderboardInput` objects. There is no need to add `filters` to be able to filter at query time.
+	//
+	// You can provide the filters in the form of SQL.
+	FilterSql *string `json:"filterSql"`
 	// The Dimension to select the maximum from.
 	Measure *MetricDataSettingsMaxMetricSettingsMeasureDimension `json:"measure"`
 }
@@ -13602,6 +17025,9 @@ func (v *MetricDataSettingsMaxMetricSettings) GetFilters() []*MetricDataSettings
 	return v.Filters
 }
 
+// GetFilterSql returns MetricDataSettingsMaxMetricSettings.FilterSql, and is useful for accessing the field via an interface.
+func (v *MetricDataSettingsMaxMetricSettings) GetFilterSql() *string { return v.FilterSql }
+
 // GetMeasure returns MetricDataSettingsMaxMetricSettings.Measure, and is useful for accessing the field via an interface.
 func (v *MetricDataSettingsMaxMetricSettings) GetMeasure() *MetricDataSettingsMaxMetricSettingsMeasureDimension {
 	return v.Measure
@@ -13959,6 +17385,10 @@ type MetricDataSettingsMinMetricSettings struct {
 	Typename *string `json:"__typename"`
 	// Metric Filters allow defining a Metric with a subset of records from the given Data Pool. If no Metric Filters are present, all records will be included. To filter at query time, add Dimensions and use the `filters` property on the `timeSeriesInput`, `counterInput`, or `leaderboardInput` objects. There is no need to add `filters` to be able to filter at query time.
 	Filters []*MetricDataSettingsMinMetricSettingsFiltersFilter `json:"filters"`
+	// Metric Filters allow defining a Metric with a subset of records from the given Data Pool. If no Metric Filters are present, all records will be included. To filter at query time, add Dimensions and use the `filters` property on the `timeSeriesInput`, `counterInput`, or `leaderboardInput` objects. There is no need to add `filters` to be able to filter at query time.
+	//
+	// You can provide the filters in the form of SQL.
+	FilterSql *string `json:"filterSql"`
 	// The Dimension to select the minimum from.
 	Measure *MetricDataSettingsMinMetricSettingsMeasureDimension `json:"measure"`
 }
@@ -13971,6 +17401,9 @@ func (v *MetricDataSettingsMinMetricSettings) GetFilters() []*MetricDataSettings
 	return v.Filters
 }
 
+// GetFilterSql returns MetricDataSettingsMinMetricSettings.FilterSql, and is useful for accessing the field via an interface.
+func (v *MetricDataSettingsMinMetricSettings) GetFilterSql() *string { return v.FilterSql }
+
 // GetMeasure returns MetricDataSettingsMinMetricSettings.Measure, and is useful for accessing the field via an interface.
 func (v *MetricDataSettingsMinMetricSettings) GetMeasure() *MetricDataSettingsMinMetricSettingsMeasureDimension {
 	return v.Measure
@@ -14182,6 +17615,10 @@ type MetricDataSettingsSumMetricSettings struct {
 	Typename *string `json:"__typename"`
 	// Metric Filters allow defining a Metric with a subset of records from the given Data Pool. If no Metric Filters are present, all records will be included. To filter at query time, add Dimensions and use the `filters` property on the `timeSeriesInput`, `counterInput`, or `leaderboardInput` objects. There is no need to add `filters` to be able to filter at query time.
 	Filters []*MetricDataSettingsSumMetricSettingsFiltersFilter `json:"filters"`
+	// Metric Filters allow defining a Metric with a subset of records from the given Data Pool. If no Metric Filters are present, all records will be included. To filter at query time, add Dimensions and use the `filters` property on the `timeSeriesInput`, `counterInput`, or `leaderboardInput` objects. There is no need to add `filters` to be able to filter at query time.
+	//
+	// You can provide the filters in the form of SQL.
+	FilterSql *string `json:"filterSql"`
 	// The Dimension to be summed.
 	Measure *MetricDataSettingsSumMetricSettingsMeasureDimension `json:"measure"`
 }
@@ -14194,6 +17631,9 @@ func (v *MetricDataSettingsSumMetricSettings) GetFilters() []*MetricDataSettings
 	return v.Filters
 }
 
+// GetFilterSql returns MetricDataSettingsSumMetricSettings.FilterSql, and is useful for accessing the field via an interface.
+func (v *MetricDataSettingsSumMetricSettings) GetFilterSql() *string { return v.FilterSql }
+
 // GetMeasure returns MetricDataSettingsSumMetricSettings.Measure, and is useful for accessing the field via an interface.
 func (v *MetricDataSettingsSumMetricSettings) GetMeasure() *MetricDataSettingsSumMetricSettingsMeasureDimension {
 	return v.Measure
@@ -15188,6 +18628,215 @@ type MetricsResponse struct {
 // GetMetrics returns MetricsResponse.Metrics, and is useful for accessing the field via an interface.
 func (v *MetricsResponse) GetMetrics() *MetricsMetricsMetricConnection { return v.Metrics }
 
+// The fields for migrating a Metric's Data Pool.
+type MigrateMetricInput struct {
+	// The Metric that is going to be migrated.
+	MetricId string `json:"metricId"`
+	// The DataPool to which the Metric is going to be migrated.
+	NewDataPoolId string `json:"newDataPoolId"`
+}
+
+// GetMetricId returns MigrateMetricInput.MetricId, and is useful for accessing the field via an interface.
+func (v *MigrateMetricInput) GetMetricId() string { return v.MetricId }
+
+// GetNewDataPoolId returns MigrateMetricInput.NewDataPoolId, and is useful for accessing the field via an interface.
+func (v *MigrateMetricInput) GetNewDataPoolId() string { return v.NewDataPoolId }
+
+// MigrateMetricMigrateMetric includes the requested fields of the GraphQL type Metric.
+// The GraphQL type's documentation follows.
+//
+// The Metric object.
+//
+// A Metric is a business indicator measured over time.
+type MigrateMetricMigrateMetric struct {
+	MetricData `json:"-"`
+}
+
+// GetId returns MigrateMetricMigrateMetric.Id, and is useful for accessing the field via an interface.
+func (v *MigrateMetricMigrateMetric) GetId() string { return v.MetricData.Id }
+
+// GetType returns MigrateMetricMigrateMetric.Type, and is useful for accessing the field via an interface.
+func (v *MigrateMetricMigrateMetric) GetType() MetricType { return v.MetricData.Type }
+
+// GetAccessControlEnabled returns MigrateMetricMigrateMetric.AccessControlEnabled, and is useful for accessing the field via an interface.
+func (v *MigrateMetricMigrateMetric) GetAccessControlEnabled() bool {
+	return v.MetricData.AccessControlEnabled
+}
+
+// GetDataPool returns MigrateMetricMigrateMetric.DataPool, and is useful for accessing the field via an interface.
+func (v *MigrateMetricMigrateMetric) GetDataPool() *MetricDataDataPool { return v.MetricData.DataPool }
+
+// GetDimensions returns MigrateMetricMigrateMetric.Dimensions, and is useful for accessing the field via an interface.
+func (v *MigrateMetricMigrateMetric) GetDimensions() []*MetricDataDimensionsDimension {
+	return v.MetricData.Dimensions
+}
+
+// GetTimestamp returns MigrateMetricMigrateMetric.Timestamp, and is useful for accessing the field via an interface.
+func (v *MigrateMetricMigrateMetric) GetTimestamp() *MetricDataTimestampDimension {
+	return v.MetricData.Timestamp
+}
+
+// GetMeasure returns MigrateMetricMigrateMetric.Measure, and is useful for accessing the field via an interface.
+func (v *MigrateMetricMigrateMetric) GetMeasure() *MetricDataMeasureDimension {
+	return v.MetricData.Measure
+}
+
+// GetSettings returns MigrateMetricMigrateMetric.Settings, and is useful for accessing the field via an interface.
+func (v *MigrateMetricMigrateMetric) GetSettings() MetricDataSettingsMetricSettings {
+	return v.MetricData.Settings
+}
+
+// GetUniqueName returns MigrateMetricMigrateMetric.UniqueName, and is useful for accessing the field via an interface.
+func (v *MigrateMetricMigrateMetric) GetUniqueName() string {
+	return v.MetricData.CommonDataMetric.UniqueName
+}
+
+// GetDescription returns MigrateMetricMigrateMetric.Description, and is useful for accessing the field via an interface.
+func (v *MigrateMetricMigrateMetric) GetDescription() string {
+	return v.MetricData.CommonDataMetric.Description
+}
+
+// GetAccount returns MigrateMetricMigrateMetric.Account, and is useful for accessing the field via an interface.
+func (v *MigrateMetricMigrateMetric) GetAccount() *CommonDataAccount {
+	return v.MetricData.CommonDataMetric.Account
+}
+
+// GetEnvironment returns MigrateMetricMigrateMetric.Environment, and is useful for accessing the field via an interface.
+func (v *MigrateMetricMigrateMetric) GetEnvironment() *CommonDataEnvironment {
+	return v.MetricData.CommonDataMetric.Environment
+}
+
+// GetCreatedAt returns MigrateMetricMigrateMetric.CreatedAt, and is useful for accessing the field via an interface.
+func (v *MigrateMetricMigrateMetric) GetCreatedAt() time.Time {
+	return v.MetricData.CommonDataMetric.CreatedAt
+}
+
+// GetModifiedAt returns MigrateMetricMigrateMetric.ModifiedAt, and is useful for accessing the field via an interface.
+func (v *MigrateMetricMigrateMetric) GetModifiedAt() time.Time {
+	return v.MetricData.CommonDataMetric.ModifiedAt
+}
+
+// GetCreatedBy returns MigrateMetricMigrateMetric.CreatedBy, and is useful for accessing the field via an interface.
+func (v *MigrateMetricMigrateMetric) GetCreatedBy() string {
+	return v.MetricData.CommonDataMetric.CreatedBy
+}
+
+// GetModifiedBy returns MigrateMetricMigrateMetric.ModifiedBy, and is useful for accessing the field via an interface.
+func (v *MigrateMetricMigrateMetric) GetModifiedBy() string {
+	return v.MetricData.CommonDataMetric.ModifiedBy
+}
+
+func (v *MigrateMetricMigrateMetric) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*MigrateMetricMigrateMetric
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.MigrateMetricMigrateMetric = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.MetricData)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalMigrateMetricMigrateMetric struct {
+	Id string `json:"id"`
+
+	Type MetricType `json:"type"`
+
+	AccessControlEnabled bool `json:"accessControlEnabled"`
+
+	DataPool *MetricDataDataPool `json:"dataPool"`
+
+	Dimensions []*MetricDataDimensionsDimension `json:"dimensions"`
+
+	Timestamp *MetricDataTimestampDimension `json:"timestamp"`
+
+	Measure *MetricDataMeasureDimension `json:"measure"`
+
+	Settings json.RawMessage `json:"settings"`
+
+	UniqueName string `json:"uniqueName"`
+
+	Description string `json:"description"`
+
+	Account *CommonDataAccount `json:"account"`
+
+	Environment *CommonDataEnvironment `json:"environment"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	ModifiedAt time.Time `json:"modifiedAt"`
+
+	CreatedBy string `json:"createdBy"`
+
+	ModifiedBy string `json:"modifiedBy"`
+}
+
+func (v *MigrateMetricMigrateMetric) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *MigrateMetricMigrateMetric) __premarshalJSON() (*__premarshalMigrateMetricMigrateMetric, error) {
+	var retval __premarshalMigrateMetricMigrateMetric
+
+	retval.Id = v.MetricData.Id
+	retval.Type = v.MetricData.Type
+	retval.AccessControlEnabled = v.MetricData.AccessControlEnabled
+	retval.DataPool = v.MetricData.DataPool
+	retval.Dimensions = v.MetricData.Dimensions
+	retval.Timestamp = v.MetricData.Timestamp
+	retval.Measure = v.MetricData.Measure
+	{
+
+		dst := &retval.Settings
+		src := v.MetricData.Settings
+		var err error
+		*dst, err = __marshalMetricDataSettingsMetricSettings(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal MigrateMetricMigrateMetric.MetricData.Settings: %w", err)
+		}
+	}
+	retval.UniqueName = v.MetricData.CommonDataMetric.UniqueName
+	retval.Description = v.MetricData.CommonDataMetric.Description
+	retval.Account = v.MetricData.CommonDataMetric.Account
+	retval.Environment = v.MetricData.CommonDataMetric.Environment
+	retval.CreatedAt = v.MetricData.CommonDataMetric.CreatedAt
+	retval.ModifiedAt = v.MetricData.CommonDataMetric.ModifiedAt
+	retval.CreatedBy = v.MetricData.CommonDataMetric.CreatedBy
+	retval.ModifiedBy = v.MetricData.CommonDataMetric.ModifiedBy
+	return &retval, nil
+}
+
+// MigrateMetricResponse is returned by MigrateMetric on success.
+type MigrateMetricResponse struct {
+	// Migrates a Metric from one Data Pool to another.
+	MigrateMetric *MigrateMetricMigrateMetric `json:"migrateMetric"`
+}
+
+// GetMigrateMetric returns MigrateMetricResponse.MigrateMetric, and is useful for accessing the field via an interface.
+func (v *MigrateMetricResponse) GetMigrateMetric() *MigrateMetricMigrateMetric {
+	return v.MigrateMetric
+}
+
 // The fields for modifying an Application.
 type ModifyApplicationInput struct {
 	// The ID or unique name of the Application to modify.
@@ -15954,6 +19603,11 @@ func (v *ModifyDataPoolAccessPolicyModifyDataPoolAccessPolicyDataPoolAccessPolic
 	return v.DataPoolAccessPolicyData.Rows
 }
 
+// GetFilterSql returns ModifyDataPoolAccessPolicyModifyDataPoolAccessPolicyDataPoolAccessPolicyResponseDataPoolAccessPolicy.FilterSql, and is useful for accessing the field via an interface.
+func (v *ModifyDataPoolAccessPolicyModifyDataPoolAccessPolicyDataPoolAccessPolicyResponseDataPoolAccessPolicy) GetFilterSql() *string {
+	return v.DataPoolAccessPolicyData.FilterSql
+}
+
 // GetDataPool returns ModifyDataPoolAccessPolicyModifyDataPoolAccessPolicyDataPoolAccessPolicyResponseDataPoolAccessPolicy.DataPool, and is useful for accessing the field via an interface.
 func (v *ModifyDataPoolAccessPolicyModifyDataPoolAccessPolicyDataPoolAccessPolicyResponseDataPoolAccessPolicy) GetDataPool() *DataPoolAccessPolicyDataDataPool {
 	return v.DataPoolAccessPolicyData.DataPool
@@ -16031,6 +19685,8 @@ type __premarshalModifyDataPoolAccessPolicyModifyDataPoolAccessPolicyDataPoolAcc
 
 	Rows []*DataPoolAccessPolicyDataRowsFilter `json:"rows"`
 
+	FilterSql *string `json:"filterSql"`
+
 	DataPool *DataPoolAccessPolicyDataDataPool `json:"dataPool"`
 
 	UniqueName string `json:"uniqueName"`
@@ -16064,6 +19720,7 @@ func (v *ModifyDataPoolAccessPolicyModifyDataPoolAccessPolicyDataPoolAccessPolic
 	retval.Id = v.DataPoolAccessPolicyData.Id
 	retval.Columns = v.DataPoolAccessPolicyData.Columns
 	retval.Rows = v.DataPoolAccessPolicyData.Rows
+	retval.FilterSql = v.DataPoolAccessPolicyData.FilterSql
 	retval.DataPool = v.DataPoolAccessPolicyData.DataPool
 	retval.UniqueName = v.DataPoolAccessPolicyData.CommonDataDataPoolAccessPolicy.UniqueName
 	retval.Description = v.DataPoolAccessPolicyData.CommonDataDataPoolAccessPolicy.Description
@@ -16265,6 +19922,11 @@ func (v *ModifyDataPoolModifyDataPoolDataPoolResponseDataPool) GetTable() string
 	return v.DataPoolData.Table
 }
 
+// GetRecordCount returns ModifyDataPoolModifyDataPoolDataPoolResponseDataPool.RecordCount, and is useful for accessing the field via an interface.
+func (v *ModifyDataPoolModifyDataPoolDataPoolResponseDataPool) GetRecordCount() *string {
+	return v.DataPoolData.RecordCount
+}
+
 // GetTenant returns ModifyDataPoolModifyDataPoolDataPoolResponseDataPool.Tenant, and is useful for accessing the field via an interface.
 func (v *ModifyDataPoolModifyDataPoolDataPoolResponseDataPool) GetTenant() *DataPoolDataTenant {
 	return v.DataPoolData.Tenant
@@ -16391,6 +20053,8 @@ type __premarshalModifyDataPoolModifyDataPoolDataPoolResponseDataPool struct {
 
 	Table string `json:"table"`
 
+	RecordCount *string `json:"recordCount"`
+
 	Tenant *DataPoolDataTenant `json:"tenant"`
 
 	Timestamp *DataPoolDataTimestamp `json:"timestamp"`
@@ -16444,6 +20108,7 @@ func (v *ModifyDataPoolModifyDataPoolDataPoolResponseDataPool) __premarshalJSON(
 	retval.Status = v.DataPoolData.Status
 	retval.Error = v.DataPoolData.Error
 	retval.Table = v.DataPoolData.Table
+	retval.RecordCount = v.DataPoolData.RecordCount
 	retval.Tenant = v.DataPoolData.Tenant
 	retval.Timestamp = v.DataPoolData.Timestamp
 	retval.Columns = v.DataPoolData.Columns
@@ -16623,6 +20288,164 @@ func (v *ModifyDataPoolResponse) __premarshalJSON() (*__premarshalModifyDataPool
 	return &retval, nil
 }
 
+// The fields for modifying an Environment.
+type ModifyEnvironmentInput struct {
+	Id string `json:"id"`
+	// The Environment's unique name.
+	UniqueName *string `json:"uniqueName"`
+	// The Environment's description.
+	Description *string `json:"description"`
+}
+
+// GetId returns ModifyEnvironmentInput.Id, and is useful for accessing the field via an interface.
+func (v *ModifyEnvironmentInput) GetId() string { return v.Id }
+
+// GetUniqueName returns ModifyEnvironmentInput.UniqueName, and is useful for accessing the field via an interface.
+func (v *ModifyEnvironmentInput) GetUniqueName() *string { return v.UniqueName }
+
+// GetDescription returns ModifyEnvironmentInput.Description, and is useful for accessing the field via an interface.
+func (v *ModifyEnvironmentInput) GetDescription() *string { return v.Description }
+
+// ModifyEnvironmentModifyEnvironmentEnvironmentResponse includes the requested fields of the GraphQL type EnvironmentResponse.
+// The GraphQL type's documentation follows.
+//
+// The result of a mutation which creates or modifies an Environment.
+type ModifyEnvironmentModifyEnvironmentEnvironmentResponse struct {
+	// The Environment which was created or modified.
+	Environment *ModifyEnvironmentModifyEnvironmentEnvironmentResponseEnvironment `json:"environment"`
+}
+
+// GetEnvironment returns ModifyEnvironmentModifyEnvironmentEnvironmentResponse.Environment, and is useful for accessing the field via an interface.
+func (v *ModifyEnvironmentModifyEnvironmentEnvironmentResponse) GetEnvironment() *ModifyEnvironmentModifyEnvironmentEnvironmentResponseEnvironment {
+	return v.Environment
+}
+
+// ModifyEnvironmentModifyEnvironmentEnvironmentResponseEnvironment includes the requested fields of the GraphQL type Environment.
+// The GraphQL type's documentation follows.
+//
+// The Environments object.
+//
+// Environments are independent and isolated Propel workspaces for development, staging (testing), and production workloads. Environments are hosted in a specific region, initially in us-east-2 only.
+type ModifyEnvironmentModifyEnvironmentEnvironmentResponseEnvironment struct {
+	EnvironmentData `json:"-"`
+}
+
+// GetId returns ModifyEnvironmentModifyEnvironmentEnvironmentResponseEnvironment.Id, and is useful for accessing the field via an interface.
+func (v *ModifyEnvironmentModifyEnvironmentEnvironmentResponseEnvironment) GetId() string {
+	return v.EnvironmentData.Id
+}
+
+// GetUniqueName returns ModifyEnvironmentModifyEnvironmentEnvironmentResponseEnvironment.UniqueName, and is useful for accessing the field via an interface.
+func (v *ModifyEnvironmentModifyEnvironmentEnvironmentResponseEnvironment) GetUniqueName() *string {
+	return v.EnvironmentData.UniqueName
+}
+
+// GetDescription returns ModifyEnvironmentModifyEnvironmentEnvironmentResponseEnvironment.Description, and is useful for accessing the field via an interface.
+func (v *ModifyEnvironmentModifyEnvironmentEnvironmentResponseEnvironment) GetDescription() *string {
+	return v.EnvironmentData.Description
+}
+
+// GetAccount returns ModifyEnvironmentModifyEnvironmentEnvironmentResponseEnvironment.Account, and is useful for accessing the field via an interface.
+func (v *ModifyEnvironmentModifyEnvironmentEnvironmentResponseEnvironment) GetAccount() *EnvironmentDataAccount {
+	return v.EnvironmentData.Account
+}
+
+// GetCreatedAt returns ModifyEnvironmentModifyEnvironmentEnvironmentResponseEnvironment.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ModifyEnvironmentModifyEnvironmentEnvironmentResponseEnvironment) GetCreatedAt() *time.Time {
+	return v.EnvironmentData.CreatedAt
+}
+
+// GetModifiedAt returns ModifyEnvironmentModifyEnvironmentEnvironmentResponseEnvironment.ModifiedAt, and is useful for accessing the field via an interface.
+func (v *ModifyEnvironmentModifyEnvironmentEnvironmentResponseEnvironment) GetModifiedAt() *time.Time {
+	return v.EnvironmentData.ModifiedAt
+}
+
+// GetCreatedBy returns ModifyEnvironmentModifyEnvironmentEnvironmentResponseEnvironment.CreatedBy, and is useful for accessing the field via an interface.
+func (v *ModifyEnvironmentModifyEnvironmentEnvironmentResponseEnvironment) GetCreatedBy() *string {
+	return v.EnvironmentData.CreatedBy
+}
+
+// GetModifiedBy returns ModifyEnvironmentModifyEnvironmentEnvironmentResponseEnvironment.ModifiedBy, and is useful for accessing the field via an interface.
+func (v *ModifyEnvironmentModifyEnvironmentEnvironmentResponseEnvironment) GetModifiedBy() *string {
+	return v.EnvironmentData.ModifiedBy
+}
+
+func (v *ModifyEnvironmentModifyEnvironmentEnvironmentResponseEnvironment) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ModifyEnvironmentModifyEnvironmentEnvironmentResponseEnvironment
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ModifyEnvironmentModifyEnvironmentEnvironmentResponseEnvironment = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.EnvironmentData)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalModifyEnvironmentModifyEnvironmentEnvironmentResponseEnvironment struct {
+	Id string `json:"id"`
+
+	UniqueName *string `json:"uniqueName"`
+
+	Description *string `json:"description"`
+
+	Account *EnvironmentDataAccount `json:"account"`
+
+	CreatedAt *time.Time `json:"createdAt"`
+
+	ModifiedAt *time.Time `json:"modifiedAt"`
+
+	CreatedBy *string `json:"createdBy"`
+
+	ModifiedBy *string `json:"modifiedBy"`
+}
+
+func (v *ModifyEnvironmentModifyEnvironmentEnvironmentResponseEnvironment) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ModifyEnvironmentModifyEnvironmentEnvironmentResponseEnvironment) __premarshalJSON() (*__premarshalModifyEnvironmentModifyEnvironmentEnvironmentResponseEnvironment, error) {
+	var retval __premarshalModifyEnvironmentModifyEnvironmentEnvironmentResponseEnvironment
+
+	retval.Id = v.EnvironmentData.Id
+	retval.UniqueName = v.EnvironmentData.UniqueName
+	retval.Description = v.EnvironmentData.Description
+	retval.Account = v.EnvironmentData.Account
+	retval.CreatedAt = v.EnvironmentData.CreatedAt
+	retval.ModifiedAt = v.EnvironmentData.ModifiedAt
+	retval.CreatedBy = v.EnvironmentData.CreatedBy
+	retval.ModifiedBy = v.EnvironmentData.ModifiedBy
+	return &retval, nil
+}
+
+// ModifyEnvironmentResponse is returned by ModifyEnvironment on success.
+type ModifyEnvironmentResponse struct {
+	// Modifies an Environment with the provided fields. If any of the optional fields are omitted, those properties will be unchanged on the Environment.
+	ModifyEnvironment *ModifyEnvironmentModifyEnvironmentEnvironmentResponse `json:"modifyEnvironment"`
+}
+
+// GetModifyEnvironment returns ModifyEnvironmentResponse.ModifyEnvironment, and is useful for accessing the field via an interface.
+func (v *ModifyEnvironmentResponse) GetModifyEnvironment() *ModifyEnvironmentModifyEnvironmentEnvironmentResponse {
+	return v.ModifyEnvironment
+}
+
 type ModifyHttpDataSourceInput struct {
 	// The HTTP Data Source's new connection settings. If not provided this property will not be modified.
 	ConnectionSettings *PartialHttpConnectionSettingsInput `json:"connectionSettings,omitempty"`
@@ -17687,6 +21510,258 @@ func (v *ModifyPolicyResponse) GetModifyPolicy() *ModifyPolicyModifyPolicyPolicy
 	return v.ModifyPolicy
 }
 
+type ModifyPostgreSqlDataSourceInput struct {
+	// The PostgreSQL Data Source's new connection settings. If not provided this property will not be modified.
+	ConnectionSettings *PartialPostgreSqlConnectionSettingsInput `json:"connectionSettings,omitempty"`
+	// The PostgreSQL Data Source's new description. If not provided this property will not be modified.
+	Description *string `json:"description"`
+	// The ID or unique name of the PostgreSQL Data Source to modify.
+	IdOrUniqueName *IdOrUniqueName `json:"idOrUniqueName,omitempty"`
+	// The PostgreSQL Data Source's new unique name. If not provided this property will not be modified.
+	UniqueName *string `json:"uniqueName"`
+}
+
+// GetConnectionSettings returns ModifyPostgreSqlDataSourceInput.ConnectionSettings, and is useful for accessing the field via an interface.
+func (v *ModifyPostgreSqlDataSourceInput) GetConnectionSettings() *PartialPostgreSqlConnectionSettingsInput {
+	return v.ConnectionSettings
+}
+
+// GetDescription returns ModifyPostgreSqlDataSourceInput.Description, and is useful for accessing the field via an interface.
+func (v *ModifyPostgreSqlDataSourceInput) GetDescription() *string { return v.Description }
+
+// GetIdOrUniqueName returns ModifyPostgreSqlDataSourceInput.IdOrUniqueName, and is useful for accessing the field via an interface.
+func (v *ModifyPostgreSqlDataSourceInput) GetIdOrUniqueName() *IdOrUniqueName {
+	return v.IdOrUniqueName
+}
+
+// GetUniqueName returns ModifyPostgreSqlDataSourceInput.UniqueName, and is useful for accessing the field via an interface.
+func (v *ModifyPostgreSqlDataSourceInput) GetUniqueName() *string { return v.UniqueName }
+
+// ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponse includes the requested fields of the GraphQL type DataSourceResponse.
+// The GraphQL type's documentation follows.
+//
+// The result of a mutation which creates or modifies a Data Source.
+type ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponse struct {
+	// The Data Source which was created or modified.
+	DataSource *ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource `json:"dataSource"`
+}
+
+// GetDataSource returns ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponse.DataSource, and is useful for accessing the field via an interface.
+func (v *ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponse) GetDataSource() *ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource {
+	return v.DataSource
+}
+
+// ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource includes the requested fields of the GraphQL type DataSource.
+// The GraphQL type's documentation follows.
+//
+// The Data Source object.
+//
+// A Data Source is a connection to your data warehouse. It has the necessary connection details for Propel to access Snowflake or any other supported Data Source.
+type ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource struct {
+	DataSourceData `json:"-"`
+}
+
+// GetId returns ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource.Id, and is useful for accessing the field via an interface.
+func (v *ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource) GetId() string {
+	return v.DataSourceData.Id
+}
+
+// GetType returns ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource.Type, and is useful for accessing the field via an interface.
+func (v *ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource) GetType() DataSourceType {
+	return v.DataSourceData.Type
+}
+
+// GetStatus returns ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource.Status, and is useful for accessing the field via an interface.
+func (v *ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource) GetStatus() DataSourceStatus {
+	return v.DataSourceData.Status
+}
+
+// GetError returns ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource.Error, and is useful for accessing the field via an interface.
+func (v *ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource) GetError() *DataSourceDataError {
+	return v.DataSourceData.Error
+}
+
+// GetDataPools returns ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource.DataPools, and is useful for accessing the field via an interface.
+func (v *ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource) GetDataPools() *DataSourceDataDataPoolsDataPoolConnection {
+	return v.DataSourceData.DataPools
+}
+
+// GetConnectionSettings returns ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource.ConnectionSettings, and is useful for accessing the field via an interface.
+func (v *ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource) GetConnectionSettings() DataSourceDataConnectionSettings {
+	return v.DataSourceData.ConnectionSettings
+}
+
+// GetTables returns ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource.Tables, and is useful for accessing the field via an interface.
+func (v *ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource) GetTables() *DataSourceDataTablesTableConnection {
+	return v.DataSourceData.Tables
+}
+
+// GetChecks returns ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource.Checks, and is useful for accessing the field via an interface.
+func (v *ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource) GetChecks() []*DataSourceDataChecksDataSourceCheck {
+	return v.DataSourceData.Checks
+}
+
+// GetTableIntrospections returns ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource.TableIntrospections, and is useful for accessing the field via an interface.
+func (v *ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource) GetTableIntrospections() *DataSourceDataTableIntrospectionsTableIntrospectionConnection {
+	return v.DataSourceData.TableIntrospections
+}
+
+// GetUniqueName returns ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource.UniqueName, and is useful for accessing the field via an interface.
+func (v *ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource) GetUniqueName() string {
+	return v.DataSourceData.CommonDataDataSource.UniqueName
+}
+
+// GetDescription returns ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource.Description, and is useful for accessing the field via an interface.
+func (v *ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource) GetDescription() string {
+	return v.DataSourceData.CommonDataDataSource.Description
+}
+
+// GetAccount returns ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource.Account, and is useful for accessing the field via an interface.
+func (v *ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource) GetAccount() *CommonDataAccount {
+	return v.DataSourceData.CommonDataDataSource.Account
+}
+
+// GetEnvironment returns ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource.Environment, and is useful for accessing the field via an interface.
+func (v *ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource) GetEnvironment() *CommonDataEnvironment {
+	return v.DataSourceData.CommonDataDataSource.Environment
+}
+
+// GetCreatedAt returns ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource) GetCreatedAt() time.Time {
+	return v.DataSourceData.CommonDataDataSource.CreatedAt
+}
+
+// GetModifiedAt returns ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource.ModifiedAt, and is useful for accessing the field via an interface.
+func (v *ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource) GetModifiedAt() time.Time {
+	return v.DataSourceData.CommonDataDataSource.ModifiedAt
+}
+
+// GetCreatedBy returns ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource.CreatedBy, and is useful for accessing the field via an interface.
+func (v *ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource) GetCreatedBy() string {
+	return v.DataSourceData.CommonDataDataSource.CreatedBy
+}
+
+// GetModifiedBy returns ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource.ModifiedBy, and is useful for accessing the field via an interface.
+func (v *ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource) GetModifiedBy() string {
+	return v.DataSourceData.CommonDataDataSource.ModifiedBy
+}
+
+func (v *ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.DataSourceData)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource struct {
+	Id string `json:"id"`
+
+	Type DataSourceType `json:"type"`
+
+	Status DataSourceStatus `json:"status"`
+
+	Error *DataSourceDataError `json:"error"`
+
+	DataPools *DataSourceDataDataPoolsDataPoolConnection `json:"dataPools"`
+
+	ConnectionSettings json.RawMessage `json:"connectionSettings"`
+
+	Tables *DataSourceDataTablesTableConnection `json:"tables"`
+
+	Checks []*DataSourceDataChecksDataSourceCheck `json:"checks"`
+
+	TableIntrospections *DataSourceDataTableIntrospectionsTableIntrospectionConnection `json:"tableIntrospections"`
+
+	UniqueName string `json:"uniqueName"`
+
+	Description string `json:"description"`
+
+	Account *CommonDataAccount `json:"account"`
+
+	Environment *CommonDataEnvironment `json:"environment"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	ModifiedAt time.Time `json:"modifiedAt"`
+
+	CreatedBy string `json:"createdBy"`
+
+	ModifiedBy string `json:"modifiedBy"`
+}
+
+func (v *ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource) __premarshalJSON() (*__premarshalModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource, error) {
+	var retval __premarshalModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource
+
+	retval.Id = v.DataSourceData.Id
+	retval.Type = v.DataSourceData.Type
+	retval.Status = v.DataSourceData.Status
+	retval.Error = v.DataSourceData.Error
+	retval.DataPools = v.DataSourceData.DataPools
+	{
+
+		dst := &retval.ConnectionSettings
+		src := v.DataSourceData.ConnectionSettings
+		var err error
+		*dst, err = __marshalDataSourceDataConnectionSettings(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponseDataSource.DataSourceData.ConnectionSettings: %w", err)
+		}
+	}
+	retval.Tables = v.DataSourceData.Tables
+	retval.Checks = v.DataSourceData.Checks
+	retval.TableIntrospections = v.DataSourceData.TableIntrospections
+	retval.UniqueName = v.DataSourceData.CommonDataDataSource.UniqueName
+	retval.Description = v.DataSourceData.CommonDataDataSource.Description
+	retval.Account = v.DataSourceData.CommonDataDataSource.Account
+	retval.Environment = v.DataSourceData.CommonDataDataSource.Environment
+	retval.CreatedAt = v.DataSourceData.CommonDataDataSource.CreatedAt
+	retval.ModifiedAt = v.DataSourceData.CommonDataDataSource.ModifiedAt
+	retval.CreatedBy = v.DataSourceData.CommonDataDataSource.CreatedBy
+	retval.ModifiedBy = v.DataSourceData.CommonDataDataSource.ModifiedBy
+	return &retval, nil
+}
+
+// ModifyPostgreSqlDataSourceResponse is returned by ModifyPostgreSqlDataSource on success.
+type ModifyPostgreSqlDataSourceResponse struct {
+	// Selects a Data Source by its ID or unique name and modifies it to have the given unique name, description, and connection settings.
+	//
+	// If any of the optional arguments are omitted, those properties will be unchanged on the Data Source.
+	ModifyPostgreSqlDataSource *ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponse `json:"modifyPostgreSqlDataSource"`
+}
+
+// GetModifyPostgreSqlDataSource returns ModifyPostgreSqlDataSourceResponse.ModifyPostgreSqlDataSource, and is useful for accessing the field via an interface.
+func (v *ModifyPostgreSqlDataSourceResponse) GetModifyPostgreSqlDataSource() *ModifyPostgreSqlDataSourceModifyPostgreSqlDataSourceDataSourceResponse {
+	return v.ModifyPostgreSqlDataSource
+}
+
 type ModifyS3DataSourceInput struct {
 	// The S3 Data Source's new connection settings. If not provided this property will not be modified.
 	ConnectionSettings *PartialS3ConnectionSettingsInput `json:"connectionSettings,omitempty"`
@@ -18672,6 +22747,166 @@ func (v *ModifyWebhookDataSourceResponse) GetModifyWebhookDataSource() *ModifyWe
 	return v.ModifyWebhookDataSource
 }
 
+// NestedFilterData includes the GraphQL fields of Filter requested by the fragment NestedFilterData.
+// The GraphQL type's documentation follows.
+//
+// The fields of a filter.
+//
+// You can construct more complex filters using `and` and `or`. For example, to construct a filter equivalent to
+//
+// ```
+// (value > 0 AND value <= 100) OR status = "confirmed"
+// ```
+//
+// you could write
+//
+// ```
+// {
+// "column": "value",
+// "operator": "GREATER_THAN",
+// "value": "0",
+// "and": [{
+// "column": "value",
+// "operator": "LESS_THAN_OR_EQUAL_TO",
+// "value": "0"
+// }],
+// "or": [{
+// "column": "status",
+// "operator": "EQUALS",
+// "value": "confirmed"
+// }]
+// }
+// ```
+//
+// Note that `and` takes precedence over `or`.
+type NestedFilterData struct {
+	// The name of the column to filter on.
+	Column string `json:"column"`
+	// The operation to perform when comparing the column and filter values.
+	Operator FilterOperator `json:"operator"`
+	// The value to compare the column to.
+	Value *string `json:"value"`
+	// Additional filters to AND with this one. AND takes precedence over OR.
+	And []*NestedFilterDataAndFilter `json:"and"`
+	// Additional filters to OR with this one. AND takes precedence over OR.
+	Or []*NestedFilterDataOrFilter `json:"or"`
+}
+
+// GetColumn returns NestedFilterData.Column, and is useful for accessing the field via an interface.
+func (v *NestedFilterData) GetColumn() string { return v.Column }
+
+// GetOperator returns NestedFilterData.Operator, and is useful for accessing the field via an interface.
+func (v *NestedFilterData) GetOperator() FilterOperator { return v.Operator }
+
+// GetValue returns NestedFilterData.Value, and is useful for accessing the field via an interface.
+func (v *NestedFilterData) GetValue() *string { return v.Value }
+
+// GetAnd returns NestedFilterData.And, and is useful for accessing the field via an interface.
+func (v *NestedFilterData) GetAnd() []*NestedFilterDataAndFilter { return v.And }
+
+// GetOr returns NestedFilterData.Or, and is useful for accessing the field via an interface.
+func (v *NestedFilterData) GetOr() []*NestedFilterDataOrFilter { return v.Or }
+
+// NestedFilterDataAndFilter includes the requested fields of the GraphQL type Filter.
+// The GraphQL type's documentation follows.
+//
+// The fields of a filter.
+//
+// You can construct more complex filters using `and` and `or`. For example, to construct a filter equivalent to
+//
+// ```
+// (value > 0 AND value <= 100) OR status = "confirmed"
+// ```
+//
+// you could write
+//
+// ```
+// {
+// "column": "value",
+// "operator": "GREATER_THAN",
+// "value": "0",
+// "and": [{
+// "column": "value",
+// "operator": "LESS_THAN_OR_EQUAL_TO",
+// "value": "0"
+// }],
+// "or": [{
+// "column": "status",
+// "operator": "EQUALS",
+// "value": "confirmed"
+// }]
+// }
+// ```
+//
+// Note that `and` takes precedence over `or`.
+type NestedFilterDataAndFilter struct {
+	// The name of the column to filter on.
+	Column string `json:"column"`
+	// The operation to perform when comparing the column and filter values.
+	Operator FilterOperator `json:"operator"`
+	// The value to compare the column to.
+	Value *string `json:"value"`
+}
+
+// GetColumn returns NestedFilterDataAndFilter.Column, and is useful for accessing the field via an interface.
+func (v *NestedFilterDataAndFilter) GetColumn() string { return v.Column }
+
+// GetOperator returns NestedFilterDataAndFilter.Operator, and is useful for accessing the field via an interface.
+func (v *NestedFilterDataAndFilter) GetOperator() FilterOperator { return v.Operator }
+
+// GetValue returns NestedFilterDataAndFilter.Value, and is useful for accessing the field via an interface.
+func (v *NestedFilterDataAndFilter) GetValue() *string { return v.Value }
+
+// NestedFilterDataOrFilter includes the requested fields of the GraphQL type Filter.
+// The GraphQL type's documentation follows.
+//
+// The fields of a filter.
+//
+// You can construct more complex filters using `and` and `or`. For example, to construct a filter equivalent to
+//
+// ```
+// (value > 0 AND value <= 100) OR status = "confirmed"
+// ```
+//
+// you could write
+//
+// ```
+// {
+// "column": "value",
+// "operator": "GREATER_THAN",
+// "value": "0",
+// "and": [{
+// "column": "value",
+// "operator": "LESS_THAN_OR_EQUAL_TO",
+// "value": "0"
+// }],
+// "or": [{
+// "column": "status",
+// "operator": "EQUALS",
+// "value": "confirmed"
+// }]
+// }
+// ```
+//
+// Note that `and` takes precedence over `or`.
+type NestedFilterDataOrFilter struct {
+	// The name of the column to filter on.
+	Column string `json:"column"`
+	// The operation to perform when comparing the column and filter values.
+	Operator FilterOperator `json:"operator"`
+	// The value to compare the column to.
+	Value *string `json:"value"`
+}
+
+// GetColumn returns NestedFilterDataOrFilter.Column, and is useful for accessing the field via an interface.
+func (v *NestedFilterDataOrFilter) GetColumn() string { return v.Column }
+
+// GetOperator returns NestedFilterDataOrFilter.Operator, and is useful for accessing the field via an interface.
+func (v *NestedFilterDataOrFilter) GetOperator() FilterOperator { return v.Operator }
+
+// GetValue returns NestedFilterDataOrFilter.Value, and is useful for accessing the field via an interface.
+func (v *NestedFilterDataOrFilter) GetValue() *string { return v.Value }
+
 // PageInfoData includes the GraphQL fields of PageInfo requested by the fragment PageInfoData.
 // The GraphQL type's documentation follows.
 //
@@ -18775,6 +23010,40 @@ func (v *PartialKafkaConnectionSettingsInput) GetTls() *bool { return v.Tls }
 // GetUser returns PartialKafkaConnectionSettingsInput.User, and is useful for accessing the field via an interface.
 func (v *PartialKafkaConnectionSettingsInput) GetUser() *string { return v.User }
 
+// The PostgreSQL Data Source connection settings.
+type PartialPostgreSqlConnectionSettingsInput struct {
+	// Which database to connect to If not provided this property will not be modified.
+	Database *string `json:"database"`
+	// The host where PostgreSQL is listening If not provided this property will not be modified.
+	Host *string `json:"host"`
+	// The password for the provided user If not provided this property will not be modified.
+	Password *string `json:"password"`
+	// The port where PostgreSQL is listening (usually 5432) If not provided this property will not be modified.
+	Port *int `json:"port"`
+	// Which schema to use If not provided this property will not be modified.
+	Schema *string `json:"schema"`
+	// The user for authenticating against PostgreSQL If not provided this property will not be modified.
+	User *string `json:"user"`
+}
+
+// GetDatabase returns PartialPostgreSqlConnectionSettingsInput.Database, and is useful for accessing the field via an interface.
+func (v *PartialPostgreSqlConnectionSettingsInput) GetDatabase() *string { return v.Database }
+
+// GetHost returns PartialPostgreSqlConnectionSettingsInput.Host, and is useful for accessing the field via an interface.
+func (v *PartialPostgreSqlConnectionSettingsInput) GetHost() *string { return v.Host }
+
+// GetPassword returns PartialPostgreSqlConnectionSettingsInput.Password, and is useful for accessing the field via an interface.
+func (v *PartialPostgreSqlConnectionSettingsInput) GetPassword() *string { return v.Password }
+
+// GetPort returns PartialPostgreSqlConnectionSettingsInput.Port, and is useful for accessing the field via an interface.
+func (v *PartialPostgreSqlConnectionSettingsInput) GetPort() *int { return v.Port }
+
+// GetSchema returns PartialPostgreSqlConnectionSettingsInput.Schema, and is useful for accessing the field via an interface.
+func (v *PartialPostgreSqlConnectionSettingsInput) GetSchema() *string { return v.Schema }
+
+// GetUser returns PartialPostgreSqlConnectionSettingsInput.User, and is useful for accessing the field via an interface.
+func (v *PartialPostgreSqlConnectionSettingsInput) GetUser() *string { return v.User }
+
 // The connection settings for an S3 Data Source. These include the S3 bucket name, the AWS access key ID, and the tables (along with their paths). We do not allow fetching the AWS secret access key after it has been set.
 type PartialS3ConnectionSettingsInput struct {
 	// The AWS access key ID for an IAM user with sufficient access to the S3 bucket. If not provided this property will not be modified.
@@ -19005,6 +23274,40 @@ const (
 	PolicyTypeTenantAccess PolicyType = "TENANT_ACCESS"
 )
 
+// The PostgreSQL Data Source connection settings.
+type PostgreSqlConnectionSettingsInput struct {
+	// Which database to connect to
+	Database *string `json:"database"`
+	// The host where PostgreSQL is listening
+	Host string `json:"host"`
+	// The password for the provided user
+	Password string `json:"password"`
+	// The port where PostgreSQL is listening (usually 5432)
+	Port *int `json:"port"`
+	// Which schema to use
+	Schema *string `json:"schema"`
+	// The user for authenticating against PostgreSQL
+	User string `json:"user"`
+}
+
+// GetDatabase returns PostgreSqlConnectionSettingsInput.Database, and is useful for accessing the field via an interface.
+func (v *PostgreSqlConnectionSettingsInput) GetDatabase() *string { return v.Database }
+
+// GetHost returns PostgreSqlConnectionSettingsInput.Host, and is useful for accessing the field via an interface.
+func (v *PostgreSqlConnectionSettingsInput) GetHost() string { return v.Host }
+
+// GetPassword returns PostgreSqlConnectionSettingsInput.Password, and is useful for accessing the field via an interface.
+func (v *PostgreSqlConnectionSettingsInput) GetPassword() string { return v.Password }
+
+// GetPort returns PostgreSqlConnectionSettingsInput.Port, and is useful for accessing the field via an interface.
+func (v *PostgreSqlConnectionSettingsInput) GetPort() *int { return v.Port }
+
+// GetSchema returns PostgreSqlConnectionSettingsInput.Schema, and is useful for accessing the field via an interface.
+func (v *PostgreSqlConnectionSettingsInput) GetSchema() *string { return v.Schema }
+
+// GetUser returns PostgreSqlConnectionSettingsInput.User, and is useful for accessing the field via an interface.
+func (v *PostgreSqlConnectionSettingsInput) GetUser() string { return v.User }
+
 // Parameters for the PostgreSQL table engine.
 type PostgreSqlTableEngineInput struct {
 	// The type is always `POSTGRESQL`.
@@ -19044,6 +23347,150 @@ func (v *ReplacingMergeTreeTableEngineInput) GetType() *TableEngineType { return
 // GetVer returns ReplacingMergeTreeTableEngineInput.Ver, and is useful for accessing the field via an interface.
 func (v *ReplacingMergeTreeTableEngineInput) GetVer() *string { return v.Ver }
 
+// ResyncDataPoolResponse is returned by ResyncDataPool on success.
+type ResyncDataPoolResponse struct {
+	// Manually trigger a re-Sync for a Data Pool.
+	ResyncDataPool *ResyncDataPoolResyncDataPoolSync `json:"resyncDataPool"`
+}
+
+// GetResyncDataPool returns ResyncDataPoolResponse.ResyncDataPool, and is useful for accessing the field via an interface.
+func (v *ResyncDataPoolResponse) GetResyncDataPool() *ResyncDataPoolResyncDataPoolSync {
+	return v.ResyncDataPool
+}
+
+// ResyncDataPoolResyncDataPoolSync includes the requested fields of the GraphQL type Sync.
+// The GraphQL type's documentation follows.
+//
+// The Sync object.
+//
+// This represents the process of syncing data from your Data Source (for example, a Snowflake data warehouse) to your Data Pool.
+type ResyncDataPoolResyncDataPoolSync struct {
+	SyncData `json:"-"`
+}
+
+// GetId returns ResyncDataPoolResyncDataPoolSync.Id, and is useful for accessing the field via an interface.
+func (v *ResyncDataPoolResyncDataPoolSync) GetId() string { return v.SyncData.Id }
+
+// GetDataPool returns ResyncDataPoolResyncDataPoolSync.DataPool, and is useful for accessing the field via an interface.
+func (v *ResyncDataPoolResyncDataPoolSync) GetDataPool() *SyncDataDataPool {
+	return v.SyncData.DataPool
+}
+
+// GetStatus returns ResyncDataPoolResyncDataPoolSync.Status, and is useful for accessing the field via an interface.
+func (v *ResyncDataPoolResyncDataPoolSync) GetStatus() SyncStatus { return v.SyncData.Status }
+
+// GetProcessedRecords returns ResyncDataPoolResyncDataPoolSync.ProcessedRecords, and is useful for accessing the field via an interface.
+func (v *ResyncDataPoolResyncDataPoolSync) GetProcessedRecords() *string {
+	return v.SyncData.ProcessedRecords
+}
+
+// GetSize returns ResyncDataPoolResyncDataPoolSync.Size, and is useful for accessing the field via an interface.
+func (v *ResyncDataPoolResyncDataPoolSync) GetSize() *string { return v.SyncData.Size }
+
+// GetStartedAt returns ResyncDataPoolResyncDataPoolSync.StartedAt, and is useful for accessing the field via an interface.
+func (v *ResyncDataPoolResyncDataPoolSync) GetStartedAt() *time.Time { return v.SyncData.StartedAt }
+
+// GetSucceededAt returns ResyncDataPoolResyncDataPoolSync.SucceededAt, and is useful for accessing the field via an interface.
+func (v *ResyncDataPoolResyncDataPoolSync) GetSucceededAt() *time.Time { return v.SyncData.SucceededAt }
+
+// GetFailedAt returns ResyncDataPoolResyncDataPoolSync.FailedAt, and is useful for accessing the field via an interface.
+func (v *ResyncDataPoolResyncDataPoolSync) GetFailedAt() *time.Time { return v.SyncData.FailedAt }
+
+// GetError returns ResyncDataPoolResyncDataPoolSync.Error, and is useful for accessing the field via an interface.
+func (v *ResyncDataPoolResyncDataPoolSync) GetError() *SyncDataError { return v.SyncData.Error }
+
+// GetCreatedAt returns ResyncDataPoolResyncDataPoolSync.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ResyncDataPoolResyncDataPoolSync) GetCreatedAt() time.Time { return v.SyncData.CreatedAt }
+
+// GetModifiedAt returns ResyncDataPoolResyncDataPoolSync.ModifiedAt, and is useful for accessing the field via an interface.
+func (v *ResyncDataPoolResyncDataPoolSync) GetModifiedAt() time.Time { return v.SyncData.ModifiedAt }
+
+// GetCreatedBy returns ResyncDataPoolResyncDataPoolSync.CreatedBy, and is useful for accessing the field via an interface.
+func (v *ResyncDataPoolResyncDataPoolSync) GetCreatedBy() string { return v.SyncData.CreatedBy }
+
+// GetModifiedBy returns ResyncDataPoolResyncDataPoolSync.ModifiedBy, and is useful for accessing the field via an interface.
+func (v *ResyncDataPoolResyncDataPoolSync) GetModifiedBy() string { return v.SyncData.ModifiedBy }
+
+func (v *ResyncDataPoolResyncDataPoolSync) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ResyncDataPoolResyncDataPoolSync
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ResyncDataPoolResyncDataPoolSync = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.SyncData)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalResyncDataPoolResyncDataPoolSync struct {
+	Id string `json:"id"`
+
+	DataPool *SyncDataDataPool `json:"dataPool"`
+
+	Status SyncStatus `json:"status"`
+
+	ProcessedRecords *string `json:"processedRecords"`
+
+	Size *string `json:"size"`
+
+	StartedAt *time.Time `json:"startedAt"`
+
+	SucceededAt *time.Time `json:"succeededAt"`
+
+	FailedAt *time.Time `json:"failedAt"`
+
+	Error *SyncDataError `json:"error"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	ModifiedAt time.Time `json:"modifiedAt"`
+
+	CreatedBy string `json:"createdBy"`
+
+	ModifiedBy string `json:"modifiedBy"`
+}
+
+func (v *ResyncDataPoolResyncDataPoolSync) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ResyncDataPoolResyncDataPoolSync) __premarshalJSON() (*__premarshalResyncDataPoolResyncDataPoolSync, error) {
+	var retval __premarshalResyncDataPoolResyncDataPoolSync
+
+	retval.Id = v.SyncData.Id
+	retval.DataPool = v.SyncData.DataPool
+	retval.Status = v.SyncData.Status
+	retval.ProcessedRecords = v.SyncData.ProcessedRecords
+	retval.Size = v.SyncData.Size
+	retval.StartedAt = v.SyncData.StartedAt
+	retval.SucceededAt = v.SyncData.SucceededAt
+	retval.FailedAt = v.SyncData.FailedAt
+	retval.Error = v.SyncData.Error
+	retval.CreatedAt = v.SyncData.CreatedAt
+	retval.ModifiedAt = v.SyncData.ModifiedAt
+	retval.CreatedBy = v.SyncData.CreatedBy
+	retval.ModifiedBy = v.SyncData.ModifiedBy
+	return &retval, nil
+}
+
 // The connection settings for an S3 Data Source. These include the S3 bucket name, the AWS access key ID, and the tables (along with their paths). We do not allow fetching the AWS secret access key after it has been set.
 type S3ConnectionSettingsInput struct {
 	// The AWS access key ID for an IAM user with sufficient access to the S3 bucket.
@@ -19145,6 +23592,16 @@ func (v *SnowflakeConnectionSettingsInput) GetPassword() string { return v.Passw
 // GetRole returns SnowflakeConnectionSettingsInput.Role, and is useful for accessing the field via an interface.
 func (v *SnowflakeConnectionSettingsInput) GetRole() string { return v.Role }
 
+// The SQL dialect to use when parsing queries.
+type SqlDialectV1 string
+
+const (
+	// Parse as PostgreSQL-compatible SQL.
+	SqlDialectV1Postgresql SqlDialectV1 = "POSTGRESQL"
+	// Parse as ClickHouse-compatible SQL.
+	SqlDialectV1Clickhouse SqlDialectV1 = "CLICKHOUSE"
+)
+
 // Parameters for the SummingMergeTree table engine.
 type SummingMergeTreeTableEngineInput struct {
 	// The type is always `SUMMING_MERGE_TREE`.
@@ -19159,6 +23616,305 @@ func (v *SummingMergeTreeTableEngineInput) GetType() *TableEngineType { return v
 // GetColumns returns SummingMergeTreeTableEngineInput.Columns, and is useful for accessing the field via an interface.
 func (v *SummingMergeTreeTableEngineInput) GetColumns() []string { return v.Columns }
 
+// SyncData includes the GraphQL fields of Sync requested by the fragment SyncData.
+// The GraphQL type's documentation follows.
+//
+// The Sync object.
+//
+// This represents the process of syncing data from your Data Source (for example, a Snowflake data warehouse) to your Data Pool.
+type SyncData struct {
+	// The Sync's unique identifier.
+	Id string `json:"id"`
+	// The Sync's Data Pool.
+	DataPool *SyncDataDataPool `json:"dataPool"`
+	// The status of the Sync (all Syncs begin as SYNCING before transitioning to SUCCEEDED or FAILED).
+	Status SyncStatus `json:"status"`
+	// The number of new, updated, and deleted records contained within the Sync, if known. This excludes filtered records.
+	ProcessedRecords *string `json:"processedRecords"`
+	// The (compressed) size of the Sync, in bytes, if known.
+	Size *string `json:"size"`
+	// The time at which the Sync started.
+	StartedAt *time.Time `json:"startedAt"`
+	// The time at which the Sync succeeded.
+	SucceededAt *time.Time `json:"succeededAt"`
+	// The time at which the Sync failed.
+	FailedAt *time.Time `json:"failedAt"`
+	// If the Sync failed, this represents the reason the Sync failed.
+	Error *SyncDataError `json:"error"`
+	// The Sync's creation date and time in UTC.
+	CreatedAt time.Time `json:"createdAt"`
+	// The Sync's last modification date and time in UTC.
+	ModifiedAt time.Time `json:"modifiedAt"`
+	// The Sync's creator. It can be either a User ID, an Application ID, or "system" if it was created by Propel.
+	CreatedBy string `json:"createdBy"`
+	// The Sync's last modifier. It can be either a User ID, an Application ID, or "system" if it was modified by Propel.
+	ModifiedBy string `json:"modifiedBy"`
+}
+
+// GetId returns SyncData.Id, and is useful for accessing the field via an interface.
+func (v *SyncData) GetId() string { return v.Id }
+
+// GetDataPool returns SyncData.DataPool, and is useful for accessing the field via an interface.
+func (v *SyncData) GetDataPool() *SyncDataDataPool { return v.DataPool }
+
+// GetStatus returns SyncData.Status, and is useful for accessing the field via an interface.
+func (v *SyncData) GetStatus() SyncStatus { return v.Status }
+
+// GetProcessedRecords returns SyncData.ProcessedRecords, and is useful for accessing the field via an interface.
+func (v *SyncData) GetProcessedRecords() *string { return v.ProcessedRecords }
+
+// GetSize returns SyncData.Size, and is useful for accessing the field via an interface.
+func (v *SyncData) GetSize() *string { return v.Size }
+
+// GetStartedAt returns SyncData.StartedAt, and is useful for accessing the field via an interface.
+func (v *SyncData) GetStartedAt() *time.Time { return v.StartedAt }
+
+// GetSucceededAt returns SyncData.SucceededAt, and is useful for accessing the field via an interface.
+func (v *SyncData) GetSucceededAt() *time.Time { return v.SucceededAt }
+
+// GetFailedAt returns SyncData.FailedAt, and is useful for accessing the field via an interface.
+func (v *SyncData) GetFailedAt() *time.Time { return v.FailedAt }
+
+// GetError returns SyncData.Error, and is useful for accessing the field via an interface.
+func (v *SyncData) GetError() *SyncDataError { return v.Error }
+
+// GetCreatedAt returns SyncData.CreatedAt, and is useful for accessing the field via an interface.
+func (v *SyncData) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetModifiedAt returns SyncData.ModifiedAt, and is useful for accessing the field via an interface.
+func (v *SyncData) GetModifiedAt() time.Time { return v.ModifiedAt }
+
+// GetCreatedBy returns SyncData.CreatedBy, and is useful for accessing the field via an interface.
+func (v *SyncData) GetCreatedBy() string { return v.CreatedBy }
+
+// GetModifiedBy returns SyncData.ModifiedBy, and is useful for accessing the field via an interface.
+func (v *SyncData) GetModifiedBy() string { return v.ModifiedBy }
+
+// SyncDataDataPool includes the requested fields of the GraphQL type DataPool.
+// The GraphQL type's documentation follows.
+//
+// The Data Pool object. Data Pools are Propel's high-speed data store and cache
+type SyncDataDataPool struct {
+	// The Data Pool's unique identifier.
+	Id string `json:"id"`
+}
+
+// GetId returns SyncDataDataPool.Id, and is useful for accessing the field via an interface.
+func (v *SyncDataDataPool) GetId() string { return v.Id }
+
+// SyncDataError includes the requested fields of the GraphQL type Error.
+// The GraphQL type's documentation follows.
+//
+// The error object.
+type SyncDataError struct {
+	GqlError `json:"-"`
+}
+
+// GetCode returns SyncDataError.Code, and is useful for accessing the field via an interface.
+func (v *SyncDataError) GetCode() *int { return v.GqlError.Code }
+
+// GetMessage returns SyncDataError.Message, and is useful for accessing the field via an interface.
+func (v *SyncDataError) GetMessage() string { return v.GqlError.Message }
+
+func (v *SyncDataError) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SyncDataError
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SyncDataError = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.GqlError)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalSyncDataError struct {
+	Code *int `json:"code"`
+
+	Message string `json:"message"`
+}
+
+func (v *SyncDataError) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SyncDataError) __premarshalJSON() (*__premarshalSyncDataError, error) {
+	var retval __premarshalSyncDataError
+
+	retval.Code = v.GqlError.Code
+	retval.Message = v.GqlError.Message
+	return &retval, nil
+}
+
+// SyncResponse is returned by Sync on success.
+type SyncResponse struct {
+	// Returns a Sync by ID.
+	Sync *SyncSync `json:"sync"`
+}
+
+// GetSync returns SyncResponse.Sync, and is useful for accessing the field via an interface.
+func (v *SyncResponse) GetSync() *SyncSync { return v.Sync }
+
+// The status of a Sync.
+type SyncStatus string
+
+const (
+	// Propel is actively syncing records contained within the Sync.
+	SyncStatusSyncing SyncStatus = "SYNCING"
+	// The Sync succeeded. Propel successfully synced all records contained within the Sync.
+	SyncStatusSucceeded SyncStatus = "SUCCEEDED"
+	// The Sync failed. Propel failed to sync some or all records contained within the Sync.
+	SyncStatusFailed SyncStatus = "FAILED"
+	// Propel is deleting the Sync.
+	SyncStatusDeleting SyncStatus = "DELETING"
+)
+
+// SyncSync includes the requested fields of the GraphQL type Sync.
+// The GraphQL type's documentation follows.
+//
+// The Sync object.
+//
+// This represents the process of syncing data from your Data Source (for example, a Snowflake data warehouse) to your Data Pool.
+type SyncSync struct {
+	SyncData `json:"-"`
+}
+
+// GetId returns SyncSync.Id, and is useful for accessing the field via an interface.
+func (v *SyncSync) GetId() string { return v.SyncData.Id }
+
+// GetDataPool returns SyncSync.DataPool, and is useful for accessing the field via an interface.
+func (v *SyncSync) GetDataPool() *SyncDataDataPool { return v.SyncData.DataPool }
+
+// GetStatus returns SyncSync.Status, and is useful for accessing the field via an interface.
+func (v *SyncSync) GetStatus() SyncStatus { return v.SyncData.Status }
+
+// GetProcessedRecords returns SyncSync.ProcessedRecords, and is useful for accessing the field via an interface.
+func (v *SyncSync) GetProcessedRecords() *string { return v.SyncData.ProcessedRecords }
+
+// GetSize returns SyncSync.Size, and is useful for accessing the field via an interface.
+func (v *SyncSync) GetSize() *string { return v.SyncData.Size }
+
+// GetStartedAt returns SyncSync.StartedAt, and is useful for accessing the field via an interface.
+func (v *SyncSync) GetStartedAt() *time.Time { return v.SyncData.StartedAt }
+
+// GetSucceededAt returns SyncSync.SucceededAt, and is useful for accessing the field via an interface.
+func (v *SyncSync) GetSucceededAt() *time.Time { return v.SyncData.SucceededAt }
+
+// GetFailedAt returns SyncSync.FailedAt, and is useful for accessing the field via an interface.
+func (v *SyncSync) GetFailedAt() *time.Time { return v.SyncData.FailedAt }
+
+// GetError returns SyncSync.Error, and is useful for accessing the field via an interface.
+func (v *SyncSync) GetError() *SyncDataError { return v.SyncData.Error }
+
+// GetCreatedAt returns SyncSync.CreatedAt, and is useful for accessing the field via an interface.
+func (v *SyncSync) GetCreatedAt() time.Time { return v.SyncData.CreatedAt }
+
+// GetModifiedAt returns SyncSync.ModifiedAt, and is useful for accessing the field via an interface.
+func (v *SyncSync) GetModifiedAt() time.Time { return v.SyncData.ModifiedAt }
+
+// GetCreatedBy returns SyncSync.CreatedBy, and is useful for accessing the field via an interface.
+func (v *SyncSync) GetCreatedBy() string { return v.SyncData.CreatedBy }
+
+// GetModifiedBy returns SyncSync.ModifiedBy, and is useful for accessing the field via an interface.
+func (v *SyncSync) GetModifiedBy() string { return v.SyncData.ModifiedBy }
+
+func (v *SyncSync) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SyncSync
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SyncSync = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.SyncData)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalSyncSync struct {
+	Id string `json:"id"`
+
+	DataPool *SyncDataDataPool `json:"dataPool"`
+
+	Status SyncStatus `json:"status"`
+
+	ProcessedRecords *string `json:"processedRecords"`
+
+	Size *string `json:"size"`
+
+	StartedAt *time.Time `json:"startedAt"`
+
+	SucceededAt *time.Time `json:"succeededAt"`
+
+	FailedAt *time.Time `json:"failedAt"`
+
+	Error *SyncDataError `json:"error"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	ModifiedAt time.Time `json:"modifiedAt"`
+
+	CreatedBy string `json:"createdBy"`
+
+	ModifiedBy string `json:"modifiedBy"`
+}
+
+func (v *SyncSync) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SyncSync) __premarshalJSON() (*__premarshalSyncSync, error) {
+	var retval __premarshalSyncSync
+
+	retval.Id = v.SyncData.Id
+	retval.DataPool = v.SyncData.DataPool
+	retval.Status = v.SyncData.Status
+	retval.ProcessedRecords = v.SyncData.ProcessedRecords
+	retval.Size = v.SyncData.Size
+	retval.StartedAt = v.SyncData.StartedAt
+	retval.SucceededAt = v.SyncData.SucceededAt
+	retval.FailedAt = v.SyncData.FailedAt
+	retval.Error = v.SyncData.Error
+	retval.CreatedAt = v.SyncData.CreatedAt
+	retval.ModifiedAt = v.SyncData.ModifiedAt
+	retval.CreatedBy = v.SyncData.CreatedBy
+	retval.ModifiedBy = v.SyncData.ModifiedBy
+	return &retval, nil
+}
+
 // A Data Pool's table engine.
 type TableEngineInput struct {
 	// Field for specifying the MergeTree table engine.
@@ -19238,126 +23994,1059 @@ func (v *TableIntrospectionData) GetDataSource() *TableIntrospectionDataDataSour
 	return v.DataSource
 }
 
-// GetStatus returns TableIntrospectionData.Status, and is useful for accessing the field via an interface.
-func (v *TableIntrospectionData) GetStatus() TableIntrospectionStatus { return v.Status }
+// GetStatus returns TableIntrospectionData.Status, and is useful for accessing the field via an interface.
+func (v *TableIntrospectionData) GetStatus() TableIntrospectionStatus { return v.Status }
+
+// GetCreatedAt returns TableIntrospectionData.CreatedAt, and is useful for accessing the field via an interface.
+func (v *TableIntrospectionData) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetCreatedBy returns TableIntrospectionData.CreatedBy, and is useful for accessing the field via an interface.
+func (v *TableIntrospectionData) GetCreatedBy() string { return v.CreatedBy }
+
+// GetModifiedAt returns TableIntrospectionData.ModifiedAt, and is useful for accessing the field via an interface.
+func (v *TableIntrospectionData) GetModifiedAt() time.Time { return v.ModifiedAt }
+
+// GetModifiedBy returns TableIntrospectionData.ModifiedBy, and is useful for accessing the field via an interface.
+func (v *TableIntrospectionData) GetModifiedBy() string { return v.ModifiedBy }
+
+// GetNumTables returns TableIntrospectionData.NumTables, and is useful for accessing the field via an interface.
+func (v *TableIntrospectionData) GetNumTables() *int { return v.NumTables }
+
+// TableIntrospectionDataDataSource includes the requested fields of the GraphQL type DataSource.
+// The GraphQL type's documentation follows.
+//
+// The Data Source object.
+//
+// A Data Source is a connection to your data warehouse. It has the necessary connection details for Propel to access Snowflake or any other supported Data Source.
+type TableIntrospectionDataDataSource struct {
+	// The Data Source's unique identifier.
+	Id string `json:"id"`
+}
+
+// GetId returns TableIntrospectionDataDataSource.Id, and is useful for accessing the field via an interface.
+func (v *TableIntrospectionDataDataSource) GetId() string { return v.Id }
+
+// The status of a table introspection.
+type TableIntrospectionStatus string
+
+const (
+	// The table introspection has not started.
+	TableIntrospectionStatusNotStarted TableIntrospectionStatus = "NOT_STARTED"
+	// The table introspection has started.
+	TableIntrospectionStatusStarted TableIntrospectionStatus = "STARTED"
+	// The table introspection succeeded.
+	TableIntrospectionStatusSucceeded TableIntrospectionStatus = "SUCCEEDED"
+	// The table introspection failed.
+	TableIntrospectionStatusFailed TableIntrospectionStatus = "FAILED"
+)
+
+// TableSettingsData includes the GraphQL fields of TableSettings requested by the fragment TableSettingsData.
+// The GraphQL type's documentation follows.
+//
+// A Data Pool's table settings.
+//
+// These describe how the Data Pool's table is created in ClickHouse.
+type TableSettingsData struct {
+	// The ClickHouse table engine for the Data Pool's table.
+	Engine *TableSettingsDataEngineTableEngine `json:"-"`
+	// The PARTITION BY clause for the Data Pool's table.
+	PartitionBy []string `json:"partitionBy"`
+	// The PRIMARY KEY clause for the Data Pool's table.
+	PrimaryKey []string `json:"primaryKey"`
+	// The ORDER BY clause for the Data Pool's table.
+	OrderBy []string `json:"orderBy"`
+}
+
+// GetEngine returns TableSettingsData.Engine, and is useful for accessing the field via an interface.
+func (v *TableSettingsData) GetEngine() *TableSettingsDataEngineTableEngine { return v.Engine }
+
+// GetPartitionBy returns TableSettingsData.PartitionBy, and is useful for accessing the field via an interface.
+func (v *TableSettingsData) GetPartitionBy() []string { return v.PartitionBy }
+
+// GetPrimaryKey returns TableSettingsData.PrimaryKey, and is useful for accessing the field via an interface.
+func (v *TableSettingsData) GetPrimaryKey() []string { return v.PrimaryKey }
+
+// GetOrderBy returns TableSettingsData.OrderBy, and is useful for accessing the field via an interface.
+func (v *TableSettingsData) GetOrderBy() []string { return v.OrderBy }
+
+func (v *TableSettingsData) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*TableSettingsData
+		Engine json.RawMessage `json:"engine"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.TableSettingsData = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Engine
+		src := firstPass.Engine
+		if len(src) != 0 && string(src) != "null" {
+			*dst = new(TableSettingsDataEngineTableEngine)
+			err = __unmarshalTableSettingsDataEngineTableEngine(
+				src, *dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal TableSettingsData.Engine: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalTableSettingsData struct {
+	Engine json.RawMessage `json:"engine"`
+
+	PartitionBy []string `json:"partitionBy"`
+
+	PrimaryKey []string `json:"primaryKey"`
+
+	OrderBy []string `json:"orderBy"`
+}
+
+func (v *TableSettingsData) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *TableSettingsData) __premarshalJSON() (*__premarshalTableSettingsData, error) {
+	var retval __premarshalTableSettingsData
+
+	{
+
+		dst := &retval.Engine
+		src := v.Engine
+		if src != nil {
+			var err error
+			*dst, err = __marshalTableSettingsDataEngineTableEngine(
+				src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal TableSettingsData.Engine: %w", err)
+			}
+		}
+	}
+	retval.PartitionBy = v.PartitionBy
+	retval.PrimaryKey = v.PrimaryKey
+	retval.OrderBy = v.OrderBy
+	return &retval, nil
+}
+
+// TableSettingsDataEngineAggregatingMergeTreeTableEngine includes the requested fields of the GraphQL type AggregatingMergeTreeTableEngine.
+// The GraphQL type's documentation follows.
+//
+// Parameters for the AggregatingMergeTree table engine.
+type TableSettingsDataEngineAggregatingMergeTreeTableEngine struct {
+	Typename *string `json:"__typename"`
+	// The type is always `AGGREGATING_MERGE_TREE`.
+	Type TableEngineType `json:"type"`
+}
+
+// GetTypename returns TableSettingsDataEngineAggregatingMergeTreeTableEngine.Typename, and is useful for accessing the field via an interface.
+func (v *TableSettingsDataEngineAggregatingMergeTreeTableEngine) GetTypename() *string {
+	return v.Typename
+}
+
+// GetType returns TableSettingsDataEngineAggregatingMergeTreeTableEngine.Type, and is useful for accessing the field via an interface.
+func (v *TableSettingsDataEngineAggregatingMergeTreeTableEngine) GetType() TableEngineType {
+	return v.Type
+}
+
+// TableSettingsDataEngineMergeTreeTableEngine includes the requested fields of the GraphQL type MergeTreeTableEngine.
+// The GraphQL type's documentation follows.
+//
+// Parameters for the MergeTree table engine.
+type TableSettingsDataEngineMergeTreeTableEngine struct {
+	Typename *string `json:"__typename"`
+	// The type is always `MERGE_TREE`.
+	Type TableEngineType `json:"type"`
+}
+
+// GetTypename returns TableSettingsDataEngineMergeTreeTableEngine.Typename, and is useful for accessing the field via an interface.
+func (v *TableSettingsDataEngineMergeTreeTableEngine) GetTypename() *string { return v.Typename }
+
+// GetType returns TableSettingsDataEngineMergeTreeTableEngine.Type, and is useful for accessing the field via an interface.
+func (v *TableSettingsDataEngineMergeTreeTableEngine) GetType() TableEngineType { return v.Type }
+
+// TableSettingsDataEnginePostgreSqlTableEngine includes the requested fields of the GraphQL type PostgreSqlTableEngine.
+// The GraphQL type's documentation follows.
+//
+// Parameters for the PostgreSQL table engine.
+type TableSettingsDataEnginePostgreSqlTableEngine struct {
+	Typename *string `json:"__typename"`
+}
+
+// GetTypename returns TableSettingsDataEnginePostgreSqlTableEngine.Typename, and is useful for accessing the field via an interface.
+func (v *TableSettingsDataEnginePostgreSqlTableEngine) GetTypename() *string { return v.Typename }
+
+// TableSettingsDataEngineReplacingMergeTreeTableEngine includes the requested fields of the GraphQL type ReplacingMergeTreeTableEngine.
+// The GraphQL type's documentation follows.
+//
+// Parameters for the ReplacingMergeTree table engine.
+type TableSettingsDataEngineReplacingMergeTreeTableEngine struct {
+	Typename *string `json:"__typename"`
+	// The type is always `REPLACING_MERGE_TREE`.
+	Type TableEngineType `json:"type"`
+	// The `ver` parameter to the ReplacingMergeTree engine.
+	Ver *string `json:"ver"`
+}
+
+// GetTypename returns TableSettingsDataEngineReplacingMergeTreeTableEngine.Typename, and is useful for accessing the field via an interface.
+func (v *TableSettingsDataEngineReplacingMergeTreeTableEngine) GetTypename() *string {
+	return v.Typename
+}
+
+// GetType returns TableSettingsDataEngineReplacingMergeTreeTableEngine.Type, and is useful for accessing the field via an interface.
+func (v *TableSettingsDataEngineReplacingMergeTreeTableEngine) GetType() TableEngineType {
+	return v.Type
+}
+
+// GetVer returns TableSettingsDataEngineReplacingMergeTreeTableEngine.Ver, and is useful for accessing the field via an interface.
+func (v *TableSettingsDataEngineReplacingMergeTreeTableEngine) GetVer() *string { return v.Ver }
+
+// TableSettingsDataEngineSummingMergeTreeTableEngine includes the requested fields of the GraphQL type SummingMergeTreeTableEngine.
+// The GraphQL type's documentation follows.
+//
+// Parameters for the SummingMergeTree table engine.
+type TableSettingsDataEngineSummingMergeTreeTableEngine struct {
+	Typename *string `json:"__typename"`
+	// The type is always `SUMMING_MERGE_TREE`.
+	Type TableEngineType `json:"type"`
+	// The columns argument for the SummingMergeTree table engine
+	Columns []string `json:"columns"`
+}
+
+// GetTypename returns TableSettingsDataEngineSummingMergeTreeTableEngine.Typename, and is useful for accessing the field via an interface.
+func (v *TableSettingsDataEngineSummingMergeTreeTableEngine) GetTypename() *string { return v.Typename }
+
+// GetType returns TableSettingsDataEngineSummingMergeTreeTableEngine.Type, and is useful for accessing the field via an interface.
+func (v *TableSettingsDataEngineSummingMergeTreeTableEngine) GetType() TableEngineType { return v.Type }
+
+// GetColumns returns TableSettingsDataEngineSummingMergeTreeTableEngine.Columns, and is useful for accessing the field via an interface.
+func (v *TableSettingsDataEngineSummingMergeTreeTableEngine) GetColumns() []string { return v.Columns }
+
+// TableSettingsDataEngineTableEngine includes the requested fields of the GraphQL interface TableEngine.
+//
+// TableSettingsDataEngineTableEngine is implemented by the following types:
+// TableSettingsDataEngineAggregatingMergeTreeTableEngine
+// TableSettingsDataEngineMergeTreeTableEngine
+// TableSettingsDataEnginePostgreSqlTableEngine
+// TableSettingsDataEngineReplacingMergeTreeTableEngine
+// TableSettingsDataEngineSummingMergeTreeTableEngine
+// The GraphQL type's documentation follows.
+//
+// A Data Pool's table engine.
+type TableSettingsDataEngineTableEngine interface {
+	implementsGraphQLInterfaceTableSettingsDataEngineTableEngine()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+}
+
+func (v *TableSettingsDataEngineAggregatingMergeTreeTableEngine) implementsGraphQLInterfaceTableSettingsDataEngineTableEngine() {
+}
+func (v *TableSettingsDataEngineMergeTreeTableEngine) implementsGraphQLInterfaceTableSettingsDataEngineTableEngine() {
+}
+func (v *TableSettingsDataEnginePostgreSqlTableEngine) implementsGraphQLInterfaceTableSettingsDataEngineTableEngine() {
+}
+func (v *TableSettingsDataEngineReplacingMergeTreeTableEngine) implementsGraphQLInterfaceTableSettingsDataEngineTableEngine() {
+}
+func (v *TableSettingsDataEngineSummingMergeTreeTableEngine) implementsGraphQLInterfaceTableSettingsDataEngineTableEngine() {
+}
+
+func __unmarshalTableSettingsDataEngineTableEngine(b []byte, v *TableSettingsDataEngineTableEngine) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "AggregatingMergeTreeTableEngine":
+		*v = new(TableSettingsDataEngineAggregatingMergeTreeTableEngine)
+		return json.Unmarshal(b, *v)
+	case "MergeTreeTableEngine":
+		*v = new(TableSettingsDataEngineMergeTreeTableEngine)
+		return json.Unmarshal(b, *v)
+	case "PostgreSqlTableEngine":
+		*v = new(TableSettingsDataEnginePostgreSqlTableEngine)
+		return json.Unmarshal(b, *v)
+	case "ReplacingMergeTreeTableEngine":
+		*v = new(TableSettingsDataEngineReplacingMergeTreeTableEngine)
+		return json.Unmarshal(b, *v)
+	case "SummingMergeTreeTableEngine":
+		*v = new(TableSettingsDataEngineSummingMergeTreeTableEngine)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing TableEngine.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for TableSettingsDataEngineTableEngine: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalTableSettingsDataEngineTableEngine(v *TableSettingsDataEngineTableEngine) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *TableSettingsDataEngineAggregatingMergeTreeTableEngine:
+		typename = "AggregatingMergeTreeTableEngine"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*TableSettingsDataEngineAggregatingMergeTreeTableEngine
+		}{typename, v}
+		return json.Marshal(result)
+	case *TableSettingsDataEngineMergeTreeTableEngine:
+		typename = "MergeTreeTableEngine"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*TableSettingsDataEngineMergeTreeTableEngine
+		}{typename, v}
+		return json.Marshal(result)
+	case *TableSettingsDataEnginePostgreSqlTableEngine:
+		typename = "PostgreSqlTableEngine"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*TableSettingsDataEnginePostgreSqlTableEngine
+		}{typename, v}
+		return json.Marshal(result)
+	case *TableSettingsDataEngineReplacingMergeTreeTableEngine:
+		typename = "ReplacingMergeTreeTableEngine"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*TableSettingsDataEngineReplacingMergeTreeTableEngine
+		}{typename, v}
+		return json.Marshal(result)
+	case *TableSettingsDataEngineSummingMergeTreeTableEngine:
+		typename = "SummingMergeTreeTableEngine"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*TableSettingsDataEngineSummingMergeTreeTableEngine
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for TableSettingsDataEngineTableEngine: "%T"`, v)
+	}
+}
+
+// A Data Pool's table settings.
+//
+// These describe how the Data Pool's table is created in ClickHouse.
+type TableSettingsInput struct {
+	// The ClickHouse table engine for the Data Pool's table.
+	//
+	// This field is optional. A default will be chosen based on the Data Pool's `timestamp` and `uniqueId` values, if specified.
+	Engine *TableEngineInput `json:"engine,omitempty"`
+	// The PARTITION BY clause for the Data Pool's table.
+	//
+	// This field is optional. A default will be chosen based on the Data Pool's `timestamp` and `uniqueId` values, if specified.
+	PartitionBy []string `json:"partitionBy"`
+	// The PRIMARY KEY clause for the Data Pool's table.
+	//
+	// This field is optional. A default will be chosen based on the Data Pool's `timestamp` and `uniqueId` values, if specified.
+	PrimaryKey []string `json:"primaryKey"`
+	// The ORDER BY clause for the Data Pool's table.
+	//
+	// This field is optional. A default will be chosen based on the Data Pool's `timestamp` and `uniqueId` values, if specified.
+	OrderBy []string `json:"orderBy"`
+	// The TTL clause for the Data Pool's table.
+	Ttl *string `json:"ttl"`
+}
+
+// GetEngine returns TableSettingsInput.Engine, and is useful for accessing the field via an interface.
+func (v *TableSettingsInput) GetEngine() *TableEngineInput { return v.Engine }
+
+// GetPartitionBy returns TableSettingsInput.PartitionBy, and is useful for accessing the field via an interface.
+func (v *TableSettingsInput) GetPartitionBy() []string { return v.PartitionBy }
+
+// GetPrimaryKey returns TableSettingsInput.PrimaryKey, and is useful for accessing the field via an interface.
+func (v *TableSettingsInput) GetPrimaryKey() []string { return v.PrimaryKey }
+
+// GetOrderBy returns TableSettingsInput.OrderBy, and is useful for accessing the field via an interface.
+func (v *TableSettingsInput) GetOrderBy() []string { return v.OrderBy }
+
+// GetTtl returns TableSettingsInput.Ttl, and is useful for accessing the field via an interface.
+func (v *TableSettingsInput) GetTtl() *string { return v.Ttl }
+
+// TenantData includes the GraphQL fields of Tenant requested by the fragment TenantData.
+// The GraphQL type's documentation follows.
+//
+// A Data Pool's tenant ID column. The tenant ID column is used to control access to your data with access policies.
+type TenantData struct {
+	// The name of the column that represents the tenant ID.
+	ColumnName string `json:"columnName"`
+	// The tenant ID column's type.
+	Type string `json:"type"`
+}
+
+// GetColumnName returns TenantData.ColumnName, and is useful for accessing the field via an interface.
+func (v *TenantData) GetColumnName() string { return v.ColumnName }
+
+// GetType returns TenantData.Type, and is useful for accessing the field via an interface.
+func (v *TenantData) GetType() string { return v.Type }
+
+// The fields to specify the Data Pool's tenant ID column. The tenant ID column is used to control access to your data with access policies.
+type TenantInput struct {
+	// The name of the column that represents the tenant ID.
+	ColumnName string `json:"columnName"`
+}
+
+// GetColumnName returns TenantInput.ColumnName, and is useful for accessing the field via an interface.
+func (v *TenantInput) GetColumnName() string { return v.ColumnName }
+
+// TestDataSourceResponse is returned by TestDataSource on success.
+type TestDataSourceResponse struct {
+	// Tests that Propel can actually connect to the data warehouse. Updates the status.
+	TestDataSource *TestDataSourceTestDataSourceDataSourceOrFailureResponse `json:"-"`
+}
+
+// GetTestDataSource returns TestDataSourceResponse.TestDataSource, and is useful for accessing the field via an interface.
+func (v *TestDataSourceResponse) GetTestDataSource() *TestDataSourceTestDataSourceDataSourceOrFailureResponse {
+	return v.TestDataSource
+}
+
+func (v *TestDataSourceResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*TestDataSourceResponse
+		TestDataSource json.RawMessage `json:"testDataSource"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.TestDataSourceResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.TestDataSource
+		src := firstPass.TestDataSource
+		if len(src) != 0 && string(src) != "null" {
+			*dst = new(TestDataSourceTestDataSourceDataSourceOrFailureResponse)
+			err = __unmarshalTestDataSourceTestDataSourceDataSourceOrFailureResponse(
+				src, *dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal TestDataSourceResponse.TestDataSource: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalTestDataSourceResponse struct {
+	TestDataSource json.RawMessage `json:"testDataSource"`
+}
+
+func (v *TestDataSourceResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *TestDataSourceResponse) __premarshalJSON() (*__premarshalTestDataSourceResponse, error) {
+	var retval __premarshalTestDataSourceResponse
+
+	{
+
+		dst := &retval.TestDataSource
+		src := v.TestDataSource
+		if src != nil {
+			var err error
+			*dst, err = __marshalTestDataSourceTestDataSourceDataSourceOrFailureResponse(
+				src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal TestDataSourceResponse.TestDataSource: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// TestDataSourceTestDataSourceDataSourceOrFailureResponse includes the requested fields of the GraphQL interface DataSourceOrFailureResponse.
+//
+// TestDataSourceTestDataSourceDataSourceOrFailureResponse is implemented by the following types:
+// TestDataSourceTestDataSourceDataSourceResponse
+// TestDataSourceTestDataSourceFailureResponse
+// The GraphQL type's documentation follows.
+//
+// The result of a mutation which creates or modifies a DataSource.
+//
+// If successful, an `DataSourceResponse` will be returned; otherwise, a
+// `FailureResponse` will be returned.
+type TestDataSourceTestDataSourceDataSourceOrFailureResponse interface {
+	implementsGraphQLInterfaceTestDataSourceTestDataSourceDataSourceOrFailureResponse()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+}
+
+func (v *TestDataSourceTestDataSourceDataSourceResponse) implementsGraphQLInterfaceTestDataSourceTestDataSourceDataSourceOrFailureResponse() {
+}
+func (v *TestDataSourceTestDataSourceFailureResponse) implementsGraphQLInterfaceTestDataSourceTestDataSourceDataSourceOrFailureResponse() {
+}
+
+func __unmarshalTestDataSourceTestDataSourceDataSourceOrFailureResponse(b []byte, v *TestDataSourceTestDataSourceDataSourceOrFailureResponse) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "DataSourceResponse":
+		*v = new(TestDataSourceTestDataSourceDataSourceResponse)
+		return json.Unmarshal(b, *v)
+	case "FailureResponse":
+		*v = new(TestDataSourceTestDataSourceFailureResponse)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing DataSourceOrFailureResponse.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for TestDataSourceTestDataSourceDataSourceOrFailureResponse: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalTestDataSourceTestDataSourceDataSourceOrFailureResponse(v *TestDataSourceTestDataSourceDataSourceOrFailureResponse) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *TestDataSourceTestDataSourceDataSourceResponse:
+		typename = "DataSourceResponse"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*TestDataSourceTestDataSourceDataSourceResponse
+		}{typename, v}
+		return json.Marshal(result)
+	case *TestDataSourceTestDataSourceFailureResponse:
+		typename = "FailureResponse"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*TestDataSourceTestDataSourceFailureResponse
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for TestDataSourceTestDataSourceDataSourceOrFailureResponse: "%T"`, v)
+	}
+}
+
+// TestDataSourceTestDataSourceDataSourceResponse includes the requested fields of the GraphQL type DataSourceResponse.
+// The GraphQL type's documentation follows.
+//
+// The result of a mutation which creates or modifies a Data Source.
+type TestDataSourceTestDataSourceDataSourceResponse struct {
+	Typename *string `json:"__typename"`
+	// The Data Source which was created or modified.
+	DataSource *TestDataSourceTestDataSourceDataSourceResponseDataSource `json:"dataSource"`
+}
+
+// GetTypename returns TestDataSourceTestDataSourceDataSourceResponse.Typename, and is useful for accessing the field via an interface.
+func (v *TestDataSourceTestDataSourceDataSourceResponse) GetTypename() *string { return v.Typename }
+
+// GetDataSource returns TestDataSourceTestDataSourceDataSourceResponse.DataSource, and is useful for accessing the field via an interface.
+func (v *TestDataSourceTestDataSourceDataSourceResponse) GetDataSource() *TestDataSourceTestDataSourceDataSourceResponseDataSource {
+	return v.DataSource
+}
+
+// TestDataSourceTestDataSourceDataSourceResponseDataSource includes the requested fields of the GraphQL type DataSource.
+// The GraphQL type's documentation follows.
+//
+// The Data Source object.
+//
+// A Data Source is a connection to your data warehouse. It has the necessary connection details for Propel to access Snowflake or any other supported Data Source.
+type TestDataSourceTestDataSourceDataSourceResponseDataSource struct {
+	DataSourceData `json:"-"`
+}
+
+// GetId returns TestDataSourceTestDataSourceDataSourceResponseDataSource.Id, and is useful for accessing the field via an interface.
+func (v *TestDataSourceTestDataSourceDataSourceResponseDataSource) GetId() string {
+	return v.DataSourceData.Id
+}
+
+// GetType returns TestDataSourceTestDataSourceDataSourceResponseDataSource.Type, and is useful for accessing the field via an interface.
+func (v *TestDataSourceTestDataSourceDataSourceResponseDataSource) GetType() DataSourceType {
+	return v.DataSourceData.Type
+}
+
+// GetStatus returns TestDataSourceTestDataSourceDataSourceResponseDataSource.Status, and is useful for accessing the field via an interface.
+func (v *TestDataSourceTestDataSourceDataSourceResponseDataSource) GetStatus() DataSourceStatus {
+	return v.DataSourceData.Status
+}
+
+// GetError returns TestDataSourceTestDataSourceDataSourceResponseDataSource.Error, and is useful for accessing the field via an interface.
+func (v *TestDataSourceTestDataSourceDataSourceResponseDataSource) GetError() *DataSourceDataError {
+	return v.DataSourceData.Error
+}
+
+// GetDataPools returns TestDataSourceTestDataSourceDataSourceResponseDataSource.DataPools, and is useful for accessing the field via an interface.
+func (v *TestDataSourceTestDataSourceDataSourceResponseDataSource) GetDataPools() *DataSourceDataDataPoolsDataPoolConnection {
+	return v.DataSourceData.DataPools
+}
+
+// GetConnectionSettings returns TestDataSourceTestDataSourceDataSourceResponseDataSource.ConnectionSettings, and is useful for accessing the field via an interface.
+func (v *TestDataSourceTestDataSourceDataSourceResponseDataSource) GetConnectionSettings() DataSourceDataConnectionSettings {
+	return v.DataSourceData.ConnectionSettings
+}
+
+// GetTables returns TestDataSourceTestDataSourceDataSourceResponseDataSource.Tables, and is useful for accessing the field via an interface.
+func (v *TestDataSourceTestDataSourceDataSourceResponseDataSource) GetTables() *DataSourceDataTablesTableConnection {
+	return v.DataSourceData.Tables
+}
+
+// GetChecks returns TestDataSourceTestDataSourceDataSourceResponseDataSource.Checks, and is useful for accessing the field via an interface.
+func (v *TestDataSourceTestDataSourceDataSourceResponseDataSource) GetChecks() []*DataSourceDataChecksDataSourceCheck {
+	return v.DataSourceData.Checks
+}
+
+// GetTableIntrospections returns TestDataSourceTestDataSourceDataSourceResponseDataSource.TableIntrospections, and is useful for accessing the field via an interface.
+func (v *TestDataSourceTestDataSourceDataSourceResponseDataSource) GetTableIntrospections() *DataSourceDataTableIntrospectionsTableIntrospectionConnection {
+	return v.DataSourceData.TableIntrospections
+}
+
+// GetUniqueName returns TestDataSourceTestDataSourceDataSourceResponseDataSource.UniqueName, and is useful for accessing the field via an interface.
+func (v *TestDataSourceTestDataSourceDataSourceResponseDataSource) GetUniqueName() string {
+	return v.DataSourceData.CommonDataDataSource.UniqueName
+}
+
+// GetDescription returns TestDataSourceTestDataSourceDataSourceResponseDataSource.Description, and is useful for accessing the field via an interface.
+func (v *TestDataSourceTestDataSourceDataSourceResponseDataSource) GetDescription() string {
+	return v.DataSourceData.CommonDataDataSource.Description
+}
+
+// GetAccount returns TestDataSourceTestDataSourceDataSourceResponseDataSource.Account, and is useful for accessing the field via an interface.
+func (v *TestDataSourceTestDataSourceDataSourceResponseDataSource) GetAccount() *CommonDataAccount {
+	return v.DataSourceData.CommonDataDataSource.Account
+}
+
+// GetEnvironment returns TestDataSourceTestDataSourceDataSourceResponseDataSource.Environment, and is useful for accessing the field via an interface.
+func (v *TestDataSourceTestDataSourceDataSourceResponseDataSource) GetEnvironment() *CommonDataEnvironment {
+	return v.DataSourceData.CommonDataDataSource.Environment
+}
+
+// GetCreatedAt returns TestDataSourceTestDataSourceDataSourceResponseDataSource.CreatedAt, and is useful for accessing the field via an interface.
+func (v *TestDataSourceTestDataSourceDataSourceResponseDataSource) GetCreatedAt() time.Time {
+	return v.DataSourceData.CommonDataDataSource.CreatedAt
+}
+
+// GetModifiedAt returns TestDataSourceTestDataSourceDataSourceResponseDataSource.ModifiedAt, and is useful for accessing the field via an interface.
+func (v *TestDataSourceTestDataSourceDataSourceResponseDataSource) GetModifiedAt() time.Time {
+	return v.DataSourceData.CommonDataDataSource.ModifiedAt
+}
+
+// GetCreatedBy returns TestDataSourceTestDataSourceDataSourceResponseDataSource.CreatedBy, and is useful for accessing the field via an interface.
+func (v *TestDataSourceTestDataSourceDataSourceResponseDataSource) GetCreatedBy() string {
+	return v.DataSourceData.CommonDataDataSource.CreatedBy
+}
+
+// GetModifiedBy returns TestDataSourceTestDataSourceDataSourceResponseDataSource.ModifiedBy, and is useful for accessing the field via an interface.
+func (v *TestDataSourceTestDataSourceDataSourceResponseDataSource) GetModifiedBy() string {
+	return v.DataSourceData.CommonDataDataSource.ModifiedBy
+}
+
+func (v *TestDataSourceTestDataSourceDataSourceResponseDataSource) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*TestDataSourceTestDataSourceDataSourceResponseDataSource
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.TestDataSourceTestDataSourceDataSourceResponseDataSource = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.DataSourceData)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalTestDataSourceTestDataSourceDataSourceResponseDataSource struct {
+	Id string `json:"id"`
+
+	Type DataSourceType `json:"type"`
+
+	Status DataSourceStatus `json:"status"`
+
+	Error *DataSourceDataError `json:"error"`
+
+	DataPools *DataSourceDataDataPoolsDataPoolConnection `json:"dataPools"`
+
+	ConnectionSettings json.RawMessage `json:"connectionSettings"`
+
+	Tables *DataSourceDataTablesTableConnection `json:"tables"`
+
+	Checks []*DataSourceDataChecksDataSourceCheck `json:"checks"`
+
+	TableIntrospections *DataSourceDataTableIntrospectionsTableIntrospectionConnection `json:"tableIntrospections"`
+
+	UniqueName string `json:"uniqueName"`
+
+	Description string `json:"description"`
+
+	Account *CommonDataAccount `json:"account"`
+
+	Environment *CommonDataEnvironment `json:"environment"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	ModifiedAt time.Time `json:"modifiedAt"`
+
+	CreatedBy string `json:"createdBy"`
+
+	ModifiedBy string `json:"modifiedBy"`
+}
+
+func (v *TestDataSourceTestDataSourceDataSourceResponseDataSource) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *TestDataSourceTestDataSourceDataSourceResponseDataSource) __premarshalJSON() (*__premarshalTestDataSourceTestDataSourceDataSourceResponseDataSource, error) {
+	var retval __premarshalTestDataSourceTestDataSourceDataSourceResponseDataSource
+
+	retval.Id = v.DataSourceData.Id
+	retval.Type = v.DataSourceData.Type
+	retval.Status = v.DataSourceData.Status
+	retval.Error = v.DataSourceData.Error
+	retval.DataPools = v.DataSourceData.DataPools
+	{
+
+		dst := &retval.ConnectionSettings
+		src := v.DataSourceData.ConnectionSettings
+		var err error
+		*dst, err = __marshalDataSourceDataConnectionSettings(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal TestDataSourceTestDataSourceDataSourceResponseDataSource.DataSourceData.ConnectionSettings: %w", err)
+		}
+	}
+	retval.Tables = v.DataSourceData.Tables
+	retval.Checks = v.DataSourceData.Checks
+	retval.TableIntrospections = v.DataSourceData.TableIntrospections
+	retval.UniqueName = v.DataSourceData.CommonDataDataSource.UniqueName
+	retval.Description = v.DataSourceData.CommonDataDataSource.Description
+	retval.Account = v.DataSourceData.CommonDataDataSource.Account
+	retval.Environment = v.DataSourceData.CommonDataDataSource.Environment
+	retval.CreatedAt = v.DataSourceData.CommonDataDataSource.CreatedAt
+	retval.ModifiedAt = v.DataSourceData.CommonDataDataSource.ModifiedAt
+	retval.CreatedBy = v.DataSourceData.CommonDataDataSource.CreatedBy
+	retval.ModifiedBy = v.DataSourceData.CommonDataDataSource.ModifiedBy
+	return &retval, nil
+}
+
+// TestDataSourceTestDataSourceFailureResponse includes the requested fields of the GraphQL type FailureResponse.
+// The GraphQL type's documentation follows.
+//
+// The failure response object.
+type TestDataSourceTestDataSourceFailureResponse struct {
+	Typename *string `json:"__typename"`
+	// The error that caused the failure.
+	Error *TestDataSourceTestDataSourceFailureResponseError `json:"error"`
+}
+
+// GetTypename returns TestDataSourceTestDataSourceFailureResponse.Typename, and is useful for accessing the field via an interface.
+func (v *TestDataSourceTestDataSourceFailureResponse) GetTypename() *string { return v.Typename }
+
+// GetError returns TestDataSourceTestDataSourceFailureResponse.Error, and is useful for accessing the field via an interface.
+func (v *TestDataSourceTestDataSourceFailureResponse) GetError() *TestDataSourceTestDataSourceFailureResponseError {
+	return v.Error
+}
+
+// TestDataSourceTestDataSourceFailureResponseError includes the requested fields of the GraphQL type Error.
+// The GraphQL type's documentation follows.
+//
+// The error object.
+type TestDataSourceTestDataSourceFailureResponseError struct {
+	GqlError `json:"-"`
+}
+
+// GetCode returns TestDataSourceTestDataSourceFailureResponseError.Code, and is useful for accessing the field via an interface.
+func (v *TestDataSourceTestDataSourceFailureResponseError) GetCode() *int { return v.GqlError.Code }
+
+// GetMessage returns TestDataSourceTestDataSourceFailureResponseError.Message, and is useful for accessing the field via an interface.
+func (v *TestDataSourceTestDataSourceFailureResponseError) GetMessage() string {
+	return v.GqlError.Message
+}
+
+func (v *TestDataSourceTestDataSourceFailureResponseError) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*TestDataSourceTestDataSourceFailureResponseError
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.TestDataSourceTestDataSourceFailureResponseError = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.GqlError)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalTestDataSourceTestDataSourceFailureResponseError struct {
+	Code *int `json:"code"`
+
+	Message string `json:"message"`
+}
+
+func (v *TestDataSourceTestDataSourceFailureResponseError) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *TestDataSourceTestDataSourceFailureResponseError) __premarshalJSON() (*__premarshalTestDataSourceTestDataSourceFailureResponseError, error) {
+	var retval __premarshalTestDataSourceTestDataSourceFailureResponseError
+
+	retval.Code = v.GqlError.Code
+	retval.Message = v.GqlError.Message
+	return &retval, nil
+}
+
+// TimestampData includes the GraphQL fields of Timestamp requested by the fragment TimestampData.
+// The GraphQL type's documentation follows.
+//
+// A Data Pool's primary timestamp column. Propel uses the primary timestamp to order and partition your data in Data Pools. It will serve as the time dimension for your Metrics.
+type TimestampData struct {
+	// The name of the column that represents the primary timestamp.
+	ColumnName string `json:"columnName"`
+	// The primary timestamp column's type.
+	Type string `json:"type"`
+}
+
+// GetColumnName returns TimestampData.ColumnName, and is useful for accessing the field via an interface.
+func (v *TimestampData) GetColumnName() string { return v.ColumnName }
+
+// GetType returns TimestampData.Type, and is useful for accessing the field via an interface.
+func (v *TimestampData) GetType() string { return v.Type }
+
+// The fields to specify the Data Pool's primary timestamp column. Propel uses the primary timestamp to order and partition your data in Data Pools. It will serve as the time dimension for your Metrics.
+type TimestampInput struct {
+	// The name of the column that represents the primary timestamp.
+	ColumnName string `json:"columnName"`
+}
+
+// GetColumnName returns TimestampInput.ColumnName, and is useful for accessing the field via an interface.
+func (v *TimestampInput) GetColumnName() string { return v.ColumnName }
+
+// UnAssignDataPoolAccessPolicyResponse is returned by UnAssignDataPoolAccessPolicy on success.
+type UnAssignDataPoolAccessPolicyResponse struct {
+	// Unassign a Data Pool Access Policy from an Application.
+	//
+	// Once unassigned, whether the Application will be able to query the Data Pool is
+	// controlled by the Data Pool's `accessControlEnabled` property. If
+	// `accessControlEnabled` is true, the Application will no longer be able to query the
+	// Data Pool. If `accessControlEnabled` is false, the Application will be able to query
+	// *all* data in the Data Pool, unrestricted.
+	UnAssignDataPoolAccessPolicyFromApplication *string `json:"unAssignDataPoolAccessPolicyFromApplication"`
+}
+
+// GetUnAssignDataPoolAccessPolicyFromApplication returns UnAssignDataPoolAccessPolicyResponse.UnAssignDataPoolAccessPolicyFromApplication, and is useful for accessing the field via an interface.
+func (v *UnAssignDataPoolAccessPolicyResponse) GetUnAssignDataPoolAccessPolicyFromApplication() *string {
+	return v.UnAssignDataPoolAccessPolicyFromApplication
+}
+
+// The fields to specify the Data Pool's unique ID column. Propel uses the primary timestamp and a unique ID to compose a primary key for determining whether records should be inserted, deleted, or updated within the Data Pool.
+type UniqueIdInput struct {
+	// The name of the column that represents the unique ID.
+	ColumnName string `json:"columnName"`
+}
+
+// GetColumnName returns UniqueIdInput.ColumnName, and is useful for accessing the field via an interface.
+func (v *UniqueIdInput) GetColumnName() string { return v.ColumnName }
+
+// UpdateDataPoolRecordsJobData includes the GraphQL fields of UpdateDataPoolRecordsJob requested by the fragment UpdateDataPoolRecordsJobData.
+// The GraphQL type's documentation follows.
+//
+// UpdateDataPoolRecords Job scheduled for a specific Data Pool.
+// The Update Data Pool Records Job represents the asynchronous process of updating records
+// given some filters, inside a Data Pool. It tracks the process of updating records
+// until it is finished, showing the progress and the outcome when it is finished.
+type UpdateDataPoolRecordsJobData struct {
+	// The UpdateDataPoolRecords Job's ID
+	Id string `json:"id"`
+	// The Data Pool whose records will be updated by the UpdateDataPoolRecords Job
+	DataPool *UpdateDataPoolRecordsJobDataDataPool `json:"dataPool"`
+	// The current UpdateDataPoolRecords Job's status
+	Status JobStatus `json:"status"`
+	// The filters that will be used for updating data, in the form of SQL. Data matching the filters will be updated.
+	FilterSql *string `json:"filterSql"`
+	// Describes how the job will update the records.
+	Set []*UpdateDataPoolRecordsJobDataSetUpdateDataPoolRecordsJobSetColumn `json:"set"`
+	// The error that occurred while updating data, if any.
+	Error *UpdateDataPoolRecordsJobDataError `json:"error"`
+	// The current progress of the UpdateDataPoolRecords Job, from 0.0 to 1.0.
+	Progress float64 `json:"progress"`
+	// The time at which the UpdateDataPoolRecords Job started.
+	StartedAt *time.Time `json:"startedAt"`
+	// The time at which the UpdateDataPoolRecords Job succeeded.
+	SucceededAt *time.Time `json:"succeededAt"`
+	// The time at which the UpdateDataPoolRecords Job failed.
+	FailedAt *time.Time `json:"failedAt"`
+}
+
+// GetId returns UpdateDataPoolRecordsJobData.Id, and is useful for accessing the field via an interface.
+func (v *UpdateDataPoolRecordsJobData) GetId() string { return v.Id }
+
+// GetDataPool returns UpdateDataPoolRecordsJobData.DataPool, and is useful for accessing the field via an interface.
+func (v *UpdateDataPoolRecordsJobData) GetDataPool() *UpdateDataPoolRecordsJobDataDataPool {
+	return v.DataPool
+}
+
+// GetStatus returns UpdateDataPoolRecordsJobData.Status, and is useful for accessing the field via an interface.
+func (v *UpdateDataPoolRecordsJobData) GetStatus() JobStatus { return v.Status }
 
-// GetCreatedAt returns TableIntrospectionData.CreatedAt, and is useful for accessing the field via an interface.
-func (v *TableIntrospectionData) GetCreatedAt() time.Time { return v.CreatedAt }
+// GetFilterSql returns UpdateDataPoolRecordsJobData.FilterSql, and is useful for accessing the field via an interface.
+func (v *UpdateDataPoolRecordsJobData) GetFilterSql() *string { return v.FilterSql }
 
-// GetCreatedBy returns TableIntrospectionData.CreatedBy, and is useful for accessing the field via an interface.
-func (v *TableIntrospectionData) GetCreatedBy() string { return v.CreatedBy }
+// GetSet returns UpdateDataPoolRecordsJobData.Set, and is useful for accessing the field via an interface.
+func (v *UpdateDataPoolRecordsJobData) GetSet() []*UpdateDataPoolRecordsJobDataSetUpdateDataPoolRecordsJobSetColumn {
+	return v.Set
+}
 
-// GetModifiedAt returns TableIntrospectionData.ModifiedAt, and is useful for accessing the field via an interface.
-func (v *TableIntrospectionData) GetModifiedAt() time.Time { return v.ModifiedAt }
+// GetError returns UpdateDataPoolRecordsJobData.Error, and is useful for accessing the field via an interface.
+func (v *UpdateDataPoolRecordsJobData) GetError() *UpdateDataPoolRecordsJobDataError { return v.Error }
 
-// GetModifiedBy returns TableIntrospectionData.ModifiedBy, and is useful for accessing the field via an interface.
-func (v *TableIntrospectionData) GetModifiedBy() string { return v.ModifiedBy }
+// GetProgress returns UpdateDataPoolRecordsJobData.Progress, and is useful for accessing the field via an interface.
+func (v *UpdateDataPoolRecordsJobData) GetProgress() float64 { return v.Progress }
 
-// GetNumTables returns TableIntrospectionData.NumTables, and is useful for accessing the field via an interface.
-func (v *TableIntrospectionData) GetNumTables() *int { return v.NumTables }
+// GetStartedAt returns UpdateDataPoolRecordsJobData.StartedAt, and is useful for accessing the field via an interface.
+func (v *UpdateDataPoolRecordsJobData) GetStartedAt() *time.Time { return v.StartedAt }
 
-// TableIntrospectionDataDataSource includes the requested fields of the GraphQL type DataSource.
+// GetSucceededAt returns UpdateDataPoolRecordsJobData.SucceededAt, and is useful for accessing the field via an interface.
+func (v *UpdateDataPoolRecordsJobData) GetSucceededAt() *time.Time { return v.SucceededAt }
+
+// GetFailedAt returns UpdateDataPoolRecordsJobData.FailedAt, and is useful for accessing the field via an interface.
+func (v *UpdateDataPoolRecordsJobData) GetFailedAt() *time.Time { return v.FailedAt }
+
+// UpdateDataPoolRecordsJobDataDataPool includes the requested fields of the GraphQL type DataPool.
 // The GraphQL type's documentation follows.
 //
-// The Data Source object.
-//
-// A Data Source is a connection to your data warehouse. It has the necessary connection details for Propel to access Snowflake or any other supported Data Source.
-type TableIntrospectionDataDataSource struct {
-	// The Data Source's unique identifier.
+// The Data Pool object. Data Pools are Propel's high-speed data store and cache
+type UpdateDataPoolRecordsJobDataDataPool struct {
+	// The Data Pool's unique identifier.
 	Id string `json:"id"`
 }
 
-// GetId returns TableIntrospectionDataDataSource.Id, and is useful for accessing the field via an interface.
-func (v *TableIntrospectionDataDataSource) GetId() string { return v.Id }
-
-// The status of a table introspection.
-type TableIntrospectionStatus string
-
-const (
-	// The table introspection has not started.
-	TableIntrospectionStatusNotStarted TableIntrospectionStatus = "NOT_STARTED"
-	// The table introspection has started.
-	TableIntrospectionStatusStarted TableIntrospectionStatus = "STARTED"
-	// The table introspection succeeded.
-	TableIntrospectionStatusSucceeded TableIntrospectionStatus = "SUCCEEDED"
-	// The table introspection failed.
-	TableIntrospectionStatusFailed TableIntrospectionStatus = "FAILED"
-)
+// GetId returns UpdateDataPoolRecordsJobDataDataPool.Id, and is useful for accessing the field via an interface.
+func (v *UpdateDataPoolRecordsJobDataDataPool) GetId() string { return v.Id }
 
-// TableSettingsData includes the GraphQL fields of TableSettings requested by the fragment TableSettingsData.
+// UpdateDataPoolRecordsJobDataError includes the requested fields of the GraphQL type Error.
 // The GraphQL type's documentation follows.
 //
-// A Data Pool's table settings.
-//
-// These describe how the Data Pool's table is created in ClickHouse.
-type TableSettingsData struct {
-	// The ClickHouse table engine for the Data Pool's table.
-	Engine *TableSettingsDataEngineTableEngine `json:"-"`
-	// The PARTITION BY clause for the Data Pool's table.
-	PartitionBy []string `json:"partitionBy"`
-	// The PRIMARY KEY clause for the Data Pool's table.
-	PrimaryKey []string `json:"primaryKey"`
-	// The ORDER BY clause for the Data Pool's table.
-	OrderBy []string `json:"orderBy"`
+// The error object.
+type UpdateDataPoolRecordsJobDataError struct {
+	GqlError `json:"-"`
 }
 
-// GetEngine returns TableSettingsData.Engine, and is useful for accessing the field via an interface.
-func (v *TableSettingsData) GetEngine() *TableSettingsDataEngineTableEngine { return v.Engine }
-
-// GetPartitionBy returns TableSettingsData.PartitionBy, and is useful for accessing the field via an interface.
-func (v *TableSettingsData) GetPartitionBy() []string { return v.PartitionBy }
-
-// GetPrimaryKey returns TableSettingsData.PrimaryKey, and is useful for accessing the field via an interface.
-func (v *TableSettingsData) GetPrimaryKey() []string { return v.PrimaryKey }
+// GetCode returns UpdateDataPoolRecordsJobDataError.Code, and is useful for accessing the field via an interface.
+func (v *UpdateDataPoolRecordsJobDataError) GetCode() *int { return v.GqlError.Code }
 
-// GetOrderBy returns TableSettingsData.OrderBy, and is useful for accessing the field via an interface.
-func (v *TableSettingsData) GetOrderBy() []string { return v.OrderBy }
+// GetMessage returns UpdateDataPoolRecordsJobDataError.Message, and is useful for accessing the field via an interface.
+func (v *UpdateDataPoolRecordsJobDataError) GetMessage() string { return v.GqlError.Message }
 
-func (v *TableSettingsData) UnmarshalJSON(b []byte) error {
+func (v *UpdateDataPoolRecordsJobDataError) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*TableSettingsData
-		Engine json.RawMessage `json:"engine"`
+		*UpdateDataPoolRecordsJobDataError
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.TableSettingsData = v
+	firstPass.UpdateDataPoolRecordsJobDataError = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
 		return err
 	}
 
-	{
-		dst := &v.Engine
-		src := firstPass.Engine
-		if len(src) != 0 && string(src) != "null" {
-			*dst = new(TableSettingsDataEngineTableEngine)
-			err = __unmarshalTableSettingsDataEngineTableEngine(
-				src, *dst)
-			if err != nil {
-				return fmt.Errorf(
-					"unable to unmarshal TableSettingsData.Engine: %w", err)
-			}
-		}
+	err = json.Unmarshal(
+		b, &v.GqlError)
+	if err != nil {
+		return err
 	}
 	return nil
 }
 
-type __premarshalTableSettingsData struct {
-	Engine json.RawMessage `json:"engine"`
-
-	PartitionBy []string `json:"partitionBy"`
-
-	PrimaryKey []string `json:"primaryKey"`
+type __premarshalUpdateDataPoolRecordsJobDataError struct {
+	Code *int `json:"code"`
 
-	OrderBy []string `json:"orderBy"`
+	Message string `json:"message"`
 }
 
-func (v *TableSettingsData) MarshalJSON() ([]byte, error) {
+func (v *UpdateDataPoolRecordsJobDataError) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -19365,356 +25054,281 @@ func (v *TableSettingsData) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *TableSettingsData) __premarshalJSON() (*__premarshalTableSettingsData, error) {
-	var retval __premarshalTableSettingsData
-
-	{
+func (v *UpdateDataPoolRecordsJobDataError) __premarshalJSON() (*__premarshalUpdateDataPoolRecordsJobDataError, error) {
+	var retval __premarshalUpdateDataPoolRecordsJobDataError
 
-		dst := &retval.Engine
-		src := v.Engine
-		if src != nil {
-			var err error
-			*dst, err = __marshalTableSettingsDataEngineTableEngine(
-				src)
-			if err != nil {
-				return nil, fmt.Errorf(
-					"unable to marshal TableSettingsData.Engine: %w", err)
-			}
-		}
-	}
-	retval.PartitionBy = v.PartitionBy
-	retval.PrimaryKey = v.PrimaryKey
-	retval.OrderBy = v.OrderBy
+	retval.Code = v.GqlError.Code
+	retval.Message = v.GqlError.Message
 	return &retval, nil
 }
 
-// TableSettingsDataEngineAggregatingMergeTreeTableEngine includes the requested fields of the GraphQL type AggregatingMergeTreeTableEngine.
+// UpdateDataPoolRecordsJobDataSetUpdateDataPoolRecordsJobSetColumn includes the requested fields of the GraphQL type UpdateDataPoolRecordsJobSetColumn.
 // The GraphQL type's documentation follows.
 //
-// Parameters for the AggregatingMergeTree table engine.
-type TableSettingsDataEngineAggregatingMergeTreeTableEngine struct {
-	Typename *string `json:"__typename"`
-	// The type is always `AGGREGATING_MERGE_TREE`.
-	Type TableEngineType `json:"type"`
+// The fields for creating an Update Data Pool Records Job.
+//
+// ```
+// {
+// "column": "status",
+// "expression": "'completed'"
+// }
+//
+// {
+// "column": "counter",
+// "expression": "counter + 1"
+// }
+//
+// {
+// "column": "full_name",
+// "expression": "concat(first_name, ' ', last_name)"
+// }
+// ```
+type UpdateDataPoolRecordsJobDataSetUpdateDataPoolRecordsJobSetColumn struct {
+	// The name of the column to update.
+	Column string `json:"column"`
+	// The value to which the column will be updated. Once evaluated, it should be of the same data type as the column.
+	Expression string `json:"expression"`
 }
 
-// GetTypename returns TableSettingsDataEngineAggregatingMergeTreeTableEngine.Typename, and is useful for accessing the field via an interface.
-func (v *TableSettingsDataEngineAggregatingMergeTreeTableEngine) GetTypename() *string {
-	return v.Typename
+// GetColumn returns UpdateDataPoolRecordsJobDataSetUpdateDataPoolRecordsJobSetColumn.Column, and is useful for accessing the field via an interface.
+func (v *UpdateDataPoolRecordsJobDataSetUpdateDataPoolRecordsJobSetColumn) GetColumn() string {
+	return v.Column
 }
 
-// GetType returns TableSettingsDataEngineAggregatingMergeTreeTableEngine.Type, and is useful for accessing the field via an interface.
-func (v *TableSettingsDataEngineAggregatingMergeTreeTableEngine) GetType() TableEngineType {
-	return v.Type
+// GetExpression returns UpdateDataPoolRecordsJobDataSetUpdateDataPoolRecordsJobSetColumn.Expression, and is useful for accessing the field via an interface.
+func (v *UpdateDataPoolRecordsJobDataSetUpdateDataPoolRecordsJobSetColumn) GetExpression() string {
+	return v.Expression
 }
 
-// TableSettingsDataEngineMergeTreeTableEngine includes the requested fields of the GraphQL type MergeTreeTableEngine.
-// The GraphQL type's documentation follows.
-//
-// Parameters for the MergeTree table engine.
-type TableSettingsDataEngineMergeTreeTableEngine struct {
-	Typename *string `json:"__typename"`
-	// The type is always `MERGE_TREE`.
-	Type TableEngineType `json:"type"`
+// UpdateDataPoolRecordsJobResponse is returned by UpdateDataPoolRecordsJob on success.
+type UpdateDataPoolRecordsJobResponse struct {
+	// Returns the UpdateDataPoolRecords Job specified by the given ID.
+	//
+	// The UpdateDataPoolRecords Job represents the asynchronous process of updating
+	// records inside a Data Pool.
+	UpdateDataPoolRecordsJob *UpdateDataPoolRecordsJobUpdateDataPoolRecordsJob `json:"updateDataPoolRecordsJob"`
 }
 
-// GetTypename returns TableSettingsDataEngineMergeTreeTableEngine.Typename, and is useful for accessing the field via an interface.
-func (v *TableSettingsDataEngineMergeTreeTableEngine) GetTypename() *string { return v.Typename }
-
-// GetType returns TableSettingsDataEngineMergeTreeTableEngine.Type, and is useful for accessing the field via an interface.
-func (v *TableSettingsDataEngineMergeTreeTableEngine) GetType() TableEngineType { return v.Type }
+// GetUpdateDataPoolRecordsJob returns UpdateDataPoolRecordsJobResponse.UpdateDataPoolRecordsJob, and is useful for accessing the field via an interface.
+func (v *UpdateDataPoolRecordsJobResponse) GetUpdateDataPoolRecordsJob() *UpdateDataPoolRecordsJobUpdateDataPoolRecordsJob {
+	return v.UpdateDataPoolRecordsJob
+}
 
-// TableSettingsDataEnginePostgreSqlTableEngine includes the requested fields of the GraphQL type PostgreSqlTableEngine.
-// The GraphQL type's documentation follows.
+// The fields for creating an Update Data Pool Records Job.
 //
-// Parameters for the PostgreSQL table engine.
-type TableSettingsDataEnginePostgreSqlTableEngine struct {
-	Typename *string `json:"__typename"`
+// ```
+// {
+// "column": "status",
+// "expression": "'completed'"
+// }
+//
+// {
+// "column": "counter",
+// "expression": "counter + 1"
+// }
+//
+// {
+// "column": "full_name",
+// "expression": "concat(first_name, ' ', last_name)"
+// }
+// ```
+type UpdateDataPoolRecordsJobSetColumnInput struct {
+	// The name of the column to update.
+	Column string `json:"column"`
+	// The value to which the column will be updated. Once evaluated, it should be of the same data type as the column.
+	Expression string `json:"expression"`
 }
 
-// GetTypename returns TableSettingsDataEnginePostgreSqlTableEngine.Typename, and is useful for accessing the field via an interface.
-func (v *TableSettingsDataEnginePostgreSqlTableEngine) GetTypename() *string { return v.Typename }
+// GetColumn returns UpdateDataPoolRecordsJobSetColumnInput.Column, and is useful for accessing the field via an interface.
+func (v *UpdateDataPoolRecordsJobSetColumnInput) GetColumn() string { return v.Column }
 
-// TableSettingsDataEngineReplacingMergeTreeTableEngine includes the requested fields of the GraphQL type ReplacingMergeTreeTableEngine.
+// GetExpression returns UpdateDataPoolRecordsJobSetColumnInput.Expression, and is useful for accessing the field via an interface.
+func (v *UpdateDataPoolRecordsJobSetColumnInput) GetExpression() string { return v.Expression }
+
+// UpdateDataPoolRecordsJobUpdateDataPoolRecordsJob includes the requested fields of the GraphQL type UpdateDataPoolRecordsJob.
 // The GraphQL type's documentation follows.
 //
-// Parameters for the ReplacingMergeTree table engine.
-type TableSettingsDataEngineReplacingMergeTreeTableEngine struct {
-	Typename *string `json:"__typename"`
-	// The type is always `REPLACING_MERGE_TREE`.
-	Type TableEngineType `json:"type"`
-	// The `ver` parameter to the ReplacingMergeTree engine.
-	Ver *string `json:"ver"`
+// UpdateDataPoolRecords Job scheduled for a specific Data Pool.
+// The Update Data Pool Records Job represents the asynchronous process of updating records
+// given some filters, inside a Data Pool. It tracks the process of updating records
+// until it is finished, showing the progress and the outcome when it is finished.
+type UpdateDataPoolRecordsJobUpdateDataPoolRecordsJob struct {
+	UpdateDataPoolRecordsJobData `json:"-"`
 }
 
-// GetTypename returns TableSettingsDataEngineReplacingMergeTreeTableEngine.Typename, and is useful for accessing the field via an interface.
-func (v *TableSettingsDataEngineReplacingMergeTreeTableEngine) GetTypename() *string {
-	return v.Typename
+// GetId returns UpdateDataPoolRecordsJobUpdateDataPoolRecordsJob.Id, and is useful for accessing the field via an interface.
+func (v *UpdateDataPoolRecordsJobUpdateDataPoolRecordsJob) GetId() string {
+	return v.UpdateDataPoolRecordsJobData.Id
 }
 
-// GetType returns TableSettingsDataEngineReplacingMergeTreeTableEngine.Type, and is useful for accessing the field via an interface.
-func (v *TableSettingsDataEngineReplacingMergeTreeTableEngine) GetType() TableEngineType {
-	return v.Type
+// GetDataPool returns UpdateDataPoolRecordsJobUpdateDataPoolRecordsJob.DataPool, and is useful for accessing the field via an interface.
+func (v *UpdateDataPoolRecordsJobUpdateDataPoolRecordsJob) GetDataPool() *UpdateDataPoolRecordsJobDataDataPool {
+	return v.UpdateDataPoolRecordsJobData.DataPool
 }
 
-// GetVer returns TableSettingsDataEngineReplacingMergeTreeTableEngine.Ver, and is useful for accessing the field via an interface.
-func (v *TableSettingsDataEngineReplacingMergeTreeTableEngine) GetVer() *string { return v.Ver }
-
-// TableSettingsDataEngineSummingMergeTreeTableEngine includes the requested fields of the GraphQL type SummingMergeTreeTableEngine.
-// The GraphQL type's documentation follows.
-//
-// Parameters for the SummingMergeTree table engine.
-type TableSettingsDataEngineSummingMergeTreeTableEngine struct {
-	Typename *string `json:"__typename"`
-	// The type is always `SUMMING_MERGE_TREE`.
-	Type TableEngineType `json:"type"`
-	// The columns argument for the SummingMergeTree table engine
-	Columns []string `json:"columns"`
+// GetStatus returns UpdateDataPoolRecordsJobUpdateDataPoolRecordsJob.Status, and is useful for accessing the field via an interface.
+func (v *UpdateDataPoolRecordsJobUpdateDataPoolRecordsJob) GetStatus() JobStatus {
+	return v.UpdateDataPoolRecordsJobData.Status
 }
 
-// GetTypename returns TableSettingsDataEngineSummingMergeTreeTableEngine.Typename, and is useful for accessing the field via an interface.
-func (v *TableSettingsDataEngineSummingMergeTreeTableEngine) GetTypename() *string { return v.Typename }
-
-// GetType returns TableSettingsDataEngineSummingMergeTreeTableEngine.Type, and is useful for accessing the field via an interface.
-func (v *TableSettingsDataEngineSummingMergeTreeTableEngine) GetType() TableEngineType { return v.Type }
-
-// GetColumns returns TableSettingsDataEngineSummingMergeTreeTableEngine.Columns, and is useful for accessing the field via an interface.
-func (v *TableSettingsDataEngineSummingMergeTreeTableEngine) GetColumns() []string { return v.Columns }
+// GetFilterSql returns UpdateDataPoolRecordsJobUpdateDataPoolRecordsJob.FilterSql, and is useful for accessing the field via an interface.
+func (v *UpdateDataPoolRecordsJobUpdateDataPoolRecordsJob) GetFilterSql() *string {
+	return v.UpdateDataPoolRecordsJobData.FilterSql
+}
 
-// TableSettingsDataEngineTableEngine includes the requested fields of the GraphQL interface TableEngine.
-//
-// TableSettingsDataEngineTableEngine is implemented by the following types:
-// TableSettingsDataEngineAggregatingMergeTreeTableEngine
-// TableSettingsDataEngineMergeTreeTableEngine
-// TableSettingsDataEnginePostgreSqlTableEngine
-// TableSettingsDataEngineReplacingMergeTreeTableEngine
-// TableSettingsDataEngineSummingMergeTreeTableEngine
-// The GraphQL type's documentation follows.
-//
-// A Data Pool's table engine.
-type TableSettingsDataEngineTableEngine interface {
-	implementsGraphQLInterfaceTableSettingsDataEngineTableEngine()
-	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
-	GetTypename() *string
+// GetSet returns UpdateDataPoolRecordsJobUpdateDataPoolRecordsJob.Set, and is useful for accessing the field via an interface.
+func (v *UpdateDataPoolRecordsJobUpdateDataPoolRecordsJob) GetSet() []*UpdateDataPoolRecordsJobDataSetUpdateDataPoolRecordsJobSetColumn {
+	return v.UpdateDataPoolRecordsJobData.Set
 }
 
-func (v *TableSettingsDataEngineAggregatingMergeTreeTableEngine) implementsGraphQLInterfaceTableSettingsDataEngineTableEngine() {
+// GetError returns UpdateDataPoolRecordsJobUpdateDataPoolRecordsJob.Error, and is useful for accessing the field via an interface.
+func (v *UpdateDataPoolRecordsJobUpdateDataPoolRecordsJob) GetError() *UpdateDataPoolRecordsJobDataError {
+	return v.UpdateDataPoolRecordsJobData.Error
 }
-func (v *TableSettingsDataEngineMergeTreeTableEngine) implementsGraphQLInterfaceTableSettingsDataEngineTableEngine() {
+
+// GetProgress returns UpdateDataPoolRecordsJobUpdateDataPoolRecordsJob.Progress, and is useful for accessing the field via an interface.
+func (v *UpdateDataPoolRecordsJobUpdateDataPoolRecordsJob) GetProgress() float64 {
+	return v.UpdateDataPoolRecordsJobData.Progress
 }
-func (v *TableSettingsDataEnginePostgreSqlTableEngine) implementsGraphQLInterfaceTableSettingsDataEngineTableEngine() {
+
+// GetStartedAt returns UpdateDataPoolRecordsJobUpdateDataPoolRecordsJob.StartedAt, and is useful for accessing the field via an interface.
+func (v *UpdateDataPoolRecordsJobUpdateDataPoolRecordsJob) GetStartedAt() *time.Time {
+	return v.UpdateDataPoolRecordsJobData.StartedAt
 }
-func (v *TableSettingsDataEngineReplacingMergeTreeTableEngine) implementsGraphQLInterfaceTableSettingsDataEngineTableEngine() {
+
+// GetSucceededAt returns UpdateDataPoolRecordsJobUpdateDataPoolRecordsJob.SucceededAt, and is useful for accessing the field via an interface.
+func (v *UpdateDataPoolRecordsJobUpdateDataPoolRecordsJob) GetSucceededAt() *time.Time {
+	return v.UpdateDataPoolRecordsJobData.SucceededAt
 }
-func (v *TableSettingsDataEngineSummingMergeTreeTableEngine) implementsGraphQLInterfaceTableSettingsDataEngineTableEngine() {
+
+// GetFailedAt returns UpdateDataPoolRecordsJobUpdateDataPoolRecordsJob.FailedAt, and is useful for accessing the field via an interface.
+func (v *UpdateDataPoolRecordsJobUpdateDataPoolRecordsJob) GetFailedAt() *time.Time {
+	return v.UpdateDataPoolRecordsJobData.FailedAt
 }
 
-func __unmarshalTableSettingsDataEngineTableEngine(b []byte, v *TableSettingsDataEngineTableEngine) error {
+func (v *UpdateDataPoolRecordsJobUpdateDataPoolRecordsJob) UnmarshalJSON(b []byte) error {
+
 	if string(b) == "null" {
 		return nil
 	}
 
-	var tn struct {
-		TypeName string `json:"__typename"`
+	var firstPass struct {
+		*UpdateDataPoolRecordsJobUpdateDataPoolRecordsJob
+		graphql.NoUnmarshalJSON
 	}
-	err := json.Unmarshal(b, &tn)
+	firstPass.UpdateDataPoolRecordsJobUpdateDataPoolRecordsJob = v
+
+	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
 		return err
 	}
 
-	switch tn.TypeName {
-	case "AggregatingMergeTreeTableEngine":
-		*v = new(TableSettingsDataEngineAggregatingMergeTreeTableEngine)
-		return json.Unmarshal(b, *v)
-	case "MergeTreeTableEngine":
-		*v = new(TableSettingsDataEngineMergeTreeTableEngine)
-		return json.Unmarshal(b, *v)
-	case "PostgreSqlTableEngine":
-		*v = new(TableSettingsDataEnginePostgreSqlTableEngine)
-		return json.Unmarshal(b, *v)
-	case "ReplacingMergeTreeTableEngine":
-		*v = new(TableSettingsDataEngineReplacingMergeTreeTableEngine)
-		return json.Unmarshal(b, *v)
-	case "SummingMergeTreeTableEngine":
-		*v = new(TableSettingsDataEngineSummingMergeTreeTableEngine)
-		return json.Unmarshal(b, *v)
-	case "":
-		return fmt.Errorf(
-			"response was missing TableEngine.__typename")
-	default:
-		return fmt.Errorf(
-			`unexpected concrete type for TableSettingsDataEngineTableEngine: "%v"`, tn.TypeName)
+	err = json.Unmarshal(
+		b, &v.UpdateDataPoolRecordsJobData)
+	if err != nil {
+		return err
 	}
+	return nil
 }
 
-func __marshalTableSettingsDataEngineTableEngine(v *TableSettingsDataEngineTableEngine) ([]byte, error) {
+type __premarshalUpdateDataPoolRecordsJobUpdateDataPoolRecordsJob struct {
+	Id string `json:"id"`
 
-	var typename string
-	switch v := (*v).(type) {
-	case *TableSettingsDataEngineAggregatingMergeTreeTableEngine:
-		typename = "AggregatingMergeTreeTableEngine"
+	DataPool *UpdateDataPoolRecordsJobDataDataPool `json:"dataPool"`
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*TableSettingsDataEngineAggregatingMergeTreeTableEngine
-		}{typename, v}
-		return json.Marshal(result)
-	case *TableSettingsDataEngineMergeTreeTableEngine:
-		typename = "MergeTreeTableEngine"
+	Status JobStatus `json:"status"`
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*TableSettingsDataEngineMergeTreeTableEngine
-		}{typename, v}
-		return json.Marshal(result)
-	case *TableSettingsDataEnginePostgreSqlTableEngine:
-		typename = "PostgreSqlTableEngine"
+	FilterSql *string `json:"filterSql"`
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*TableSettingsDataEnginePostgreSqlTableEngine
-		}{typename, v}
-		return json.Marshal(result)
-	case *TableSettingsDataEngineReplacingMergeTreeTableEngine:
-		typename = "ReplacingMergeTreeTableEngine"
+	Set []*UpdateDataPoolRecordsJobDataSetUpdateDataPoolRecordsJobSetColumn `json:"set"`
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*TableSettingsDataEngineReplacingMergeTreeTableEngine
-		}{typename, v}
-		return json.Marshal(result)
-	case *TableSettingsDataEngineSummingMergeTreeTableEngine:
-		typename = "SummingMergeTreeTableEngine"
+	Error *UpdateDataPoolRecordsJobDataError `json:"error"`
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*TableSettingsDataEngineSummingMergeTreeTableEngine
-		}{typename, v}
-		return json.Marshal(result)
-	case nil:
-		return []byte("null"), nil
-	default:
-		return nil, fmt.Errorf(
-			`unexpected concrete type for TableSettingsDataEngineTableEngine: "%T"`, v)
-	}
-}
+	Progress float64 `json:"progress"`
 
-// A Data Pool's table settings.
-//
-// These describe how the Data Pool's table is created in ClickHouse.
-type TableSettingsInput struct {
-	// The ClickHouse table engine for the Data Pool's table.
-	//
-	// This field is optional. A default will be chosen based on the Data Pool's `timestamp` and `uniqueId` values, if specified.
-	Engine *TableEngineInput `json:"engine,omitempty"`
-	// The PARTITION BY clause for the Data Pool's table.
-	//
-	// This field is optional. A default will be chosen based on the Data Pool's `timestamp` and `uniqueId` values, if specified.
-	PartitionBy []string `json:"partitionBy"`
-	// The PRIMARY KEY clause for the Data Pool's table.
-	//
-	// This field is optional. A default will be chosen based on the Data Pool's `timestamp` and `uniqueId` values, if specified.
-	PrimaryKey []string `json:"primaryKey"`
-	// The ORDER BY clause for the Data Pool's table.
-	//
-	// This field is optional. A default will be chosen based on the Data Pool's `timestamp` and `uniqueId` values, if specified.
-	OrderBy []string `json:"orderBy"`
-	// The TTL clause for the Data Pool's table.
-	Ttl *string `json:"ttl"`
-}
+	StartedAt *time.Time `json:"startedAt"`
 
-// GetEngine returns TableSettingsInput.Engine, and is useful for accessing the field via an interface.
-func (v *TableSettingsInput) GetEngine() *TableEngineInput { return v.Engine }
+	SucceededAt *time.Time `json:"succeededAt"`
 
-// GetPartitionBy returns TableSettingsInput.PartitionBy, and is useful for accessing the field via an interface.
-func (v *TableSettingsInput) GetPartitionBy() []string { return v.PartitionBy }
+	FailedAt *time.Time `json:"failedAt"`
+}
 
-// GetPrimaryKey returns TableSettingsInput.PrimaryKey, and is useful for accessing the field via an interface.
-func (v *TableSettingsInput) GetPrimaryKey() []string { return v.PrimaryKey }
+func (v *UpdateDataPoolRecordsJobUpdateDataPoolRecordsJob) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
 
-// GetOrderBy returns TableSettingsInput.OrderBy, and is useful for accessing the field via an interface.
-func (v *TableSettingsInput) GetOrderBy() []string { return v.OrderBy }
+func (v *UpdateDataPoolRecordsJobUpdateDataPoolRecordsJob) __premarshalJSON() (*__premarshalUpdateDataPoolRecordsJobUpdateDataPoolRecordsJob, error) {
+	var retval __premarshalUpdateDataPoolRecordsJobUpdateDataPoolRecordsJob
 
-// GetTtl returns TableSettingsInput.Ttl, and is useful for accessing the field via an interface.
-func (v *TableSettingsInput) GetTtl() *string { return v.Ttl }
+	retval.Id = v.UpdateDataPoolRecordsJobData.Id
+	retval.DataPool = v.UpdateDataPoolRecordsJobData.DataPool
+	retval.Status = v.UpdateDataPoolRecordsJobData.Status
+	retval.FilterSql = v.UpdateDataPoolRecordsJobData.FilterSql
+	retval.Set = v.UpdateDataPoolRecordsJobData.Set
+	retval.Error = v.UpdateDataPoolRecordsJobData.Error
+	retval.Progress = v.UpdateDataPoolRecordsJobData.Progress
+	retval.StartedAt = v.UpdateDataPoolRecordsJobData.StartedAt
+	retval.SucceededAt = v.UpdateDataPoolRecordsJobData.SucceededAt
+	retval.FailedAt = v.UpdateDataPoolRecordsJobData.FailedAt
+	return &retval, nil
+}
 
-// TenantData includes the GraphQL fields of Tenant requested by the fragment TenantData.
+// ValidateExpressionDataPool includes the requested fields of the GraphQL type DataPool.
 // The GraphQL type's documentation follows.
 //
-// A Data Pool's tenant ID column. The tenant ID column is used to control access to your data with access policies.
-type TenantData struct {
-	// The name of the column that represents the tenant ID.
-	ColumnName string `json:"columnName"`
-	// The tenant ID column's type.
-	Type string `json:"type"`
+// The Data Pool object. Data Pools are Propel's high-speed data store and cache
+type ValidateExpressionDataPool struct {
+	// The Data Pool's unique identifier.
+	Id string `json:"id"`
+	// Validates a custom expression against the Data Pool's available columns. If the provided expression is invalid, the ValidateExpressionResult response will contain a reason explaining why.
+	ValidateExpression *ValidateExpressionDataPoolValidateExpressionValidateExpressionResult `json:"validateExpression"`
 }
 
-// GetColumnName returns TenantData.ColumnName, and is useful for accessing the field via an interface.
-func (v *TenantData) GetColumnName() string { return v.ColumnName }
-
-// GetType returns TenantData.Type, and is useful for accessing the field via an interface.
-func (v *TenantData) GetType() string { return v.Type }
+// GetId returns ValidateExpressionDataPool.Id, and is useful for accessing the field via an interface.
+func (v *ValidateExpressionDataPool) GetId() string { return v.Id }
 
-// The fields to specify the Data Pool's tenant ID column. The tenant ID column is used to control access to your data with access policies.
-type TenantInput struct {
-	// The name of the column that represents the tenant ID.
-	ColumnName string `json:"columnName"`
+// GetValidateExpression returns ValidateExpressionDataPool.ValidateExpression, and is useful for accessing the field via an interface.
+func (v *ValidateExpressionDataPool) GetValidateExpression() *ValidateExpressionDataPoolValidateExpressionValidateExpressionResult {
+	return v.ValidateExpression
 }
 
-// GetColumnName returns TenantInput.ColumnName, and is useful for accessing the field via an interface.
-func (v *TenantInput) GetColumnName() string { return v.ColumnName }
-
-// TimestampData includes the GraphQL fields of Timestamp requested by the fragment TimestampData.
+// ValidateExpressionDataPoolValidateExpressionValidateExpressionResult includes the requested fields of the GraphQL type ValidateExpressionResult.
 // The GraphQL type's documentation follows.
 //
-// A Data Pool's primary timestamp column. Propel uses the primary timestamp to order and partition your data in Data Pools. It will serve as the time dimension for your Metrics.
-type TimestampData struct {
-	// The name of the column that represents the primary timestamp.
-	ColumnName string `json:"columnName"`
-	// The primary timestamp column's type.
-	Type string `json:"type"`
-}
-
-// GetColumnName returns TimestampData.ColumnName, and is useful for accessing the field via an interface.
-func (v *TimestampData) GetColumnName() string { return v.ColumnName }
-
-// GetType returns TimestampData.Type, and is useful for accessing the field via an interface.
-func (v *TimestampData) GetType() string { return v.Type }
-
-// The fields to specify the Data Pool's primary timestamp column. Propel uses the primary timestamp to order and partition your data in Data Pools. It will serve as the time dimension for your Metrics.
-type TimestampInput struct {
-	// The name of the column that represents the primary timestamp.
-	ColumnName string `json:"columnName"`
+// Response returned by the validateExpression query for validating expressions in Custom Metrics.
+//
+// Returns whether the expression is valid or not with a reason explaining why.
+type ValidateExpressionDataPoolValidateExpressionValidateExpressionResult struct {
+	// True if the expression is valid, false otherwise.
+	Valid bool `json:"valid"`
+	// The reason for why the expression is not valid in case it isn't, null otherwise.
+	Reason *string `json:"reason"`
 }
 
-// GetColumnName returns TimestampInput.ColumnName, and is useful for accessing the field via an interface.
-func (v *TimestampInput) GetColumnName() string { return v.ColumnName }
-
-// UnAssignDataPoolAccessPolicyResponse is returned by UnAssignDataPoolAccessPolicy on success.
-type UnAssignDataPoolAccessPolicyResponse struct {
-	// Unassign a Data Pool Access Policy from an Application.
-	//
-	// Once unassigned, whether the Application will be able to query the Data Pool is
-	// controlled by the Data Pool's `accessControlEnabled` property. If
-	// `accessControlEnabled` is true, the Application will no longer be able to query the
-	// Data Pool. If `accessControlEnabled` is false, the Application will be able to query
-	// *all* data in the Data Pool, unrestricted.
-	UnAssignDataPoolAccessPolicyFromApplication *string `json:"unAssignDataPoolAccessPolicyFromApplication"`
+// GetValid returns ValidateExpressionDataPoolValidateExpressionValidateExpressionResult.Valid, and is useful for accessing the field via an interface.
+func (v *ValidateExpressionDataPoolValidateExpressionValidateExpressionResult) GetValid() bool {
+	return v.Valid
 }
 
-// GetUnAssignDataPoolAccessPolicyFromApplication returns UnAssignDataPoolAccessPolicyResponse.UnAssignDataPoolAccessPolicyFromApplication, and is useful for accessing the field via an interface.
-func (v *UnAssignDataPoolAccessPolicyResponse) GetUnAssignDataPoolAccessPolicyFromApplication() *string {
-	return v.UnAssignDataPoolAccessPolicyFromApplication
+// GetReason returns ValidateExpressionDataPoolValidateExpressionValidateExpressionResult.Reason, and is useful for accessing the field via an interface.
+func (v *ValidateExpressionDataPoolValidateExpressionValidateExpressionResult) GetReason() *string {
+	return v.Reason
 }
 
-// The fields to specify the Data Pool's unique ID column. Propel uses the primary timestamp and a unique ID to compose a primary key for determining whether records should be inserted, deleted, or updated within the Data Pool.
-type UniqueIdInput struct {
-	// The name of the column that represents the unique ID.
-	ColumnName string `json:"columnName"`
+// ValidateExpressionResponse is returned by ValidateExpression on success.
+type ValidateExpressionResponse struct {
+	// Returns the Data Pool specified by the given ID.
+	//
+	// A Data Pool is a cached table hydrated from your data warehouse optimized for high-concurrency and low-latency queries.
+	DataPool *ValidateExpressionDataPool `json:"dataPool"`
 }
 
-// GetColumnName returns UniqueIdInput.ColumnName, and is useful for accessing the field via an interface.
-func (v *UniqueIdInput) GetColumnName() string { return v.ColumnName }
+// GetDataPool returns ValidateExpressionResponse.DataPool, and is useful for accessing the field via an interface.
+func (v *ValidateExpressionResponse) GetDataPool() *ValidateExpressionDataPool { return v.DataPool }
 
 // The Webhook Data Source connection settings.
 type WebhookConnectionSettingsInput struct {
@@ -19792,6 +25406,22 @@ func (v *WebhookDataSourceColumnInput) GetType() ColumnType { return v.Type }
 // GetNullable returns WebhookDataSourceColumnInput.Nullable, and is useful for accessing the field via an interface.
 func (v *WebhookDataSourceColumnInput) GetNullable() bool { return v.Nullable }
 
+// __AddColumnToDataPoolJobByStatusInput is used internally by genqlient
+type __AddColumnToDataPoolJobByStatusInput struct {
+	Status JobStatus `json:"status"`
+	First  *int      `json:"first"`
+	After  *string   `json:"after"`
+}
+
+// GetStatus returns __AddColumnToDataPoolJobByStatusInput.Status, and is useful for accessing the field via an interface.
+func (v *__AddColumnToDataPoolJobByStatusInput) GetStatus() JobStatus { return v.Status }
+
+// GetFirst returns __AddColumnToDataPoolJobByStatusInput.First, and is useful for accessing the field via an interface.
+func (v *__AddColumnToDataPoolJobByStatusInput) GetFirst() *int { return v.First }
+
+// GetAfter returns __AddColumnToDataPoolJobByStatusInput.After, and is useful for accessing the field via an interface.
+func (v *__AddColumnToDataPoolJobByStatusInput) GetAfter() *string { return v.After }
+
 // __AddColumnToDataPoolJobInput is used internally by genqlient
 type __AddColumnToDataPoolJobInput struct {
 	Id string `json:"id"`
@@ -19800,6 +25430,14 @@ type __AddColumnToDataPoolJobInput struct {
 // GetId returns __AddColumnToDataPoolJobInput.Id, and is useful for accessing the field via an interface.
 func (v *__AddColumnToDataPoolJobInput) GetId() string { return v.Id }
 
+// __ApplicationByNameInput is used internally by genqlient
+type __ApplicationByNameInput struct {
+	UniqueName string `json:"uniqueName"`
+}
+
+// GetUniqueName returns __ApplicationByNameInput.UniqueName, and is useful for accessing the field via an interface.
+func (v *__ApplicationByNameInput) GetUniqueName() string { return v.UniqueName }
+
 // __ApplicationInput is used internally by genqlient
 type __ApplicationInput struct {
 	Id string `json:"id"`
@@ -19822,6 +25460,14 @@ func (v *__AssignDataPoolAccessPolicyInput) GetDataPoolAccessPolicy() string {
 	return v.DataPoolAccessPolicy
 }
 
+// __BoosterInput is used internally by genqlient
+type __BoosterInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __BoosterInput.Id, and is useful for accessing the field via an interface.
+func (v *__BoosterInput) GetId() string { return v.Id }
+
 // __CreateAddColumnToDataPoolJobInput is used internally by genqlient
 type __CreateAddColumnToDataPoolJobInput struct {
 	Input *CreateAddColumnToDataPoolJobInput `json:"input,omitempty"`
@@ -19848,6 +25494,14 @@ type __CreateAverageMetricInput struct {
 // GetInput returns __CreateAverageMetricInput.Input, and is useful for accessing the field via an interface.
 func (v *__CreateAverageMetricInput) GetInput() *CreateAverageMetricInput { return v.Input }
 
+// __CreateBoosterInput is used internally by genqlient
+type __CreateBoosterInput struct {
+	Input *CreateBoosterInput `json:"input,omitempty"`
+}
+
+// GetInput returns __CreateBoosterInput.Input, and is useful for accessing the field via an interface.
+func (v *__CreateBoosterInput) GetInput() *CreateBoosterInput { return v.Input }
+
 // __CreateClickHouseDataSourceInput is used internally by genqlient
 type __CreateClickHouseDataSourceInput struct {
 	Input *CreateClickHouseDataSourceInput `json:"input,omitempty"`
@@ -19900,6 +25554,22 @@ type __CreateDataPoolInput struct {
 // GetInput returns __CreateDataPoolInput.Input, and is useful for accessing the field via an interface.
 func (v *__CreateDataPoolInput) GetInput() *CreateDataPoolInputV2 { return v.Input }
 
+// __CreateDeletionJobInput is used internally by genqlient
+type __CreateDeletionJobInput struct {
+	Input *CreateDeletionJobInput `json:"input,omitempty"`
+}
+
+// GetInput returns __CreateDeletionJobInput.Input, and is useful for accessing the field via an interface.
+func (v *__CreateDeletionJobInput) GetInput() *CreateDeletionJobInput { return v.Input }
+
+// __CreateEnvironmentInput is used internally by genqlient
+type __CreateEnvironmentInput struct {
+	Input *CreateEnvironmentInput `json:"input,omitempty"`
+}
+
+// GetInput returns __CreateEnvironmentInput.Input, and is useful for accessing the field via an interface.
+func (v *__CreateEnvironmentInput) GetInput() *CreateEnvironmentInput { return v.Input }
+
 // __CreateHttpDataSourceInput is used internally by genqlient
 type __CreateHttpDataSourceInput struct {
 	Input *CreateHttpDataSourceInput `json:"input,omitempty"`
@@ -19948,6 +25618,16 @@ type __CreatePolicyInput struct {
 // GetInput returns __CreatePolicyInput.Input, and is useful for accessing the field via an interface.
 func (v *__CreatePolicyInput) GetInput() *CreatePolicyInput { return v.Input }
 
+// __CreatePostgreSqlDataSourceInput is used internally by genqlient
+type __CreatePostgreSqlDataSourceInput struct {
+	Input *CreatePostgreSqlDataSourceInput `json:"input,omitempty"`
+}
+
+// GetInput returns __CreatePostgreSqlDataSourceInput.Input, and is useful for accessing the field via an interface.
+func (v *__CreatePostgreSqlDataSourceInput) GetInput() *CreatePostgreSqlDataSourceInput {
+	return v.Input
+}
+
 // __CreateS3DataSourceInput is used internally by genqlient
 type __CreateS3DataSourceInput struct {
 	Input *CreateS3DataSourceInput `json:"input,omitempty"`
@@ -19972,6 +25652,16 @@ type __CreateSumMetricInput struct {
 // GetInput returns __CreateSumMetricInput.Input, and is useful for accessing the field via an interface.
 func (v *__CreateSumMetricInput) GetInput() *CreateSumMetricInput { return v.Input }
 
+// __CreateUpdateDataPoolRecordsJobInput is used internally by genqlient
+type __CreateUpdateDataPoolRecordsJobInput struct {
+	Input *CreateUpdateDataPoolRecordsJobInput `json:"input,omitempty"`
+}
+
+// GetInput returns __CreateUpdateDataPoolRecordsJobInput.Input, and is useful for accessing the field via an interface.
+func (v *__CreateUpdateDataPoolRecordsJobInput) GetInput() *CreateUpdateDataPoolRecordsJobInput {
+	return v.Input
+}
+
 // __CreateWebhookDataSourceInput is used internally by genqlient
 type __CreateWebhookDataSourceInput struct {
 	Input *CreateWebhookDataSourceInput `json:"input,omitempty"`
@@ -19980,6 +25670,22 @@ type __CreateWebhookDataSourceInput struct {
 // GetInput returns __CreateWebhookDataSourceInput.Input, and is useful for accessing the field via an interface.
 func (v *__CreateWebhookDataSourceInput) GetInput() *CreateWebhookDataSourceInput { return v.Input }
 
+// __DataPoolAccessPoliciesInput is used internally by genqlient
+type __DataPoolAccessPoliciesInput struct {
+	Id    string  `json:"id"`
+	First *int    `json:"first"`
+	After *string `json:"after"`
+}
+
+// GetId returns __DataPoolAccessPoliciesInput.Id, and is useful for accessing the field via an interface.
+func (v *__DataPoolAccessPoliciesInput) GetId() string { return v.Id }
+
+// GetFirst returns __DataPoolAccessPoliciesInput.First, and is useful for accessing the field via an interface.
+func (v *__DataPoolAccessPoliciesInput) GetFirst() *int { return v.First }
+
+// GetAfter returns __DataPoolAccessPoliciesInput.After, and is useful for accessing the field via an interface.
+func (v *__DataPoolAccessPoliciesInput) GetAfter() *string { return v.After }
+
 // __DataPoolAccessPolicyInput is used internally by genqlient
 type __DataPoolAccessPolicyInput struct {
 	Id string `json:"id"`
@@ -20004,6 +25710,22 @@ type __DataPoolInput struct {
 // GetId returns __DataPoolInput.Id, and is useful for accessing the field via an interface.
 func (v *__DataPoolInput) GetId() string { return v.Id }
 
+// __DataPoolMetricsInput is used internally by genqlient
+type __DataPoolMetricsInput struct {
+	Id    string  `json:"id"`
+	First *int    `json:"first"`
+	After *string `json:"after"`
+}
+
+// GetId returns __DataPoolMetricsInput.Id, and is useful for accessing the field via an interface.
+func (v *__DataPoolMetricsInput) GetId() string { return v.Id }
+
+// GetFirst returns __DataPoolMetricsInput.First, and is useful for accessing the field via an interface.
+func (v *__DataPoolMetricsInput) GetFirst() *int { return v.First }
+
+// GetAfter returns __DataPoolMetricsInput.After, and is useful for accessing the field via an interface.
+func (v *__DataPoolMetricsInput) GetAfter() *string { return v.After }
+
 // __DataPoolsInput is used internally by genqlient
 type __DataPoolsInput struct {
 	First  *int    `json:"first"`
@@ -20068,6 +25790,14 @@ type __DeleteApplicationInput struct {
 // GetId returns __DeleteApplicationInput.Id, and is useful for accessing the field via an interface.
 func (v *__DeleteApplicationInput) GetId() string { return v.Id }
 
+// __DeleteBoosterInput is used internally by genqlient
+type __DeleteBoosterInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __DeleteBoosterInput.Id, and is useful for accessing the field via an interface.
+func (v *__DeleteBoosterInput) GetId() string { return v.Id }
+
 // __DeleteDataPoolAccessPolicyInput is used internally by genqlient
 type __DeleteDataPoolAccessPolicyInput struct {
 	Id string `json:"id"`
@@ -20140,6 +25870,54 @@ type __DeletePolicyInput struct {
 // GetId returns __DeletePolicyInput.Id, and is useful for accessing the field via an interface.
 func (v *__DeletePolicyInput) GetId() string { return v.Id }
 
+// __DeletionJobInput is used internally by genqlient
+type __DeletionJobInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __DeletionJobInput.Id, and is useful for accessing the field via an interface.
+func (v *__DeletionJobInput) GetId() string { return v.Id }
+
+// __DescribeSqlInput is used internally by genqlient
+type __DescribeSqlInput struct {
+	Input *DescribeSqlV1Input `json:"input,omitempty"`
+}
+
+// GetInput returns __DescribeSqlInput.Input, and is useful for accessing the field via an interface.
+func (v *__DescribeSqlInput) GetInput() *DescribeSqlV1Input { return v.Input }
+
+// __DisableSyncingInput is used internally by genqlient
+type __DisableSyncingInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __DisableSyncingInput.Id, and is useful for accessing the field via an interface.
+func (v *__DisableSyncingInput) GetId() string { return v.Id }
+
+// __EnableSyncingInput is used internally by genqlient
+type __EnableSyncingInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __EnableSyncingInput.Id, and is useful for accessing the field via an interface.
+func (v *__EnableSyncingInput) GetId() string { return v.Id }
+
+// __EnvironmentInput is used internally by genqlient
+type __EnvironmentInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __EnvironmentInput.Id, and is useful for accessing the field via an interface.
+func (v *__EnvironmentInput) GetId() string { return v.Id }
+
+// __MaterializedViewByNameInput is used internally by genqlient
+type __MaterializedViewByNameInput struct {
+	UniqueName string `json:"uniqueName"`
+}
+
+// GetUniqueName returns __MaterializedViewByNameInput.UniqueName, and is useful for accessing the field via an interface.
+func (v *__MaterializedViewByNameInput) GetUniqueName() string { return v.UniqueName }
+
 // __MaterializedViewInput is used internally by genqlient
 type __MaterializedViewInput struct {
 	Id string `json:"id"`
@@ -20148,6 +25926,26 @@ type __MaterializedViewInput struct {
 // GetId returns __MaterializedViewInput.Id, and is useful for accessing the field via an interface.
 func (v *__MaterializedViewInput) GetId() string { return v.Id }
 
+// __MaterializedViewsInput is used internally by genqlient
+type __MaterializedViewsInput struct {
+	First  *int    `json:"first"`
+	Last   *int    `json:"last"`
+	After  *string `json:"after"`
+	Before *string `json:"before"`
+}
+
+// GetFirst returns __MaterializedViewsInput.First, and is useful for accessing the field via an interface.
+func (v *__MaterializedViewsInput) GetFirst() *int { return v.First }
+
+// GetLast returns __MaterializedViewsInput.Last, and is useful for accessing the field via an interface.
+func (v *__MaterializedViewsInput) GetLast() *int { return v.Last }
+
+// GetAfter returns __MaterializedViewsInput.After, and is useful for accessing the field via an interface.
+func (v *__MaterializedViewsInput) GetAfter() *string { return v.After }
+
+// GetBefore returns __MaterializedViewsInput.Before, and is useful for accessing the field via an interface.
+func (v *__MaterializedViewsInput) GetBefore() *string { return v.Before }
+
 // __MetricByNameInput is used internally by genqlient
 type __MetricByNameInput struct {
 	UniqueName string `json:"uniqueName"`
@@ -20184,6 +25982,14 @@ func (v *__MetricsInput) GetAfter() *string { return v.After }
 // GetBefore returns __MetricsInput.Before, and is useful for accessing the field via an interface.
 func (v *__MetricsInput) GetBefore() *string { return v.Before }
 
+// __MigrateMetricInput is used internally by genqlient
+type __MigrateMetricInput struct {
+	Input *MigrateMetricInput `json:"input,omitempty"`
+}
+
+// GetInput returns __MigrateMetricInput.Input, and is useful for accessing the field via an interface.
+func (v *__MigrateMetricInput) GetInput() *MigrateMetricInput { return v.Input }
+
 // __ModifyApplicationInput is used internally by genqlient
 type __ModifyApplicationInput struct {
 	Input *ModifyApplicationInput `json:"input,omitempty"`
@@ -20220,6 +26026,14 @@ type __ModifyDataPoolInput struct {
 // GetInput returns __ModifyDataPoolInput.Input, and is useful for accessing the field via an interface.
 func (v *__ModifyDataPoolInput) GetInput() *ModifyDataPoolInput { return v.Input }
 
+// __ModifyEnvironmentInput is used internally by genqlient
+type __ModifyEnvironmentInput struct {
+	Input *ModifyEnvironmentInput `json:"input,omitempty"`
+}
+
+// GetInput returns __ModifyEnvironmentInput.Input, and is useful for accessing the field via an interface.
+func (v *__ModifyEnvironmentInput) GetInput() *ModifyEnvironmentInput { return v.Input }
+
 // __ModifyHttpDataSourceInput is used internally by genqlient
 type __ModifyHttpDataSourceInput struct {
 	Input *ModifyHttpDataSourceInput `json:"input,omitempty"`
@@ -20260,6 +26074,16 @@ type __ModifyPolicyInput struct {
 // GetInput returns __ModifyPolicyInput.Input, and is useful for accessing the field via an interface.
 func (v *__ModifyPolicyInput) GetInput() *ModifyPolicyInput { return v.Input }
 
+// __ModifyPostgreSqlDataSourceInput is used internally by genqlient
+type __ModifyPostgreSqlDataSourceInput struct {
+	Input *ModifyPostgreSqlDataSourceInput `json:"input,omitempty"`
+}
+
+// GetInput returns __ModifyPostgreSqlDataSourceInput.Input, and is useful for accessing the field via an interface.
+func (v *__ModifyPostgreSqlDataSourceInput) GetInput() *ModifyPostgreSqlDataSourceInput {
+	return v.Input
+}
+
 // __ModifyS3DataSourceInput is used internally by genqlient
 type __ModifyS3DataSourceInput struct {
 	Input *ModifyS3DataSourceInput `json:"input,omitempty"`
@@ -20292,6 +26116,30 @@ type __PolicyInput struct {
 // GetId returns __PolicyInput.Id, and is useful for accessing the field via an interface.
 func (v *__PolicyInput) GetId() string { return v.Id }
 
+// __ResyncDataPoolInput is used internally by genqlient
+type __ResyncDataPoolInput struct {
+	DataPoolId string `json:"dataPoolId"`
+}
+
+// GetDataPoolId returns __ResyncDataPoolInput.DataPoolId, and is useful for accessing the field via an interface.
+func (v *__ResyncDataPoolInput) GetDataPoolId() string { return v.DataPoolId }
+
+// __SyncInput is used internally by genqlient
+type __SyncInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __SyncInput.Id, and is useful for accessing the field via an interface.
+func (v *__SyncInput) GetId() string { return v.Id }
+
+// __TestDataSourceInput is used internally by genqlient
+type __TestDataSourceInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __TestDataSourceInput.Id, and is useful for accessing the field via an interface.
+func (v *__TestDataSourceInput) GetId() string { return v.Id }
+
 // __UnAssignDataPoolAccessPolicyInput is used internally by genqlient
 type __UnAssignDataPoolAccessPolicyInput struct {
 	DataPoolAccessPolicy string `json:"dataPoolAccessPolicy"`
@@ -20306,6 +26154,26 @@ func (v *__UnAssignDataPoolAccessPolicyInput) GetDataPoolAccessPolicy() string {
 // GetApplication returns __UnAssignDataPoolAccessPolicyInput.Application, and is useful for accessing the field via an interface.
 func (v *__UnAssignDataPoolAccessPolicyInput) GetApplication() string { return v.Application }
 
+// __UpdateDataPoolRecordsJobInput is used internally by genqlient
+type __UpdateDataPoolRecordsJobInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __UpdateDataPoolRecordsJobInput.Id, and is useful for accessing the field via an interface.
+func (v *__UpdateDataPoolRecordsJobInput) GetId() string { return v.Id }
+
+// __ValidateExpressionInput is used internally by genqlient
+type __ValidateExpressionInput struct {
+	DataPoolId string `json:"dataPoolId"`
+	Expression string `json:"expression"`
+}
+
+// GetDataPoolId returns __ValidateExpressionInput.DataPoolId, and is useful for accessing the field via an interface.
+func (v *__ValidateExpressionInput) GetDataPoolId() string { return v.DataPoolId }
+
+// GetExpression returns __ValidateExpressionInput.Expression, and is useful for accessing the field via an interface.
+func (v *__ValidateExpressionInput) GetExpression() string { return v.Expression }
+
 // The query or mutation executed by AddColumnToDataPoolJob.
 const AddColumnToDataPoolJob_Operation = `
 query AddColumnToDataPoolJob ($id: ID!) {
@@ -20357,6 +26225,56 @@ func AddColumnToDataPoolJob(
 	return &data_, err_
 }
 
+// The query or mutation executed by AddColumnToDataPoolJobByStatus.
+const AddColumnToDataPoolJobByStatus_Operation = `
+query AddColumnToDataPoolJobByStatus ($status: JobStatus!, $first: Int, $after: String) {
+	addColumnToDataPoolJobByStatus(status: $status, first: $first, after: $after) {
+		pageInfo {
+			... PageInfoData
+		}
+		nodes {
+			id
+		}
+	}
+}
+fragment PageInfoData on PageInfo {
+	startCursor
+	endCursor
+	hasNextPage
+	hasPreviousPage
+}
+`
+
+func AddColumnToDataPoolJobByStatus(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	status JobStatus,
+	first *int,
+	after *string,
+) (*AddColumnToDataPoolJobByStatusResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "AddColumnToDataPoolJobByStatus",
+		Query:  AddColumnToDataPoolJobByStatus_Operation,
+		Variables: &__AddColumnToDataPoolJobByStatusInput{
+			Status: status,
+			First:  first,
+			After:  after,
+		},
+	}
+	var err_ error
+
+	var data_ AddColumnToDataPoolJobByStatusResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by Application.
 const Application_Operation = `
 query Application ($id: ID!) {
@@ -20398,11 +26316,23 @@ fragment DataPoolAccessPolicyData on DataPoolAccessPolicy {
 	rows {
 		... FilterData
 	}
+	filterSql
 	dataPool {
 		id
 	}
 }
 fragment FilterData on Filter {
+	column
+	operator
+	value
+	and {
+		... NestedFilterData
+	}
+	or {
+		... NestedFilterData
+	}
+}
+fragment NestedFilterData on Filter {
 	column
 	operator
 	value
@@ -20445,6 +26375,106 @@ func Application(
 	return &data_, err_
 }
 
+// The query or mutation executed by ApplicationByName.
+const ApplicationByName_Operation = `
+query ApplicationByName ($uniqueName: String!) {
+	application: applicationByName(uniqueName: $uniqueName) {
+		... ApplicationData
+	}
+}
+fragment ApplicationData on Application {
+	id
+	... CommonData
+	clientId
+	secret
+	scopes
+	propeller
+	dataPoolAccessPolicies {
+		nodes {
+			... DataPoolAccessPolicyData
+		}
+	}
+}
+fragment CommonData on Common {
+	uniqueName
+	description
+	account {
+		id
+	}
+	environment {
+		id
+	}
+	createdAt
+	modifiedAt
+	createdBy
+	modifiedBy
+}
+fragment DataPoolAccessPolicyData on DataPoolAccessPolicy {
+	id
+	... CommonData
+	columns
+	rows {
+		... FilterData
+	}
+	filterSql
+	dataPool {
+		id
+	}
+}
+fragment FilterData on Filter {
+	column
+	operator
+	value
+	and {
+		... NestedFilterData
+	}
+	or {
+		... NestedFilterData
+	}
+}
+fragment NestedFilterData on Filter {
+	column
+	operator
+	value
+	and {
+		column
+		operator
+		value
+	}
+	or {
+		column
+		operator
+		value
+	}
+}
+`
+
+func ApplicationByName(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	uniqueName string,
+) (*ApplicationByNameResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "ApplicationByName",
+		Query:  ApplicationByName_Operation,
+		Variables: &__ApplicationByNameInput{
+			UniqueName: uniqueName,
+		},
+	}
+	var err_ error
+
+	var data_ ApplicationByNameResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by AssignDataPoolAccessPolicy.
 const AssignDataPoolAccessPolicy_Operation = `
 mutation AssignDataPoolAccessPolicy ($application: ID!, $dataPoolAccessPolicy: ID!) {
@@ -20480,6 +26510,77 @@ func AssignDataPoolAccessPolicy(
 	return &data_, err_
 }
 
+// The query or mutation executed by Booster.
+const Booster_Operation = `
+query Booster ($id: ID!) {
+	booster(id: $id) {
+		... BoosterData
+	}
+}
+fragment BoosterData on Booster {
+	id
+	account {
+		id
+	}
+	environment {
+		id
+	}
+	metric {
+		id
+	}
+	status
+	error {
+		... GqlError
+	}
+	progress
+	dimensions {
+		... DimensionData
+	}
+	recordCount
+	sizeInTerabytes
+	createdAt
+	modifiedAt
+	createdBy
+	modifiedBy
+}
+fragment GqlError on Error {
+	code
+	message
+}
+fragment DimensionData on Dimension {
+	columnName
+	type
+	isNullable
+	isUniqueKey
+}
+`
+
+func Booster(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (*BoosterResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "Booster",
+		Query:  Booster_Operation,
+		Variables: &__BoosterInput{
+			Id: id,
+		},
+	}
+	var err_ error
+
+	var data_ BoosterResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by CreateAddColumnToDataPoolJob.
 const CreateAddColumnToDataPoolJob_Operation = `
 mutation CreateAddColumnToDataPoolJob ($input: CreateAddColumnToDataPoolJobInput!) {
@@ -20588,11 +26689,23 @@ fragment DataPoolAccessPolicyData on DataPoolAccessPolicy {
 	rows {
 		... FilterData
 	}
+	filterSql
 	dataPool {
 		id
 	}
 }
 fragment FilterData on Filter {
+	column
+	operator
+	value
+	and {
+		... NestedFilterData
+	}
+	or {
+		... NestedFilterData
+	}
+}
+fragment NestedFilterData on Filter {
 	column
 	operator
 	value
@@ -20669,12 +26782,14 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 		}
 		... on SumMetricSettings {
 			__typename
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -20684,6 +26799,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			dimension {
 				... DimensionData
 			}
@@ -20693,6 +26809,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -20702,6 +26819,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -20711,6 +26829,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -20720,6 +26839,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			expression
 		}
 	}
@@ -20749,6 +26869,7 @@ fragment DataPoolData on DataPool {
 		message
 	}
 	table
+	recordCount
 	tenant {
 		... TenantData
 	}
@@ -20798,6 +26919,193 @@ fragment DimensionData on Dimension {
 	isUniqueKey
 }
 fragment FilterData on Filter {
+	column
+	operator
+	value
+	and {
+		... NestedFilterData
+	}
+	or {
+		... NestedFilterData
+	}
+}
+fragment DataSourceData on DataSource {
+	id
+	... CommonData
+	type
+	status
+	error {
+		message
+	}
+	dataPools {
+		nodes {
+			id
+			accessControlEnabled
+			timestamp {
+				... TimestampData
+			}
+		}
+	}
+	connectionSettings {
+		__typename
+		... on SnowflakeConnectionSettings {
+			account
+			database
+			warehouse
+			schema
+			username
+			role
+		}
+		... on HttpConnectionSettings {
+			basicAuth {
+				username
+				password
+			}
+			tables {
+				id
+				name
+				columns {
+					name
+					type
+					nullable
+				}
+			}
+		}
+		... on S3ConnectionSettings {
+			bucket
+			awsAccessKeyId
+			tables {
+				id
+				name
+				path
+				columns {
+					name
+					type
+					nullable
+				}
+			}
+		}
+		... on WebhookConnectionSettings {
+			basicAuth {
+				username
+				password
+			}
+			columns {
+				name
+				type
+				jsonProperty
+				nullable
+			}
+			tenant
+			uniqueId
+			tableSettings {
+				... TableSettingsData
+			}
+			webhookUrl
+		}
+		... on KafkaConnectionSettings {
+			auth
+			user
+			password
+			tls
+			bootstrapServers
+		}
+		... on ClickHouseConnectionSettings {
+			url
+			database
+			user
+			password
+			readonly
+		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
+	}
+	tables(first: 100) {
+		nodes {
+			id
+			name
+			columns(first: 100) {
+				nodes {
+					... ColumnData
+				}
+			}
+		}
+	}
+	checks {
+		name
+		description
+		status
+		error {
+			code
+			message
+		}
+		checkedAt
+	}
+	tableIntrospections(first: 100) {
+		nodes {
+			... TableIntrospectionData
+		}
+	}
+}
+fragment TenantData on Tenant {
+	columnName
+	type
+}
+fragment TimestampData on Timestamp {
+	columnName
+	type
+}
+fragment DataPoolColumnData on DataPoolColumn {
+	columnName
+	type
+	clickHouseType
+	isNullable
+}
+fragment DataPoolSyncingData on DataPoolSyncing {
+	status
+	interval
+	lastSyncedAt
+}
+fragment DataPoolAccessPolicyData on DataPoolAccessPolicy {
+	id
+	... CommonData
+	columns
+	rows {
+		... FilterData
+	}
+	filterSql
+	dataPool {
+		id
+	}
+}
+fragment TableSettingsData on TableSettings {
+	engine {
+		__typename
+		... on MergeTreeTableEngine {
+			type
+		}
+		... on ReplacingMergeTreeTableEngine {
+			type
+			ver
+		}
+		... on SummingMergeTreeTableEngine {
+			type
+			columns
+		}
+		... on AggregatingMergeTreeTableEngine {
+			type
+		}
+	}
+	partitionBy
+	primaryKey
+	orderBy
+}
+fragment NestedFilterData on Filter {
 	column
 	operator
 	value
@@ -20812,6 +27120,132 @@ fragment FilterData on Filter {
 		value
 	}
 }
+fragment ColumnData on Column {
+	name
+	type
+	isNullable
+}
+fragment TableIntrospectionData on TableIntrospection {
+	dataSource {
+		id
+	}
+	status
+	createdAt
+	createdBy
+	modifiedAt
+	modifiedBy
+	numTables
+}
+`
+
+func CreateAverageMetric(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input *CreateAverageMetricInput,
+) (*CreateAverageMetricResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "CreateAverageMetric",
+		Query:  CreateAverageMetric_Operation,
+		Variables: &__CreateAverageMetricInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ CreateAverageMetricResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by CreateBooster.
+const CreateBooster_Operation = `
+mutation CreateBooster ($input: CreateBoosterInput!) {
+	createBooster(input: $input) {
+		booster {
+			... BoosterData
+		}
+	}
+}
+fragment BoosterData on Booster {
+	id
+	account {
+		id
+	}
+	environment {
+		id
+	}
+	metric {
+		id
+	}
+	status
+	error {
+		... GqlError
+	}
+	progress
+	dimensions {
+		... DimensionData
+	}
+	recordCount
+	sizeInTerabytes
+	createdAt
+	modifiedAt
+	createdBy
+	modifiedBy
+}
+fragment GqlError on Error {
+	code
+	message
+}
+fragment DimensionData on Dimension {
+	columnName
+	type
+	isNullable
+	isUniqueKey
+}
+`
+
+func CreateBooster(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input *CreateBoosterInput,
+) (*CreateBoosterResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "CreateBooster",
+		Query:  CreateBooster_Operation,
+		Variables: &__CreateBoosterInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ CreateBoosterResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by CreateClickHouseDataSource.
+const CreateClickHouseDataSource_Operation = `
+mutation CreateClickHouseDataSource ($input: CreateClickHouseDataSourceInput!) {
+	createClickHouseDataSource(input: $input) {
+		dataSource {
+			... DataSourceData
+		}
+	}
+}
 fragment DataSourceData on DataSource {
 	id
 	... CommonData
@@ -20900,226 +27334,12 @@ fragment DataSourceData on DataSource {
 			password
 			readonly
 		}
-	}
-	tables(first: 100) {
-		nodes {
-			id
-			name
-			columns(first: 100) {
-				nodes {
-					... ColumnData
-				}
-			}
-		}
-	}
-	checks {
-		name
-		description
-		status
-		error {
-			code
-			message
-		}
-		checkedAt
-	}
-	tableIntrospections(first: 100) {
-		nodes {
-			... TableIntrospectionData
-		}
-	}
-}
-fragment TenantData on Tenant {
-	columnName
-	type
-}
-fragment TimestampData on Timestamp {
-	columnName
-	type
-}
-fragment DataPoolColumnData on DataPoolColumn {
-	columnName
-	type
-	clickHouseType
-	isNullable
-}
-fragment DataPoolSyncingData on DataPoolSyncing {
-	status
-	interval
-	lastSyncedAt
-}
-fragment DataPoolAccessPolicyData on DataPoolAccessPolicy {
-	id
-	... CommonData
-	columns
-	rows {
-		... FilterData
-	}
-	dataPool {
-		id
-	}
-}
-fragment TableSettingsData on TableSettings {
-	engine {
-		__typename
-		... on MergeTreeTableEngine {
-			type
-		}
-		... on ReplacingMergeTreeTableEngine {
-			type
-			ver
-		}
-		... on SummingMergeTreeTableEngine {
-			type
-			columns
-		}
-		... on AggregatingMergeTreeTableEngine {
-			type
-		}
-	}
-	partitionBy
-	primaryKey
-	orderBy
-}
-fragment ColumnData on Column {
-	name
-	type
-	isNullable
-}
-fragment TableIntrospectionData on TableIntrospection {
-	dataSource {
-		id
-	}
-	status
-	createdAt
-	createdBy
-	modifiedAt
-	modifiedBy
-	numTables
-}
-`
-
-func CreateAverageMetric(
-	ctx_ context.Context,
-	client_ graphql.Client,
-	input *CreateAverageMetricInput,
-) (*CreateAverageMetricResponse, error) {
-	req_ := &graphql.Request{
-		OpName: "CreateAverageMetric",
-		Query:  CreateAverageMetric_Operation,
-		Variables: &__CreateAverageMetricInput{
-			Input: input,
-		},
-	}
-	var err_ error
-
-	var data_ CreateAverageMetricResponse
-	resp_ := &graphql.Response{Data: &data_}
-
-	err_ = client_.MakeRequest(
-		ctx_,
-		req_,
-		resp_,
-	)
-
-	return &data_, err_
-}
-
-// The query or mutation executed by CreateClickHouseDataSource.
-const CreateClickHouseDataSource_Operation = `
-mutation CreateClickHouseDataSource ($input: CreateClickHouseDataSourceInput!) {
-	createClickHouseDataSource(input: $input) {
-		dataSource {
-			... DataSourceData
-		}
-	}
-}
-fragment DataSourceData on DataSource {
-	id
-	... CommonData
-	type
-	status
-	error {
-		message
-	}
-	dataPools {
-		nodes {
-			id
-			accessControlEnabled
-			timestamp {
-				... TimestampData
-			}
-		}
-	}
-	connectionSettings {
-		__typename
-		... on SnowflakeConnectionSettings {
-			account
-			database
-			warehouse
-			schema
-			username
-			role
-		}
-		... on HttpConnectionSettings {
-			basicAuth {
-				username
-				password
-			}
-			tables {
-				id
-				name
-				columns {
-					name
-					type
-					nullable
-				}
-			}
-		}
-		... on S3ConnectionSettings {
-			bucket
-			awsAccessKeyId
-			tables {
-				id
-				name
-				path
-				columns {
-					name
-					type
-					nullable
-				}
-			}
-		}
-		... on WebhookConnectionSettings {
-			basicAuth {
-				username
-				password
-			}
-			columns {
-				name
-				type
-				jsonProperty
-				nullable
-			}
-			tenant
-			uniqueId
-			tableSettings {
-				... TableSettingsData
-			}
-			webhookUrl
-		}
-		... on KafkaConnectionSettings {
-			auth
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
 			user
-			password
-			tls
-			bootstrapServers
-		}
-		... on ClickHouseConnectionSettings {
-			url
-			database
-			user
-			password
-			readonly
 		}
 	}
 	tables(first: 100) {
@@ -21267,12 +27487,14 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 		}
 		... on SumMetricSettings {
 			__typename
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -21282,6 +27504,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			dimension {
 				... DimensionData
 			}
@@ -21291,6 +27514,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -21300,6 +27524,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -21309,6 +27534,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -21318,6 +27544,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			expression
 		}
 	}
@@ -21347,6 +27574,7 @@ fragment DataPoolData on DataPool {
 		message
 	}
 	table
+	recordCount
 	tenant {
 		... TenantData
 	}
@@ -21400,14 +27628,10 @@ fragment FilterData on Filter {
 	operator
 	value
 	and {
-		column
-		operator
-		value
+		... NestedFilterData
 	}
 	or {
-		column
-		operator
-		value
+		... NestedFilterData
 	}
 }
 fragment DataSourceData on DataSource {
@@ -21498,6 +27722,13 @@ fragment DataSourceData on DataSource {
 			password
 			readonly
 		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
 	}
 	tables(first: 100) {
 		nodes {
@@ -21552,6 +27783,7 @@ fragment DataPoolAccessPolicyData on DataPoolAccessPolicy {
 	rows {
 		... FilterData
 	}
+	filterSql
 	dataPool {
 		id
 	}
@@ -21578,6 +27810,21 @@ fragment TableSettingsData on TableSettings {
 	primaryKey
 	orderBy
 }
+fragment NestedFilterData on Filter {
+	column
+	operator
+	value
+	and {
+		column
+		operator
+		value
+	}
+	or {
+		column
+		operator
+		value
+	}
+}
 fragment ColumnData on Column {
 	name
 	type
@@ -21656,12 +27903,14 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 		}
 		... on SumMetricSettings {
 			__typename
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -21671,6 +27920,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			dimension {
 				... DimensionData
 			}
@@ -21680,6 +27930,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -21689,6 +27940,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -21698,6 +27950,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -21707,6 +27960,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			expression
 		}
 	}
@@ -21736,6 +27990,7 @@ fragment DataPoolData on DataPool {
 		message
 	}
 	table
+	recordCount
 	tenant {
 		... TenantData
 	}
@@ -21789,14 +28044,10 @@ fragment FilterData on Filter {
 	operator
 	value
 	and {
-		column
-		operator
-		value
+		... NestedFilterData
 	}
 	or {
-		column
-		operator
-		value
+		... NestedFilterData
 	}
 }
 fragment DataSourceData on DataSource {
@@ -21887,6 +28138,13 @@ fragment DataSourceData on DataSource {
 			password
 			readonly
 		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
 	}
 	tables(first: 100) {
 		nodes {
@@ -21941,6 +28199,7 @@ fragment DataPoolAccessPolicyData on DataPoolAccessPolicy {
 	rows {
 		... FilterData
 	}
+	filterSql
 	dataPool {
 		id
 	}
@@ -21967,6 +28226,21 @@ fragment TableSettingsData on TableSettings {
 	primaryKey
 	orderBy
 }
+fragment NestedFilterData on Filter {
+	column
+	operator
+	value
+	and {
+		column
+		operator
+		value
+	}
+	or {
+		column
+		operator
+		value
+	}
+}
 fragment ColumnData on Column {
 	name
 	type
@@ -22045,12 +28319,14 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 		}
 		... on SumMetricSettings {
 			__typename
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -22060,6 +28336,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			dimension {
 				... DimensionData
 			}
@@ -22069,6 +28346,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -22078,6 +28356,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -22087,6 +28366,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -22096,6 +28376,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			expression
 		}
 	}
@@ -22125,6 +28406,7 @@ fragment DataPoolData on DataPool {
 		message
 	}
 	table
+	recordCount
 	tenant {
 		... TenantData
 	}
@@ -22178,14 +28460,10 @@ fragment FilterData on Filter {
 	operator
 	value
 	and {
-		column
-		operator
-		value
+		... NestedFilterData
 	}
 	or {
-		column
-		operator
-		value
+		... NestedFilterData
 	}
 }
 fragment DataSourceData on DataSource {
@@ -22276,6 +28554,13 @@ fragment DataSourceData on DataSource {
 			password
 			readonly
 		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
 	}
 	tables(first: 100) {
 		nodes {
@@ -22330,6 +28615,7 @@ fragment DataPoolAccessPolicyData on DataPoolAccessPolicy {
 	rows {
 		... FilterData
 	}
+	filterSql
 	dataPool {
 		id
 	}
@@ -22356,6 +28642,21 @@ fragment TableSettingsData on TableSettings {
 	primaryKey
 	orderBy
 }
+fragment NestedFilterData on Filter {
+	column
+	operator
+	value
+	and {
+		column
+		operator
+		value
+	}
+	or {
+		column
+		operator
+		value
+	}
+}
 fragment ColumnData on Column {
 	name
 	type
@@ -22423,6 +28724,7 @@ fragment DataPoolData on DataPool {
 		message
 	}
 	table
+	recordCount
 	tenant {
 		... TenantData
 	}
@@ -22567,6 +28869,13 @@ fragment DataSourceData on DataSource {
 			password
 			readonly
 		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
 	}
 	tables(first: 100) {
 		nodes {
@@ -22621,6 +28930,7 @@ fragment DataPoolAccessPolicyData on DataPoolAccessPolicy {
 	rows {
 		... FilterData
 	}
+	filterSql
 	dataPool {
 		id
 	}
@@ -22664,6 +28974,17 @@ fragment TableIntrospectionData on TableIntrospection {
 	numTables
 }
 fragment FilterData on Filter {
+	column
+	operator
+	value
+	and {
+		... NestedFilterData
+	}
+	or {
+		... NestedFilterData
+	}
+}
+fragment NestedFilterData on Filter {
 	column
 	operator
 	value
@@ -22722,6 +29043,7 @@ fragment DataPoolAccessPolicyData on DataPoolAccessPolicy {
 	rows {
 		... FilterData
 	}
+	filterSql
 	dataPool {
 		id
 	}
@@ -22741,6 +29063,17 @@ fragment CommonData on Common {
 	modifiedBy
 }
 fragment FilterData on Filter {
+	column
+	operator
+	value
+	and {
+		... NestedFilterData
+	}
+	or {
+		... NestedFilterData
+	}
+}
+fragment NestedFilterData on Filter {
 	column
 	operator
 	value
@@ -22783,6 +29116,111 @@ func CreateDataPoolAccessPolicy(
 	return &data_, err_
 }
 
+// The query or mutation executed by CreateDeletionJob.
+const CreateDeletionJob_Operation = `
+mutation CreateDeletionJob ($input: CreateDeletionJobInput!) {
+	createDeletionJob(input: $input) {
+		job {
+			... DeletionJobData
+		}
+	}
+}
+fragment DeletionJobData on DeletionJob {
+	id
+	dataPool {
+		id
+	}
+	status
+	filterSql
+	error {
+		... GqlError
+	}
+	progress
+	startedAt
+	succeededAt
+	failedAt
+}
+fragment GqlError on Error {
+	code
+	message
+}
+`
+
+func CreateDeletionJob(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input *CreateDeletionJobInput,
+) (*CreateDeletionJobResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "CreateDeletionJob",
+		Query:  CreateDeletionJob_Operation,
+		Variables: &__CreateDeletionJobInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ CreateDeletionJobResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by CreateEnvironment.
+const CreateEnvironment_Operation = `
+mutation CreateEnvironment ($input: CreateEnvironmentInput!) {
+	createEnvironment(input: $input) {
+		environment {
+			... EnvironmentData
+		}
+	}
+}
+fragment EnvironmentData on Environment {
+	id
+	uniqueName
+	description
+	account {
+		id
+	}
+	createdAt
+	modifiedAt
+	createdBy
+	modifiedBy
+}
+`
+
+func CreateEnvironment(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input *CreateEnvironmentInput,
+) (*CreateEnvironmentResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "CreateEnvironment",
+		Query:  CreateEnvironment_Operation,
+		Variables: &__CreateEnvironmentInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ CreateEnvironmentResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by CreateHttpDataSource.
 const CreateHttpDataSource_Operation = `
 mutation CreateHttpDataSource ($input: CreateHttpDataSourceInput!) {
@@ -22880,6 +29318,13 @@ fragment DataSourceData on DataSource {
 			password
 			readonly
 		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
 	}
 	tables(first: 100) {
 		nodes {
@@ -23089,6 +29534,13 @@ fragment DataSourceData on DataSource {
 			password
 			readonly
 		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
 	}
 	tables(first: 100) {
 		nodes {
@@ -23303,12 +29755,430 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
+		}
+		... on SumMetricSettings {
+			__typename
+			filters {
+				... FilterData
+			}
+			filterSql
+			measure {
+				... DimensionData
+			}
+		}
+		... on CountDistinctMetricSettings {
+			__typename
+			filters {
+				... FilterData
+			}
+			filterSql
+			dimension {
+				... DimensionData
+			}
+		}
+		... on AverageMetricSettings {
+			__typename
+			filters {
+				... FilterData
+			}
+			filterSql
+			measure {
+				... DimensionData
+			}
+		}
+		... on MinMetricSettings {
+			__typename
+			filters {
+				... FilterData
+			}
+			filterSql
+			measure {
+				... DimensionData
+			}
+		}
+		... on MaxMetricSettings {
+			__typename
+			filters {
+				... FilterData
+			}
+			filterSql
+			measure {
+				... DimensionData
+			}
+		}
+		... on CustomMetricSettings {
+			__typename
+			filters {
+				... FilterData
+			}
+			filterSql
+			expression
+		}
+	}
+}
+fragment CommonData on Common {
+	uniqueName
+	description
+	account {
+		id
+	}
+	environment {
+		id
+	}
+	createdAt
+	modifiedAt
+	createdBy
+	modifiedBy
+}
+fragment DataPoolData on DataPool {
+	id
+	... CommonData
+	dataSource {
+		... DataSourceData
+	}
+	status
+	error {
+		message
+	}
+	table
+	recordCount
+	tenant {
+		... TenantData
+	}
+	timestamp {
+		... TimestampData
+	}
+	columns {
+		nodes {
+			... DataPoolColumnData
+		}
+	}
+	uniqueId {
+		columnName
+	}
+	syncing {
+		... DataPoolSyncingData
+	}
+	availableMeasures {
+		nodes {
+			... DataPoolColumnData
+		}
+	}
+	setupTasks {
+		name
+		description
+		status
+		error {
+			code
+			message
+		}
+		completedAt
+	}
+	dataPoolAccessPolicies {
+		nodes {
+			... DataPoolAccessPolicyData
+		}
+	}
+	accessControlEnabled
+	tableSettings {
+		... TableSettingsData
+	}
+}
+fragment DimensionData on Dimension {
+	columnName
+	type
+	isNullable
+	isUniqueKey
+}
+fragment FilterData on Filter {
+	column
+	operator
+	value
+	and {
+		... NestedFilterData
+	}
+	or {
+		... NestedFilterData
+	}
+}
+fragment DataSourceData on DataSource {
+	id
+	... CommonData
+	type
+	status
+	error {
+		message
+	}
+	dataPools {
+		nodes {
+			id
+			accessControlEnabled
+			timestamp {
+				... TimestampData
+			}
+		}
+	}
+	connectionSettings {
+		__typename
+		... on SnowflakeConnectionSettings {
+			account
+			database
+			warehouse
+			schema
+			username
+			role
+		}
+		... on HttpConnectionSettings {
+			basicAuth {
+				username
+				password
+			}
+			tables {
+				id
+				name
+				columns {
+					name
+					type
+					nullable
+				}
+			}
+		}
+		... on S3ConnectionSettings {
+			bucket
+			awsAccessKeyId
+			tables {
+				id
+				name
+				path
+				columns {
+					name
+					type
+					nullable
+				}
+			}
+		}
+		... on WebhookConnectionSettings {
+			basicAuth {
+				username
+				password
+			}
+			columns {
+				name
+				type
+				jsonProperty
+				nullable
+			}
+			tenant
+			uniqueId
+			tableSettings {
+				... TableSettingsData
+			}
+			webhookUrl
+		}
+		... on KafkaConnectionSettings {
+			auth
+			user
+			password
+			tls
+			bootstrapServers
+		}
+		... on ClickHouseConnectionSettings {
+			url
+			database
+			user
+			password
+			readonly
+		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
+	}
+	tables(first: 100) {
+		nodes {
+			id
+			name
+			columns(first: 100) {
+				nodes {
+					... ColumnData
+				}
+			}
+		}
+	}
+	checks {
+		name
+		description
+		status
+		error {
+			code
+			message
+		}
+		checkedAt
+	}
+	tableIntrospections(first: 100) {
+		nodes {
+			... TableIntrospectionData
+		}
+	}
+}
+fragment TenantData on Tenant {
+	columnName
+	type
+}
+fragment TimestampData on Timestamp {
+	columnName
+	type
+}
+fragment DataPoolColumnData on DataPoolColumn {
+	columnName
+	type
+	clickHouseType
+	isNullable
+}
+fragment DataPoolSyncingData on DataPoolSyncing {
+	status
+	interval
+	lastSyncedAt
+}
+fragment DataPoolAccessPolicyData on DataPoolAccessPolicy {
+	id
+	... CommonData
+	columns
+	rows {
+		... FilterData
+	}
+	filterSql
+	dataPool {
+		id
+	}
+}
+fragment TableSettingsData on TableSettings {
+	engine {
+		__typename
+		... on MergeTreeTableEngine {
+			type
+		}
+		... on ReplacingMergeTreeTableEngine {
+			type
+			ver
+		}
+		... on SummingMergeTreeTableEngine {
+			type
+			columns
+		}
+		... on AggregatingMergeTreeTableEngine {
+			type
+		}
+	}
+	partitionBy
+	primaryKey
+	orderBy
+}
+fragment NestedFilterData on Filter {
+	column
+	operator
+	value
+	and {
+		column
+		operator
+		value
+	}
+	or {
+		column
+		operator
+		value
+	}
+}
+fragment ColumnData on Column {
+	name
+	type
+	isNullable
+}
+fragment TableIntrospectionData on TableIntrospection {
+	dataSource {
+		id
+	}
+	status
+	createdAt
+	createdBy
+	modifiedAt
+	modifiedBy
+	numTables
+}
+`
+
+func CreateMaxMetric(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input *CreateMaxMetricInput,
+) (*CreateMaxMetricResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "CreateMaxMetric",
+		Query:  CreateMaxMetric_Operation,
+		Variables: &__CreateMaxMetricInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ CreateMaxMetricResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by CreateMinMetric.
+const CreateMinMetric_Operation = `
+mutation CreateMinMetric ($input: CreateMinMetricInput) {
+	createMinMetric(input: $input) {
+		__typename
+		metric {
+			... MetricData
+		}
+	}
+}
+fragment MetricData on Metric {
+	... CommonData
+	id
+	type
+	accessControlEnabled
+	dataPool {
+		... DataPoolData
+	}
+	dimensions {
+		... DimensionData
+	}
+	timestamp {
+		... DimensionData
+	}
+	measure {
+		... DimensionData
+	}
+	settings {
+		__typename
+		... on CountMetricSettings {
+			__typename
+			filters {
+				... FilterData
+			}
+			filterSql
 		}
 		... on SumMetricSettings {
 			__typename
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -23318,6 +30188,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			dimension {
 				... DimensionData
 			}
@@ -23327,6 +30198,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -23336,6 +30208,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -23345,6 +30218,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -23354,6 +30228,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			expression
 		}
 	}
@@ -23383,6 +30258,7 @@ fragment DataPoolData on DataPool {
 		message
 	}
 	table
+	recordCount
 	tenant {
 		... TenantData
 	}
@@ -23436,14 +30312,10 @@ fragment FilterData on Filter {
 	operator
 	value
 	and {
-		column
-		operator
-		value
+		... NestedFilterData
 	}
 	or {
-		column
-		operator
-		value
+		... NestedFilterData
 	}
 }
 fragment DataSourceData on DataSource {
@@ -23534,6 +30406,13 @@ fragment DataSourceData on DataSource {
 			password
 			readonly
 		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
 	}
 	tables(first: 100) {
 		nodes {
@@ -23588,6 +30467,7 @@ fragment DataPoolAccessPolicyData on DataPoolAccessPolicy {
 	rows {
 		... FilterData
 	}
+	filterSql
 	dataPool {
 		id
 	}
@@ -23614,6 +30494,21 @@ fragment TableSettingsData on TableSettings {
 	primaryKey
 	orderBy
 }
+fragment NestedFilterData on Filter {
+	column
+	operator
+	value
+	and {
+		column
+		operator
+		value
+	}
+	or {
+		column
+		operator
+		value
+	}
+}
 fragment ColumnData on Column {
 	name
 	type
@@ -23632,21 +30527,21 @@ fragment TableIntrospectionData on TableIntrospection {
 }
 `
 
-func CreateMaxMetric(
+func CreateMinMetric(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	input *CreateMaxMetricInput,
-) (*CreateMaxMetricResponse, error) {
+	input *CreateMinMetricInput,
+) (*CreateMinMetricResponse, error) {
 	req_ := &graphql.Request{
-		OpName: "CreateMaxMetric",
-		Query:  CreateMaxMetric_Operation,
-		Variables: &__CreateMaxMetricInput{
+		OpName: "CreateMinMetric",
+		Query:  CreateMinMetric_Operation,
+		Variables: &__CreateMinMetricInput{
 			Input: input,
 		},
 	}
 	var err_ error
 
-	var data_ CreateMaxMetricResponse
+	var data_ CreateMinMetricResponse
 	resp_ := &graphql.Response{Data: &data_}
 
 	err_ = client_.MakeRequest(
@@ -23658,181 +30553,60 @@ func CreateMaxMetric(
 	return &data_, err_
 }
 
-// The query or mutation executed by CreateMinMetric.
-const CreateMinMetric_Operation = `
-mutation CreateMinMetric ($input: CreateMinMetricInput) {
-	createMinMetric(input: $input) {
-		__typename
-		metric {
-			... MetricData
+// The query or mutation executed by CreatePolicy.
+const CreatePolicy_Operation = `
+mutation CreatePolicy ($input: CreatePolicyInput!) {
+	createPolicy(input: $input) {
+		policy {
+			... PolicyData
 		}
 	}
 }
-fragment MetricData on Metric {
-	... CommonData
+fragment PolicyData on Policy {
 	id
 	type
-	accessControlEnabled
-	dataPool {
-		... DataPoolData
-	}
-	dimensions {
-		... DimensionData
-	}
-	timestamp {
-		... DimensionData
-	}
-	measure {
-		... DimensionData
-	}
-	settings {
-		__typename
-		... on CountMetricSettings {
-			__typename
-			filters {
-				... FilterData
-			}
-		}
-		... on SumMetricSettings {
-			__typename
-			filters {
-				... FilterData
-			}
-			measure {
-				... DimensionData
-			}
-		}
-		... on CountDistinctMetricSettings {
-			__typename
-			filters {
-				... FilterData
-			}
-			dimension {
-				... DimensionData
-			}
-		}
-		... on AverageMetricSettings {
-			__typename
-			filters {
-				... FilterData
-			}
-			measure {
-				... DimensionData
-			}
-		}
-		... on MinMetricSettings {
-			__typename
-			filters {
-				... FilterData
-			}
-			measure {
-				... DimensionData
-			}
-		}
-		... on MaxMetricSettings {
-			__typename
-			filters {
-				... FilterData
-			}
-			measure {
-				... DimensionData
-			}
-		}
-		... on CustomMetricSettings {
-			__typename
-			filters {
-				... FilterData
-			}
-			expression
-		}
-	}
-}
-fragment CommonData on Common {
-	uniqueName
-	description
-	account {
+	metric {
 		id
 	}
-	environment {
+	application {
 		id
 	}
-	createdAt
-	modifiedAt
-	createdBy
-	modifiedBy
 }
-fragment DataPoolData on DataPool {
-	id
-	... CommonData
-	dataSource {
-		... DataSourceData
-	}
-	status
-	error {
-		message
-	}
-	table
-	tenant {
-		... TenantData
-	}
-	timestamp {
-		... TimestampData
-	}
-	columns {
-		nodes {
-			... DataPoolColumnData
-		}
-	}
-	uniqueId {
-		columnName
-	}
-	syncing {
-		... DataPoolSyncingData
-	}
-	availableMeasures {
-		nodes {
-			... DataPoolColumnData
-		}
-	}
-	setupTasks {
-		name
-		description
-		status
-		error {
-			code
-			message
-		}
-		completedAt
-	}
-	dataPoolAccessPolicies {
-		nodes {
-			... DataPoolAccessPolicyData
-		}
-	}
-	accessControlEnabled
-	tableSettings {
-		... TableSettingsData
+`
+
+func CreatePolicy(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input *CreatePolicyInput,
+) (*CreatePolicyResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "CreatePolicy",
+		Query:  CreatePolicy_Operation,
+		Variables: &__CreatePolicyInput{
+			Input: input,
+		},
 	}
+	var err_ error
+
+	var data_ CreatePolicyResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
 }
-fragment DimensionData on Dimension {
-	columnName
-	type
-	isNullable
-	isUniqueKey
-}
-fragment FilterData on Filter {
-	column
-	operator
-	value
-	and {
-		column
-		operator
-		value
-	}
-	or {
-		column
-		operator
-		value
+
+// The query or mutation executed by CreatePostgreSqlDataSource.
+const CreatePostgreSqlDataSource_Operation = `
+mutation CreatePostgreSqlDataSource ($input: CreatePostgreSqlDataSourceInput!) {
+	createPostgreSqlDataSource(input: $input) {
+		dataSource {
+			... DataSourceData
+		}
 	}
 }
 fragment DataSourceData on DataSource {
@@ -23923,6 +30697,13 @@ fragment DataSourceData on DataSource {
 			password
 			readonly
 		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
 	}
 	tables(first: 100) {
 		nodes {
@@ -23951,36 +30732,24 @@ fragment DataSourceData on DataSource {
 		}
 	}
 }
-fragment TenantData on Tenant {
-	columnName
-	type
+fragment CommonData on Common {
+	uniqueName
+	description
+	account {
+		id
+	}
+	environment {
+		id
+	}
+	createdAt
+	modifiedAt
+	createdBy
+	modifiedBy
 }
 fragment TimestampData on Timestamp {
 	columnName
 	type
 }
-fragment DataPoolColumnData on DataPoolColumn {
-	columnName
-	type
-	clickHouseType
-	isNullable
-}
-fragment DataPoolSyncingData on DataPoolSyncing {
-	status
-	interval
-	lastSyncedAt
-}
-fragment DataPoolAccessPolicyData on DataPoolAccessPolicy {
-	id
-	... CommonData
-	columns
-	rows {
-		... FilterData
-	}
-	dataPool {
-		id
-	}
-}
 fragment TableSettingsData on TableSettings {
 	engine {
 		__typename
@@ -24021,68 +30790,21 @@ fragment TableIntrospectionData on TableIntrospection {
 }
 `
 
-func CreateMinMetric(
+func CreatePostgreSqlDataSource(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	input *CreateMinMetricInput,
-) (*CreateMinMetricResponse, error) {
+	input *CreatePostgreSqlDataSourceInput,
+) (*CreatePostgreSqlDataSourceResponse, error) {
 	req_ := &graphql.Request{
-		OpName: "CreateMinMetric",
-		Query:  CreateMinMetric_Operation,
-		Variables: &__CreateMinMetricInput{
+		OpName: "CreatePostgreSqlDataSource",
+		Query:  CreatePostgreSqlDataSource_Operation,
+		Variables: &__CreatePostgreSqlDataSourceInput{
 			Input: input,
 		},
 	}
 	var err_ error
 
-	var data_ CreateMinMetricResponse
-	resp_ := &graphql.Response{Data: &data_}
-
-	err_ = client_.MakeRequest(
-		ctx_,
-		req_,
-		resp_,
-	)
-
-	return &data_, err_
-}
-
-// The query or mutation executed by CreatePolicy.
-const CreatePolicy_Operation = `
-mutation CreatePolicy ($input: CreatePolicyInput!) {
-	createPolicy(input: $input) {
-		policy {
-			... PolicyData
-		}
-	}
-}
-fragment PolicyData on Policy {
-	id
-	type
-	metric {
-		id
-	}
-	application {
-		id
-	}
-}
-`
-
-func CreatePolicy(
-	ctx_ context.Context,
-	client_ graphql.Client,
-	input *CreatePolicyInput,
-) (*CreatePolicyResponse, error) {
-	req_ := &graphql.Request{
-		OpName: "CreatePolicy",
-		Query:  CreatePolicy_Operation,
-		Variables: &__CreatePolicyInput{
-			Input: input,
-		},
-	}
-	var err_ error
-
-	var data_ CreatePolicyResponse
+	var data_ CreatePostgreSqlDataSourceResponse
 	resp_ := &graphql.Response{Data: &data_}
 
 	err_ = client_.MakeRequest(
@@ -24191,6 +30913,13 @@ fragment DataSourceData on DataSource {
 			password
 			readonly
 		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
 	}
 	tables(first: 100) {
 		nodes {
@@ -24408,6 +31137,13 @@ fragment DataSourceData on DataSource {
 			password
 			readonly
 		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
 	}
 	tables(first: 100) {
 		nodes {
@@ -24558,12 +31294,14 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 		}
 		... on SumMetricSettings {
 			__typename
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -24573,6 +31311,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			dimension {
 				... DimensionData
 			}
@@ -24582,6 +31321,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -24591,6 +31331,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -24600,6 +31341,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -24609,6 +31351,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			expression
 		}
 	}
@@ -24638,6 +31381,7 @@ fragment DataPoolData on DataPool {
 		message
 	}
 	table
+	recordCount
 	tenant {
 		... TenantData
 	}
@@ -24691,14 +31435,10 @@ fragment FilterData on Filter {
 	operator
 	value
 	and {
-		column
-		operator
-		value
+		... NestedFilterData
 	}
 	or {
-		column
-		operator
-		value
+		... NestedFilterData
 	}
 }
 fragment DataSourceData on DataSource {
@@ -24789,6 +31529,13 @@ fragment DataSourceData on DataSource {
 			password
 			readonly
 		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
 	}
 	tables(first: 100) {
 		nodes {
@@ -24843,6 +31590,7 @@ fragment DataPoolAccessPolicyData on DataPoolAccessPolicy {
 	rows {
 		... FilterData
 	}
+	filterSql
 	dataPool {
 		id
 	}
@@ -24869,6 +31617,21 @@ fragment TableSettingsData on TableSettings {
 	primaryKey
 	orderBy
 }
+fragment NestedFilterData on Filter {
+	column
+	operator
+	value
+	and {
+		column
+		operator
+		value
+	}
+	or {
+		column
+		operator
+		value
+	}
+}
 fragment ColumnData on Column {
 	name
 	type
@@ -24913,6 +31676,66 @@ func CreateSumMetric(
 	return &data_, err_
 }
 
+// The query or mutation executed by CreateUpdateDataPoolRecordsJob.
+const CreateUpdateDataPoolRecordsJob_Operation = `
+mutation CreateUpdateDataPoolRecordsJob ($input: CreateUpdateDataPoolRecordsJobInput!) {
+	createUpdateDataPoolRecordsJob(input: $input) {
+		job {
+			... UpdateDataPoolRecordsJobData
+		}
+	}
+}
+fragment UpdateDataPoolRecordsJobData on UpdateDataPoolRecordsJob {
+	id
+	dataPool {
+		id
+	}
+	status
+	filterSql
+	set {
+		column
+		expression
+	}
+	error {
+		... GqlError
+	}
+	progress
+	startedAt
+	succeededAt
+	failedAt
+}
+fragment GqlError on Error {
+	code
+	message
+}
+`
+
+func CreateUpdateDataPoolRecordsJob(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input *CreateUpdateDataPoolRecordsJobInput,
+) (*CreateUpdateDataPoolRecordsJobResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "CreateUpdateDataPoolRecordsJob",
+		Query:  CreateUpdateDataPoolRecordsJob_Operation,
+		Variables: &__CreateUpdateDataPoolRecordsJobInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ CreateUpdateDataPoolRecordsJobResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by CreateWebhookDataSource.
 const CreateWebhookDataSource_Operation = `
 mutation CreateWebhookDataSource ($input: CreateWebhookDataSourceInput!) {
@@ -25010,6 +31833,13 @@ fragment DataSourceData on DataSource {
 			password
 			readonly
 		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
 	}
 	tables(first: 100) {
 		nodes {
@@ -25140,6 +31970,7 @@ fragment DataPoolData on DataPool {
 		message
 	}
 	table
+	recordCount
 	tenant {
 		... TenantData
 	}
@@ -25284,6 +32115,13 @@ fragment DataSourceData on DataSource {
 			password
 			readonly
 		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
 	}
 	tables(first: 100) {
 		nodes {
@@ -25338,6 +32176,7 @@ fragment DataPoolAccessPolicyData on DataPoolAccessPolicy {
 	rows {
 		... FilterData
 	}
+	filterSql
 	dataPool {
 		id
 	}
@@ -25381,6 +32220,17 @@ fragment TableIntrospectionData on TableIntrospection {
 	numTables
 }
 fragment FilterData on Filter {
+	column
+	operator
+	value
+	and {
+		... NestedFilterData
+	}
+	or {
+		... NestedFilterData
+	}
+}
+fragment NestedFilterData on Filter {
 	column
 	operator
 	value
@@ -25423,6 +32273,60 @@ func DataPool(
 	return &data_, err_
 }
 
+// The query or mutation executed by DataPoolAccessPolicies.
+const DataPoolAccessPolicies_Operation = `
+query DataPoolAccessPolicies ($id: ID!, $first: Int, $after: String) {
+	dataPool(id: $id) {
+		id
+		dataPoolAccessPolicies(first: $first, after: $after) {
+			pageInfo {
+				... PageInfoData
+			}
+			nodes {
+				id
+				uniqueName
+			}
+		}
+	}
+}
+fragment PageInfoData on PageInfo {
+	startCursor
+	endCursor
+	hasNextPage
+	hasPreviousPage
+}
+`
+
+func DataPoolAccessPolicies(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+	first *int,
+	after *string,
+) (*DataPoolAccessPoliciesResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "DataPoolAccessPolicies",
+		Query:  DataPoolAccessPolicies_Operation,
+		Variables: &__DataPoolAccessPoliciesInput{
+			Id:    id,
+			First: first,
+			After: after,
+		},
+	}
+	var err_ error
+
+	var data_ DataPoolAccessPoliciesResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by DataPoolAccessPolicy.
 const DataPoolAccessPolicy_Operation = `
 query DataPoolAccessPolicy ($id: ID!) {
@@ -25442,6 +32346,7 @@ fragment DataPoolAccessPolicyData on DataPoolAccessPolicy {
 	rows {
 		... FilterData
 	}
+	filterSql
 	dataPool {
 		id
 	}
@@ -25461,6 +32366,17 @@ fragment CommonData on Common {
 	modifiedBy
 }
 fragment FilterData on Filter {
+	column
+	operator
+	value
+	and {
+		... NestedFilterData
+	}
+	or {
+		... NestedFilterData
+	}
+}
+fragment NestedFilterData on Filter {
 	column
 	operator
 	value
@@ -25521,6 +32437,7 @@ fragment DataPoolData on DataPool {
 		message
 	}
 	table
+	recordCount
 	tenant {
 		... TenantData
 	}
@@ -25665,6 +32582,13 @@ fragment DataSourceData on DataSource {
 			password
 			readonly
 		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
 	}
 	tables(first: 100) {
 		nodes {
@@ -25719,6 +32643,7 @@ fragment DataPoolAccessPolicyData on DataPoolAccessPolicy {
 	rows {
 		... FilterData
 	}
+	filterSql
 	dataPool {
 		id
 	}
@@ -25762,6 +32687,17 @@ fragment TableIntrospectionData on TableIntrospection {
 	numTables
 }
 fragment FilterData on Filter {
+	column
+	operator
+	value
+	and {
+		... NestedFilterData
+	}
+	or {
+		... NestedFilterData
+	}
+}
+fragment NestedFilterData on Filter {
 	column
 	operator
 	value
@@ -25804,6 +32740,60 @@ func DataPoolByName(
 	return &data_, err_
 }
 
+// The query or mutation executed by DataPoolMetrics.
+const DataPoolMetrics_Operation = `
+query DataPoolMetrics ($id: ID!, $first: Int, $after: String) {
+	dataPool(id: $id) {
+		id
+		metrics(first: $first, after: $after) {
+			pageInfo {
+				... PageInfoData
+			}
+			nodes {
+				id
+				uniqueName
+			}
+		}
+	}
+}
+fragment PageInfoData on PageInfo {
+	startCursor
+	endCursor
+	hasNextPage
+	hasPreviousPage
+}
+`
+
+func DataPoolMetrics(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+	first *int,
+	after *string,
+) (*DataPoolMetricsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "DataPoolMetrics",
+		Query:  DataPoolMetrics_Operation,
+		Variables: &__DataPoolMetricsInput{
+			Id:    id,
+			First: first,
+			After: after,
+		},
+	}
+	var err_ error
+
+	var data_ DataPoolMetricsResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by DataPools.
 const DataPools_Operation = `
 query DataPools ($first: Int, $last: Int, $after: String, $before: String) {
@@ -25835,6 +32825,7 @@ fragment DataPoolData on DataPool {
 		message
 	}
 	table
+	recordCount
 	tenant {
 		... TenantData
 	}
@@ -25979,6 +32970,13 @@ fragment DataSourceData on DataSource {
 			password
 			readonly
 		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
 	}
 	tables(first: 100) {
 		nodes {
@@ -26033,6 +33031,7 @@ fragment DataPoolAccessPolicyData on DataPoolAccessPolicy {
 	rows {
 		... FilterData
 	}
+	filterSql
 	dataPool {
 		id
 	}
@@ -26076,6 +33075,17 @@ fragment TableIntrospectionData on TableIntrospection {
 	numTables
 }
 fragment FilterData on Filter {
+	column
+	operator
+	value
+	and {
+		... NestedFilterData
+	}
+	or {
+		... NestedFilterData
+	}
+}
+fragment NestedFilterData on Filter {
 	column
 	operator
 	value
@@ -26219,6 +33229,13 @@ fragment DataSourceData on DataSource {
 			password
 			readonly
 		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
 	}
 	tables(first: 100) {
 		nodes {
@@ -26426,6 +33443,13 @@ fragment DataSourceData on DataSource {
 			password
 			readonly
 		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
 	}
 	tables(first: 100) {
 		nodes {
@@ -26646,6 +33670,13 @@ fragment DataSourceData on DataSource {
 			password
 			readonly
 		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
 	}
 	tables(first: 100) {
 		nodes {
@@ -26797,6 +33828,39 @@ func DeleteApplication(
 	return &data_, err_
 }
 
+// The query or mutation executed by DeleteBooster.
+const DeleteBooster_Operation = `
+mutation DeleteBooster ($id: ID!) {
+	deleteBooster(id: $id)
+}
+`
+
+func DeleteBooster(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (*DeleteBoosterResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "DeleteBooster",
+		Query:  DeleteBooster_Operation,
+		Variables: &__DeleteBoosterInput{
+			Id: id,
+		},
+	}
+	var err_ error
+
+	var data_ DeleteBoosterResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by DeleteDataPool.
 const DeleteDataPool_Operation = `
 mutation DeleteDataPool ($id: ID!) {
@@ -27094,6 +34158,232 @@ func DeletePolicy(
 	return &data_, err_
 }
 
+// The query or mutation executed by DeletionJob.
+const DeletionJob_Operation = `
+query DeletionJob ($id: ID!) {
+	deletionJob(id: $id) {
+		... DeletionJobData
+	}
+}
+fragment DeletionJobData on DeletionJob {
+	id
+	dataPool {
+		id
+	}
+	status
+	filterSql
+	error {
+		... GqlError
+	}
+	progress
+	startedAt
+	succeededAt
+	failedAt
+}
+fragment GqlError on Error {
+	code
+	message
+}
+`
+
+func DeletionJob(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (*DeletionJobResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "DeletionJob",
+		Query:  DeletionJob_Operation,
+		Variables: &__DeletionJobInput{
+			Id: id,
+		},
+	}
+	var err_ error
+
+	var data_ DeletionJobResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by DescribeSql.
+const DescribeSql_Operation = `
+query DescribeSql ($input: DescribeSqlV1Input!) {
+	describeSqlV1(input: $input) {
+		columns {
+			columnName
+			type
+			isNullable
+		}
+	}
+}
+`
+
+func DescribeSql(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input *DescribeSqlV1Input,
+) (*DescribeSqlResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "DescribeSql",
+		Query:  DescribeSql_Operation,
+		Variables: &__DescribeSqlInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ DescribeSqlResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by DisableSyncing.
+const DisableSyncing_Operation = `
+mutation DisableSyncing ($id: ID!) {
+	disableSyncing(id: $id) {
+		id
+		syncing {
+			... DataPoolSyncingData
+		}
+	}
+}
+fragment DataPoolSyncingData on DataPoolSyncing {
+	status
+	interval
+	lastSyncedAt
+}
+`
+
+func DisableSyncing(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (*DisableSyncingResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "DisableSyncing",
+		Query:  DisableSyncing_Operation,
+		Variables: &__DisableSyncingInput{
+			Id: id,
+		},
+	}
+	var err_ error
+
+	var data_ DisableSyncingResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by EnableSyncing.
+const EnableSyncing_Operation = `
+mutation EnableSyncing ($id: ID!) {
+	enableSyncing(id: $id) {
+		id
+		syncing {
+			... DataPoolSyncingData
+		}
+	}
+}
+fragment DataPoolSyncingData on DataPoolSyncing {
+	status
+	interval
+	lastSyncedAt
+}
+`
+
+func EnableSyncing(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (*EnableSyncingResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "EnableSyncing",
+		Query:  EnableSyncing_Operation,
+		Variables: &__EnableSyncingInput{
+			Id: id,
+		},
+	}
+	var err_ error
+
+	var data_ EnableSyncingResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by Environment.
+const Environment_Operation = `
+query Environment ($id: ID!) {
+	environment(id: $id) {
+		... EnvironmentData
+	}
+}
+fragment EnvironmentData on Environment {
+	id
+	uniqueName
+	description
+	account {
+		id
+	}
+	createdAt
+	modifiedAt
+	createdBy
+	modifiedBy
+}
+`
+
+func Environment(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (*EnvironmentResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "Environment",
+		Query:  Environment_Operation,
+		Variables: &__EnvironmentInput{
+			Id: id,
+		},
+	}
+	var err_ error
+
+	var data_ EnvironmentResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by MaterializedView.
 const MaterializedView_Operation = `
 query MaterializedView ($id: ID!) {
@@ -27157,6 +34447,149 @@ func MaterializedView(
 	return &data_, err_
 }
 
+// The query or mutation executed by MaterializedViewByName.
+const MaterializedViewByName_Operation = `
+query MaterializedViewByName ($uniqueName: String!) {
+	materializedView: materializedViewByName(uniqueName: $uniqueName) {
+		... MaterializedViewData
+	}
+}
+fragment MaterializedViewData on MaterializedView {
+	id
+	... CommonData
+	sql
+	destination {
+		id
+	}
+	source {
+		id
+	}
+	others {
+		id
+	}
+}
+fragment CommonData on Common {
+	uniqueName
+	description
+	account {
+		id
+	}
+	environment {
+		id
+	}
+	createdAt
+	modifiedAt
+	createdBy
+	modifiedBy
+}
+`
+
+func MaterializedViewByName(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	uniqueName string,
+) (*MaterializedViewByNameResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "MaterializedViewByName",
+		Query:  MaterializedViewByName_Operation,
+		Variables: &__MaterializedViewByNameInput{
+			UniqueName: uniqueName,
+		},
+	}
+	var err_ error
+
+	var data_ MaterializedViewByNameResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by MaterializedViews.
+const MaterializedViews_Operation = `
+query MaterializedViews ($first: Int, $last: Int, $after: String, $before: String) {
+	materializedViews(first: $first, last: $last, after: $after, before: $before) {
+		pageInfo {
+			... PageInfoData
+		}
+		nodes {
+			... MaterializedViewData
+		}
+	}
+}
+fragment PageInfoData on PageInfo {
+	startCursor
+	endCursor
+	hasNextPage
+	hasPreviousPage
+}
+fragment MaterializedViewData on MaterializedView {
+	id
+	... CommonData
+	sql
+	destination {
+		id
+	}
+	source {
+		id
+	}
+	others {
+		id
+	}
+}
+fragment CommonData on Common {
+	uniqueName
+	description
+	account {
+		id
+	}
+	environment {
+		id
+	}
+	createdAt
+	modifiedAt
+	createdBy
+	modifiedBy
+}
+`
+
+func MaterializedViews(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	first *int,
+	last *int,
+	after *string,
+	before *string,
+) (*MaterializedViewsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "MaterializedViews",
+		Query:  MaterializedViews_Operation,
+		Variables: &__MaterializedViewsInput{
+			First:  first,
+			Last:   last,
+			After:  after,
+			Before: before,
+		},
+	}
+	var err_ error
+
+	var data_ MaterializedViewsResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by Metric.
 const Metric_Operation = `
 query Metric ($id: ID!) {
@@ -27188,12 +34621,14 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 		}
 		... on SumMetricSettings {
 			__typename
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -27203,6 +34638,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			dimension {
 				... DimensionData
 			}
@@ -27212,6 +34648,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -27221,6 +34658,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -27230,6 +34668,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -27239,6 +34678,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			expression
 		}
 	}
@@ -27268,6 +34708,7 @@ fragment DataPoolData on DataPool {
 		message
 	}
 	table
+	recordCount
 	tenant {
 		... TenantData
 	}
@@ -27321,14 +34762,10 @@ fragment FilterData on Filter {
 	operator
 	value
 	and {
-		column
-		operator
-		value
+		... NestedFilterData
 	}
 	or {
-		column
-		operator
-		value
+		... NestedFilterData
 	}
 }
 fragment DataSourceData on DataSource {
@@ -27419,6 +34856,13 @@ fragment DataSourceData on DataSource {
 			password
 			readonly
 		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
 	}
 	tables(first: 100) {
 		nodes {
@@ -27473,6 +34917,7 @@ fragment DataPoolAccessPolicyData on DataPoolAccessPolicy {
 	rows {
 		... FilterData
 	}
+	filterSql
 	dataPool {
 		id
 	}
@@ -27499,6 +34944,21 @@ fragment TableSettingsData on TableSettings {
 	primaryKey
 	orderBy
 }
+fragment NestedFilterData on Filter {
+	column
+	operator
+	value
+	and {
+		column
+		operator
+		value
+	}
+	or {
+		column
+		operator
+		value
+	}
+}
 fragment ColumnData on Column {
 	name
 	type
@@ -27574,12 +35034,14 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 		}
 		... on SumMetricSettings {
 			__typename
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -27589,6 +35051,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			dimension {
 				... DimensionData
 			}
@@ -27598,6 +35061,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -27607,6 +35071,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -27616,6 +35081,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -27625,6 +35091,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			expression
 		}
 	}
@@ -27654,6 +35121,7 @@ fragment DataPoolData on DataPool {
 		message
 	}
 	table
+	recordCount
 	tenant {
 		... TenantData
 	}
@@ -27707,14 +35175,10 @@ fragment FilterData on Filter {
 	operator
 	value
 	and {
-		column
-		operator
-		value
+		... NestedFilterData
 	}
 	or {
-		column
-		operator
-		value
+		... NestedFilterData
 	}
 }
 fragment DataSourceData on DataSource {
@@ -27805,6 +35269,13 @@ fragment DataSourceData on DataSource {
 			password
 			readonly
 		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
 	}
 	tables(first: 100) {
 		nodes {
@@ -27859,6 +35330,7 @@ fragment DataPoolAccessPolicyData on DataPoolAccessPolicy {
 	rows {
 		... FilterData
 	}
+	filterSql
 	dataPool {
 		id
 	}
@@ -27885,6 +35357,21 @@ fragment TableSettingsData on TableSettings {
 	primaryKey
 	orderBy
 }
+fragment NestedFilterData on Filter {
+	column
+	operator
+	value
+	and {
+		column
+		operator
+		value
+	}
+	or {
+		column
+		operator
+		value
+	}
+}
 fragment ColumnData on Column {
 	name
 	type
@@ -27977,12 +35464,14 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 		}
 		... on SumMetricSettings {
 			__typename
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -27992,6 +35481,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			dimension {
 				... DimensionData
 			}
@@ -28001,6 +35491,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -28010,6 +35501,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -28019,6 +35511,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -28028,6 +35521,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			expression
 		}
 	}
@@ -28057,6 +35551,7 @@ fragment DataPoolData on DataPool {
 		message
 	}
 	table
+	recordCount
 	tenant {
 		... TenantData
 	}
@@ -28110,14 +35605,10 @@ fragment FilterData on Filter {
 	operator
 	value
 	and {
-		column
-		operator
-		value
+		... NestedFilterData
 	}
 	or {
-		column
-		operator
-		value
+		... NestedFilterData
 	}
 }
 fragment DataSourceData on DataSource {
@@ -28208,6 +35699,13 @@ fragment DataSourceData on DataSource {
 			password
 			readonly
 		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
 	}
 	tables(first: 100) {
 		nodes {
@@ -28262,6 +35760,7 @@ fragment DataPoolAccessPolicyData on DataPoolAccessPolicy {
 	rows {
 		... FilterData
 	}
+	filterSql
 	dataPool {
 		id
 	}
@@ -28288,6 +35787,21 @@ fragment TableSettingsData on TableSettings {
 	primaryKey
 	orderBy
 }
+fragment NestedFilterData on Filter {
+	column
+	operator
+	value
+	and {
+		column
+		operator
+		value
+	}
+	or {
+		column
+		operator
+		value
+	}
+}
 fragment ColumnData on Column {
 	name
 	type
@@ -28338,6 +35852,419 @@ func Metrics(
 	return &data_, err_
 }
 
+// The query or mutation executed by MigrateMetric.
+const MigrateMetric_Operation = `
+mutation MigrateMetric ($input: MigrateMetricInput!) {
+	migrateMetric(input: $input) {
+		... MetricData
+	}
+}
+fragment MetricData on Metric {
+	... CommonData
+	id
+	type
+	accessControlEnabled
+	dataPool {
+		... DataPoolData
+	}
+	dimensions {
+		... DimensionData
+	}
+	timestamp {
+		... DimensionData
+	}
+	measure {
+		... DimensionData
+	}
+	settings {
+		__typename
+		... on CountMetricSettings {
+			__typename
+			filters {
+				... FilterData
+			}
+			filterSql
+		}
+		... on SumMetricSettings {
+			__typename
+			filters {
+				... FilterData
+			}
+			filterSql
+			measure {
+				... DimensionData
+			}
+		}
+		... on CountDistinctMetricSettings {
+			__typename
+			filters {
+				... FilterData
+			}
+			filterSql
+			dimension {
+				... DimensionData
+			}
+		}
+		... on AverageMetricSettings {
+			__typename
+			filters {
+				... FilterData
+			}
+			filterSql
+			measure {
+				... DimensionData
+			}
+		}
+		... on MinMetricSettings {
+			__typename
+			filters {
+				... FilterData
+			}
+			filterSql
+			measure {
+				... DimensionData
+			}
+		}
+		... on MaxMetricSettings {
+			__typename
+			filters {
+				... FilterData
+			}
+			filterSql
+			measure {
+				... DimensionData
+			}
+		}
+		... on CustomMetricSettings {
+			__typename
+			filters {
+				... FilterData
+			}
+			filterSql
+			expression
+		}
+	}
+}
+fragment CommonData on Common {
+	uniqueName
+	description
+	account {
+		id
+	}
+	environment {
+		id
+	}
+	createdAt
+	modifiedAt
+	createdBy
+	modifiedBy
+}
+fragment DataPoolData on DataPool {
+	id
+	... CommonData
+	dataSource {
+		... DataSourceData
+	}
+	status
+	error {
+		message
+	}
+	table
+	recordCount
+	tenant {
+		... TenantData
+	}
+	timestamp {
+		... TimestampData
+	}
+	columns {
+		nodes {
+			... DataPoolColumnData
+		}
+	}
+	uniqueId {
+		columnName
+	}
+	syncing {
+		... DataPoolSyncingData
+	}
+	availableMeasures {
+		nodes {
+			... DataPoolColumnData
+		}
+	}
+	setupTasks {
+		name
+		description
+		status
+		error {
+			code
+			message
+		}
+		completedAt
+	}
+	dataPoolAccessPolicies {
+		nodes {
+			... DataPoolAccessPolicyData
+		}
+	}
+	accessControlEnabled
+	tableSettings {
+		... TableSettingsData
+	}
+}
+fragment DimensionData on Dimension {
+	columnName
+	type
+	isNullable
+	isUniqueKey
+}
+fragment FilterData on Filter {
+	column
+	operator
+	value
+	and {
+		... NestedFilterData
+	}
+	or {
+		... NestedFilterData
+	}
+}
+fragment DataSourceData on DataSource {
+	id
+	... CommonData
+	type
+	status
+	error {
+		message
+	}
+	dataPools {
+		nodes {
+			id
+			accessControlEnabled
+			timestamp {
+				... TimestampData
+			}
+		}
+	}
+	connectionSettings {
+		__typename
+		... on SnowflakeConnectionSettings {
+			account
+			database
+			warehouse
+			schema
+			username
+			role
+		}
+		... on HttpConnectionSettings {
+			basicAuth {
+				username
+				password
+			}
+			tables {
+				id
+				name
+				columns {
+					name
+					type
+					nullable
+				}
+			}
+		}
+		... on S3ConnectionSettings {
+			bucket
+			awsAccessKeyId
+			tables {
+				id
+				name
+				path
+				columns {
+					name
+					type
+					nullable
+				}
+			}
+		}
+		... on WebhookConnectionSettings {
+			basicAuth {
+				username
+				password
+			}
+			columns {
+				name
+				type
+				jsonProperty
+				nullable
+			}
+			tenant
+			uniqueId
+			tableSettings {
+				... TableSettingsData
+			}
+			webhookUrl
+		}
+		... on KafkaConnectionSettings {
+			auth
+			user
+			password
+			tls
+			bootstrapServers
+		}
+		... on ClickHouseConnectionSettings {
+			url
+			database
+			user
+			password
+			readonly
+		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
+	}
+	tables(first: 100) {
+		nodes {
+			id
+			name
+			columns(first: 100) {
+				nodes {
+					... ColumnData
+				}
+			}
+		}
+	}
+	checks {
+		name
+		description
+		status
+		error {
+			code
+			message
+		}
+		checkedAt
+	}
+	tableIntrospections(first: 100) {
+		nodes {
+			... TableIntrospectionData
+		}
+	}
+}
+fragment TenantData on Tenant {
+	columnName
+	type
+}
+fragment TimestampData on Timestamp {
+	columnName
+	type
+}
+fragment DataPoolColumnData on DataPoolColumn {
+	columnName
+	type
+	clickHouseType
+	isNullable
+}
+fragment DataPoolSyncingData on DataPoolSyncing {
+	status
+	interval
+	lastSyncedAt
+}
+fragment DataPoolAccessPolicyData on DataPoolAccessPolicy {
+	id
+	... CommonData
+	columns
+	rows {
+		... FilterData
+	}
+	filterSql
+	dataPool {
+		id
+	}
+}
+fragment TableSettingsData on TableSettings {
+	engine {
+		__typename
+		... on MergeTreeTableEngine {
+			type
+		}
+		... on ReplacingMergeTreeTableEngine {
+			type
+			ver
+		}
+		... on SummingMergeTreeTableEngine {
+			type
+			columns
+		}
+		... on AggregatingMergeTreeTableEngine {
+			type
+		}
+	}
+	partitionBy
+	primaryKey
+	orderBy
+}
+fragment NestedFilterData on Filter {
+	column
+	operator
+	value
+	and {
+		column
+		operator
+		value
+	}
+	or {
+		column
+		operator
+		value
+	}
+}
+fragment ColumnData on Column {
+	name
+	type
+	isNullable
+}
+fragment TableIntrospectionData on TableIntrospection {
+	dataSource {
+		id
+	}
+	status
+	createdAt
+	createdBy
+	modifiedAt
+	modifiedBy
+	numTables
+}
+`
+
+func MigrateMetric(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input *MigrateMetricInput,
+) (*MigrateMetricResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "MigrateMetric",
+		Query:  MigrateMetric_Operation,
+		Variables: &__MigrateMetricInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ MigrateMetricResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by ModifyApplication.
 const ModifyApplication_Operation = `
 mutation ModifyApplication ($input: modifyApplicationInput!) {
@@ -28393,11 +36320,23 @@ fragment DataPoolAccessPolicyData on DataPoolAccessPolicy {
 	rows {
 		... FilterData
 	}
+	filterSql
 	dataPool {
 		id
 	}
 }
 fragment FilterData on Filter {
+	column
+	operator
+	value
+	and {
+		... NestedFilterData
+	}
+	or {
+		... NestedFilterData
+	}
+}
+fragment NestedFilterData on Filter {
 	column
 	operator
 	value
@@ -28537,6 +36476,13 @@ fragment DataSourceData on DataSource {
 			password
 			readonly
 		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
 	}
 	tables(first: 100) {
 		nodes {
@@ -28677,6 +36623,7 @@ fragment DataPoolData on DataPool {
 		message
 	}
 	table
+	recordCount
 	tenant {
 		... TenantData
 	}
@@ -28825,6 +36772,13 @@ fragment DataSourceData on DataSource {
 			password
 			readonly
 		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
 	}
 	tables(first: 100) {
 		nodes {
@@ -28879,6 +36833,7 @@ fragment DataPoolAccessPolicyData on DataPoolAccessPolicy {
 	rows {
 		... FilterData
 	}
+	filterSql
 	dataPool {
 		id
 	}
@@ -28922,6 +36877,17 @@ fragment TableIntrospectionData on TableIntrospection {
 	numTables
 }
 fragment FilterData on Filter {
+	column
+	operator
+	value
+	and {
+		... NestedFilterData
+	}
+	or {
+		... NestedFilterData
+	}
+}
+fragment NestedFilterData on Filter {
 	column
 	operator
 	value
@@ -28980,6 +36946,7 @@ fragment DataPoolAccessPolicyData on DataPoolAccessPolicy {
 	rows {
 		... FilterData
 	}
+	filterSql
 	dataPool {
 		id
 	}
@@ -28999,6 +36966,17 @@ fragment CommonData on Common {
 	modifiedBy
 }
 fragment FilterData on Filter {
+	column
+	operator
+	value
+	and {
+		... NestedFilterData
+	}
+	or {
+		... NestedFilterData
+	}
+}
+fragment NestedFilterData on Filter {
 	column
 	operator
 	value
@@ -29041,6 +37019,55 @@ func ModifyDataPoolAccessPolicy(
 	return &data_, err_
 }
 
+// The query or mutation executed by ModifyEnvironment.
+const ModifyEnvironment_Operation = `
+mutation ModifyEnvironment ($input: ModifyEnvironmentInput!) {
+	modifyEnvironment(input: $input) {
+		environment {
+			... EnvironmentData
+		}
+	}
+}
+fragment EnvironmentData on Environment {
+	id
+	uniqueName
+	description
+	account {
+		id
+	}
+	createdAt
+	modifiedAt
+	createdBy
+	modifiedBy
+}
+`
+
+func ModifyEnvironment(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input *ModifyEnvironmentInput,
+) (*ModifyEnvironmentResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "ModifyEnvironment",
+		Query:  ModifyEnvironment_Operation,
+		Variables: &__ModifyEnvironmentInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ ModifyEnvironmentResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by ModifyHttpDataSource.
 const ModifyHttpDataSource_Operation = `
 mutation ModifyHttpDataSource ($input: ModifyHttpDataSourceInput!) {
@@ -29138,6 +37165,13 @@ fragment DataSourceData on DataSource {
 			password
 			readonly
 		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
 	}
 	tables(first: 100) {
 		nodes {
@@ -29347,6 +37381,13 @@ fragment DataSourceData on DataSource {
 			password
 			readonly
 		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
 	}
 	tables(first: 100) {
 		nodes {
@@ -29558,12 +37599,14 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 		}
 		... on SumMetricSettings {
 			__typename
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -29573,6 +37616,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			dimension {
 				... DimensionData
 			}
@@ -29582,6 +37626,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -29591,6 +37636,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -29600,6 +37646,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			measure {
 				... DimensionData
 			}
@@ -29609,6 +37656,7 @@ fragment MetricData on Metric {
 			filters {
 				... FilterData
 			}
+			filterSql
 			expression
 		}
 	}
@@ -29638,6 +37686,7 @@ fragment DataPoolData on DataPool {
 		message
 	}
 	table
+	recordCount
 	tenant {
 		... TenantData
 	}
@@ -29691,14 +37740,10 @@ fragment FilterData on Filter {
 	operator
 	value
 	and {
-		column
-		operator
-		value
+		... NestedFilterData
 	}
 	or {
-		column
-		operator
-		value
+		... NestedFilterData
 	}
 }
 fragment DataSourceData on DataSource {
@@ -29789,6 +37834,13 @@ fragment DataSourceData on DataSource {
 			password
 			readonly
 		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
 	}
 	tables(first: 100) {
 		nodes {
@@ -29843,6 +37895,7 @@ fragment DataPoolAccessPolicyData on DataPoolAccessPolicy {
 	rows {
 		... FilterData
 	}
+	filterSql
 	dataPool {
 		id
 	}
@@ -29869,6 +37922,21 @@ fragment TableSettingsData on TableSettings {
 	primaryKey
 	orderBy
 }
+fragment NestedFilterData on Filter {
+	column
+	operator
+	value
+	and {
+		column
+		operator
+		value
+	}
+	or {
+		column
+		operator
+		value
+	}
+}
 fragment ColumnData on Column {
 	name
 	type
@@ -29960,6 +38028,222 @@ func ModifyPolicy(
 	return &data_, err_
 }
 
+// The query or mutation executed by ModifyPostgreSqlDataSource.
+const ModifyPostgreSqlDataSource_Operation = `
+mutation ModifyPostgreSqlDataSource ($input: ModifyPostgreSqlDataSourceInput!) {
+	modifyPostgreSqlDataSource(input: $input) {
+		dataSource {
+			... DataSourceData
+		}
+	}
+}
+fragment DataSourceData on DataSource {
+	id
+	... CommonData
+	type
+	status
+	error {
+		message
+	}
+	dataPools {
+		nodes {
+			id
+			accessControlEnabled
+			timestamp {
+				... TimestampData
+			}
+		}
+	}
+	connectionSettings {
+		__typename
+		... on SnowflakeConnectionSettings {
+			account
+			database
+			warehouse
+			schema
+			username
+			role
+		}
+		... on HttpConnectionSettings {
+			basicAuth {
+				username
+				password
+			}
+			tables {
+				id
+				name
+				columns {
+					name
+					type
+					nullable
+				}
+			}
+		}
+		... on S3ConnectionSettings {
+			bucket
+			awsAccessKeyId
+			tables {
+				id
+				name
+				path
+				columns {
+					name
+					type
+					nullable
+				}
+			}
+		}
+		... on WebhookConnectionSettings {
+			basicAuth {
+				username
+				password
+			}
+			columns {
+				name
+				type
+				jsonProperty
+				nullable
+			}
+			tenant
+			uniqueId
+			tableSettings {
+				... TableSettingsData
+			}
+			webhookUrl
+		}
+		... on KafkaConnectionSettings {
+			auth
+			user
+			password
+			tls
+			bootstrapServers
+		}
+		... on ClickHouseConnectionSettings {
+			url
+			database
+			user
+			password
+			readonly
+		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
+	}
+	tables(first: 100) {
+		nodes {
+			id
+			name
+			columns(first: 100) {
+				nodes {
+					... ColumnData
+				}
+			}
+		}
+	}
+	checks {
+		name
+		description
+		status
+		error {
+			code
+			message
+		}
+		checkedAt
+	}
+	tableIntrospections(first: 100) {
+		nodes {
+			... TableIntrospectionData
+		}
+	}
+}
+fragment CommonData on Common {
+	uniqueName
+	description
+	account {
+		id
+	}
+	environment {
+		id
+	}
+	createdAt
+	modifiedAt
+	createdBy
+	modifiedBy
+}
+fragment TimestampData on Timestamp {
+	columnName
+	type
+}
+fragment TableSettingsData on TableSettings {
+	engine {
+		__typename
+		... on MergeTreeTableEngine {
+			type
+		}
+		... on ReplacingMergeTreeTableEngine {
+			type
+			ver
+		}
+		... on SummingMergeTreeTableEngine {
+			type
+			columns
+		}
+		... on AggregatingMergeTreeTableEngine {
+			type
+		}
+	}
+	partitionBy
+	primaryKey
+	orderBy
+}
+fragment ColumnData on Column {
+	name
+	type
+	isNullable
+}
+fragment TableIntrospectionData on TableIntrospection {
+	dataSource {
+		id
+	}
+	status
+	createdAt
+	createdBy
+	modifiedAt
+	modifiedBy
+	numTables
+}
+`
+
+func ModifyPostgreSqlDataSource(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input *ModifyPostgreSqlDataSourceInput,
+) (*ModifyPostgreSqlDataSourceResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "ModifyPostgreSqlDataSource",
+		Query:  ModifyPostgreSqlDataSource_Operation,
+		Variables: &__ModifyPostgreSqlDataSourceInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ ModifyPostgreSqlDataSourceResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by ModifyS3DataSource.
 const ModifyS3DataSource_Operation = `
 mutation ModifyS3DataSource ($input: ModifyS3DataSourceInput!) {
@@ -30057,6 +38341,237 @@ fragment DataSourceData on DataSource {
 			password
 			readonly
 		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
+	}
+	tables(first: 100) {
+		nodes {
+			id
+			name
+			columns(first: 100) {
+				nodes {
+					... ColumnData
+				}
+			}
+		}
+	}
+	checks {
+		name
+		description
+		status
+		error {
+			code
+			message
+		}
+		checkedAt
+	}
+	tableIntrospections(first: 100) {
+		nodes {
+			... TableIntrospectionData
+		}
+	}
+}
+fragment CommonData on Common {
+	uniqueName
+	description
+	account {
+		id
+	}
+	environment {
+		id
+	}
+	createdAt
+	modifiedAt
+	createdBy
+	modifiedBy
+}
+fragment TimestampData on Timestamp {
+	columnName
+	type
+}
+fragment TableSettingsData on TableSettings {
+	engine {
+		__typename
+		... on MergeTreeTableEngine {
+			type
+		}
+		... on ReplacingMergeTreeTableEngine {
+			type
+			ver
+		}
+		... on SummingMergeTreeTableEngine {
+			type
+			columns
+		}
+		... on AggregatingMergeTreeTableEngine {
+			type
+		}
+	}
+	partitionBy
+	primaryKey
+	orderBy
+}
+fragment ColumnData on Column {
+	name
+	type
+	isNullable
+}
+fragment TableIntrospectionData on TableIntrospection {
+	dataSource {
+		id
+	}
+	status
+	createdAt
+	createdBy
+	modifiedAt
+	modifiedBy
+	numTables
+}
+`
+
+func ModifyS3DataSource(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input *ModifyS3DataSourceInput,
+) (*ModifyS3DataSourceResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "ModifyS3DataSource",
+		Query:  ModifyS3DataSource_Operation,
+		Variables: &__ModifyS3DataSourceInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ ModifyS3DataSourceResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by ModifySnowflakeDataSource.
+const ModifySnowflakeDataSource_Operation = `
+mutation ModifySnowflakeDataSource ($input: modifySnowflakeDataSourceInput!) {
+	modifySnowflakeDataSource(input: $input) {
+		__typename
+		... on DataSourceResponse {
+			dataSource {
+				... DataSourceData
+			}
+		}
+		... on FailureResponse {
+			error {
+				... GqlError
+			}
+		}
+	}
+}
+fragment DataSourceData on DataSource {
+	id
+	... CommonData
+	type
+	status
+	error {
+		message
+	}
+	dataPools {
+		nodes {
+			id
+			accessControlEnabled
+			timestamp {
+				... TimestampData
+			}
+		}
+	}
+	connectionSettings {
+		__typename
+		... on SnowflakeConnectionSettings {
+			account
+			database
+			warehouse
+			schema
+			username
+			role
+		}
+		... on HttpConnectionSettings {
+			basicAuth {
+				username
+				password
+			}
+			tables {
+				id
+				name
+				columns {
+					name
+					type
+					nullable
+				}
+			}
+		}
+		... on S3ConnectionSettings {
+			bucket
+			awsAccessKeyId
+			tables {
+				id
+				name
+				path
+				columns {
+					name
+					type
+					nullable
+				}
+			}
+		}
+		... on WebhookConnectionSettings {
+			basicAuth {
+				username
+				password
+			}
+			columns {
+				name
+				type
+				jsonProperty
+				nullable
+			}
+			tenant
+			uniqueId
+			tableSettings {
+				... TableSettingsData
+			}
+			webhookUrl
+		}
+		... on KafkaConnectionSettings {
+			auth
+			user
+			password
+			tls
+			bootstrapServers
+		}
+		... on ClickHouseConnectionSettings {
+			url
+			database
+			user
+			password
+			readonly
+		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
 	}
 	tables(first: 100) {
 		nodes {
@@ -30085,6 +38600,10 @@ fragment DataSourceData on DataSource {
 		}
 	}
 }
+fragment GqlError on Error {
+	code
+	message
+}
 fragment CommonData on Common {
 	uniqueName
 	description
@@ -30143,21 +38662,21 @@ fragment TableIntrospectionData on TableIntrospection {
 }
 `
 
-func ModifyS3DataSource(
+func ModifySnowflakeDataSource(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	input *ModifyS3DataSourceInput,
-) (*ModifyS3DataSourceResponse, error) {
+	input *ModifySnowflakeDataSourceInput,
+) (*ModifySnowflakeDataSourceResponse, error) {
 	req_ := &graphql.Request{
-		OpName: "ModifyS3DataSource",
-		Query:  ModifyS3DataSource_Operation,
-		Variables: &__ModifyS3DataSourceInput{
+		OpName: "ModifySnowflakeDataSource",
+		Query:  ModifySnowflakeDataSource_Operation,
+		Variables: &__ModifySnowflakeDataSourceInput{
 			Input: input,
 		},
 	}
 	var err_ error
 
-	var data_ ModifyS3DataSourceResponse
+	var data_ ModifySnowflakeDataSourceResponse
 	resp_ := &graphql.Response{Data: &data_}
 
 	err_ = client_.MakeRequest(
@@ -30169,20 +38688,12 @@ func ModifyS3DataSource(
 	return &data_, err_
 }
 
-// The query or mutation executed by ModifySnowflakeDataSource.
-const ModifySnowflakeDataSource_Operation = `
-mutation ModifySnowflakeDataSource ($input: modifySnowflakeDataSourceInput!) {
-	modifySnowflakeDataSource(input: $input) {
-		__typename
-		... on DataSourceResponse {
-			dataSource {
-				... DataSourceData
-			}
-		}
-		... on FailureResponse {
-			error {
-				... GqlError
-			}
+// The query or mutation executed by ModifyWebhookDataSource.
+const ModifyWebhookDataSource_Operation = `
+mutation ModifyWebhookDataSource ($input: ModifyWebhookDataSourceInput!) {
+	modifyWebhookDataSource(input: $input) {
+		dataSource {
+			... DataSourceData
 		}
 	}
 }
@@ -30274,6 +38785,13 @@ fragment DataSourceData on DataSource {
 			password
 			readonly
 		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
 	}
 	tables(first: 100) {
 		nodes {
@@ -30302,10 +38820,6 @@ fragment DataSourceData on DataSource {
 		}
 	}
 }
-fragment GqlError on Error {
-	code
-	message
-}
 fragment CommonData on Common {
 	uniqueName
 	description
@@ -30364,21 +38878,21 @@ fragment TableIntrospectionData on TableIntrospection {
 }
 `
 
-func ModifySnowflakeDataSource(
+func ModifyWebhookDataSource(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	input *ModifySnowflakeDataSourceInput,
-) (*ModifySnowflakeDataSourceResponse, error) {
+	input *ModifyWebhookDataSourceInput,
+) (*ModifyWebhookDataSourceResponse, error) {
 	req_ := &graphql.Request{
-		OpName: "ModifySnowflakeDataSource",
-		Query:  ModifySnowflakeDataSource_Operation,
-		Variables: &__ModifySnowflakeDataSourceInput{
+		OpName: "ModifyWebhookDataSource",
+		Query:  ModifyWebhookDataSource_Operation,
+		Variables: &__ModifyWebhookDataSourceInput{
 			Input: input,
 		},
 	}
 	var err_ error
 
-	var data_ ModifySnowflakeDataSourceResponse
+	var data_ ModifyWebhookDataSourceResponse
 	resp_ := &graphql.Response{Data: &data_}
 
 	err_ = client_.MakeRequest(
@@ -30390,12 +38904,181 @@ func ModifySnowflakeDataSource(
 	return &data_, err_
 }
 
-// The query or mutation executed by ModifyWebhookDataSource.
-const ModifyWebhookDataSource_Operation = `
-mutation ModifyWebhookDataSource ($input: ModifyWebhookDataSourceInput!) {
-	modifyWebhookDataSource(input: $input) {
-		dataSource {
-			... DataSourceData
+// The query or mutation executed by Policy.
+const Policy_Operation = `
+query Policy ($id: ID!) {
+	policy(id: $id) {
+		... PolicyData
+	}
+}
+fragment PolicyData on Policy {
+	id
+	type
+	metric {
+		id
+	}
+	application {
+		id
+	}
+}
+`
+
+func Policy(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (*PolicyResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "Policy",
+		Query:  Policy_Operation,
+		Variables: &__PolicyInput{
+			Id: id,
+		},
+	}
+	var err_ error
+
+	var data_ PolicyResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by ResyncDataPool.
+const ResyncDataPool_Operation = `
+mutation ResyncDataPool ($dataPoolId: ID!) {
+	resyncDataPool(dataPoolId: $dataPoolId) {
+		... SyncData
+	}
+}
+fragment SyncData on Sync {
+	id
+	dataPool {
+		id
+	}
+	status
+	processedRecords
+	size
+	startedAt
+	succeededAt
+	failedAt
+	error {
+		... GqlError
+	}
+	createdAt
+	modifiedAt
+	createdBy
+	modifiedBy
+}
+fragment GqlError on Error {
+	code
+	message
+}
+`
+
+func ResyncDataPool(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	dataPoolId string,
+) (*ResyncDataPoolResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "ResyncDataPool",
+		Query:  ResyncDataPool_Operation,
+		Variables: &__ResyncDataPoolInput{
+			DataPoolId: dataPoolId,
+		},
+	}
+	var err_ error
+
+	var data_ ResyncDataPoolResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by Sync.
+const Sync_Operation = `
+query Sync ($id: ID!) {
+	sync(id: $id) {
+		... SyncData
+	}
+}
+fragment SyncData on Sync {
+	id
+	dataPool {
+		id
+	}
+	status
+	processedRecords
+	size
+	startedAt
+	succeededAt
+	failedAt
+	error {
+		... GqlError
+	}
+	createdAt
+	modifiedAt
+	createdBy
+	modifiedBy
+}
+fragment GqlError on Error {
+	code
+	message
+}
+`
+
+func Sync(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (*SyncResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "Sync",
+		Query:  Sync_Operation,
+		Variables: &__SyncInput{
+			Id: id,
+		},
+	}
+	var err_ error
+
+	var data_ SyncResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by TestDataSource.
+const TestDataSource_Operation = `
+mutation TestDataSource ($id: String!) {
+	testDataSource(input: {id:$id}) {
+		__typename
+		... on DataSourceResponse {
+			dataSource {
+				... DataSourceData
+			}
+		}
+		... on FailureResponse {
+			error {
+				... GqlError
+			}
 		}
 	}
 }
@@ -30487,6 +39170,13 @@ fragment DataSourceData on DataSource {
 			password
 			readonly
 		}
+		... on PostgreSqlConnectionSettings {
+			host
+			port
+			postgreSqlDatabase: database
+			postgreSqlSchema: schema
+			user
+		}
 	}
 	tables(first: 100) {
 		nodes {
@@ -30515,6 +39205,10 @@ fragment DataSourceData on DataSource {
 		}
 	}
 }
+fragment GqlError on Error {
+	code
+	message
+}
 fragment CommonData on Common {
 	uniqueName
 	description
@@ -30573,21 +39267,21 @@ fragment TableIntrospectionData on TableIntrospection {
 }
 `
 
-func ModifyWebhookDataSource(
+func TestDataSource(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	input *ModifyWebhookDataSourceInput,
-) (*ModifyWebhookDataSourceResponse, error) {
+	id string,
+) (*TestDataSourceResponse, error) {
 	req_ := &graphql.Request{
-		OpName: "ModifyWebhookDataSource",
-		Query:  ModifyWebhookDataSource_Operation,
-		Variables: &__ModifyWebhookDataSourceInput{
-			Input: input,
+		OpName: "TestDataSource",
+		Query:  TestDataSource_Operation,
+		Variables: &__TestDataSourceInput{
+			Id: id,
 		},
 	}
 	var err_ error
 
-	var data_ ModifyWebhookDataSourceResponse
+	var data_ TestDataSourceResponse
 	resp_ := &graphql.Response{Data: &data_}
 
 	err_ = client_.MakeRequest(
@@ -30599,40 +39293,88 @@ func ModifyWebhookDataSource(
 	return &data_, err_
 }
 
-// The query or mutation executed by Policy.
-const Policy_Operation = `
-query Policy ($id: ID!) {
-	policy(id: $id) {
-		... PolicyData
+// The query or mutation executed by UnAssignDataPoolAccessPolicy.
+const UnAssignDataPoolAccessPolicy_Operation = `
+mutation UnAssignDataPoolAccessPolicy ($dataPoolAccessPolicy: ID!, $application: ID!) {
+	unAssignDataPoolAccessPolicyFromApplication(dataPoolAccessPolicy: $dataPoolAccessPolicy, application: $application)
+}
+`
+
+func UnAssignDataPoolAccessPolicy(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	dataPoolAccessPolicy string,
+	application string,
+) (*UnAssignDataPoolAccessPolicyResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "UnAssignDataPoolAccessPolicy",
+		Query:  UnAssignDataPoolAccessPolicy_Operation,
+		Variables: &__UnAssignDataPoolAccessPolicyInput{
+			DataPoolAccessPolicy: dataPoolAccessPolicy,
+			Application:          application,
+		},
+	}
+	var err_ error
+
+	var data_ UnAssignDataPoolAccessPolicyResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by UpdateDataPoolRecordsJob.
+const UpdateDataPoolRecordsJob_Operation = `
+query UpdateDataPoolRecordsJob ($id: ID!) {
+	updateDataPoolRecordsJob(id: $id) {
+		... UpdateDataPoolRecordsJobData
 	}
 }
-fragment PolicyData on Policy {
+fragment UpdateDataPoolRecordsJobData on UpdateDataPoolRecordsJob {
 	id
-	type
-	metric {
+	dataPool {
 		id
 	}
-	application {
-		id
+	status
+	filterSql
+	set {
+		column
+		expression
+	}
+	error {
+		... GqlError
 	}
+	progress
+	startedAt
+	succeededAt
+	failedAt
+}
+fragment GqlError on Error {
+	code
+	message
 }
 `
 
-func Policy(
+func UpdateDataPoolRecordsJob(
 	ctx_ context.Context,
 	client_ graphql.Client,
 	id string,
-) (*PolicyResponse, error) {
+) (*UpdateDataPoolRecordsJobResponse, error) {
 	req_ := &graphql.Request{
-		OpName: "Policy",
-		Query:  Policy_Operation,
-		Variables: &__PolicyInput{
+		OpName: "UpdateDataPoolRecordsJob",
+		Query:  UpdateDataPoolRecordsJob_Operation,
+		Variables: &__UpdateDataPoolRecordsJobInput{
 			Id: id,
 		},
 	}
 	var err_ error
 
-	var data_ PolicyResponse
+	var data_ UpdateDataPoolRecordsJobResponse
 	resp_ := &graphql.Response{Data: &data_}
 
 	err_ = client_.MakeRequest(
@@ -30644,30 +39386,36 @@ func Policy(
 	return &data_, err_
 }
 
-// The query or mutation executed by UnAssignDataPoolAccessPolicy.
-const UnAssignDataPoolAccessPolicy_Operation = `
-mutation UnAssignDataPoolAccessPolicy ($dataPoolAccessPolicy: ID!, $application: ID!) {
-	unAssignDataPoolAccessPolicyFromApplication(dataPoolAccessPolicy: $dataPoolAccessPolicy, application: $application)
+// The query or mutation executed by ValidateExpression.
+const ValidateExpression_Operation = `
+query ValidateExpression ($dataPoolId: ID!, $expression: String!) {
+	dataPool(id: $dataPoolId) {
+		id
+		validateExpression(expression: $expression) {
+			valid
+			reason
+		}
+	}
 }
 `
 
-func UnAssignDataPoolAccessPolicy(
+func ValidateExpression(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	dataPoolAccessPolicy string,
-	application string,
-) (*UnAssignDataPoolAccessPolicyResponse, error) {
+	dataPoolId string,
+	expression string,
+) (*ValidateExpressionResponse, error) {
 	req_ := &graphql.Request{
-		OpName: "UnAssignDataPoolAccessPolicy",
-		Query:  UnAssignDataPoolAccessPolicy_Operation,
-		Variables: &__UnAssignDataPoolAccessPolicyInput{
-			DataPoolAccessPolicy: dataPoolAccessPolicy,
-			Application:          application,
+		OpName: "ValidateExpression",
+		Query:  ValidateExpression_Operation,
+		Variables: &__ValidateExpressionInput{
+			DataPoolId: dataPoolId,
+			Expression: expression,
 		},
 	}
 	var err_ error
 
-	var data_ UnAssignDataPoolAccessPolicyResponse
+	var data_ ValidateExpressionResponse
 	resp_ := &graphql.Response{Data: &data_}
 
 	err_ = client_.MakeRequest(
@@ -0,0 +1,221 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	defaultMaxRetries = 4
+	defaultMaxBackoff = 30 * time.Second
+
+	// minBackoff is the delay before the first retry. It doubles with every subsequent retry.
+	minBackoff = 1 * time.Second
+)
+
+// withRetry retries requests that failed with a transient error, waiting with exponential backoff and jitter
+// between attempts. GraphQL queries are retried on any transient failure. Mutations are only retried when the
+// server rejected them before running them, so they are never applied twice.
+type withRetry struct {
+	maxRetries int
+	maxBackoff time.Duration
+	transport  http.RoundTripper
+
+	// sleep is overridden in tests.
+	sleep func(req *http.Request, d time.Duration) error
+}
+
+func newWithRetry(maxRetries int, maxBackoff time.Duration, transport http.RoundTripper) *withRetry {
+	return &withRetry{
+		maxRetries: maxRetries,
+		maxBackoff: maxBackoff,
+		transport:  transport,
+		sleep:      sleepWithContext,
+	}
+}
+
+func (wr *withRetry) RoundTrip(req *http.Request) (*http.Response, error) {
+	if wr.maxRetries <= 0 || (req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) {
+		return wr.transport.RoundTrip(req)
+	}
+
+	idempotent := isGraphQLQuery(req)
+
+	for attempt := 0; ; attempt++ {
+		r := req
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+
+			r = req.Clone(req.Context())
+			r.Body = body
+		}
+
+		resp, err := wr.transport.RoundTrip(r)
+		if attempt >= wr.maxRetries || !shouldRetry(resp, err, idempotent) {
+			return resp, err
+		}
+
+		delay := wr.backoff(attempt)
+		if resp != nil {
+			if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok && retryAfter > delay {
+				delay = min(retryAfter, wr.maxBackoff)
+			}
+
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		if err := wr.sleep(req, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// backoff returns the delay before the given retry attempt: an exponentially growing delay capped at
+// maxBackoff, of which the second half is randomized to spread out concurrent retries.
+func (wr *withRetry) backoff(attempt int) time.Duration {
+	delay := wr.maxBackoff
+	if attempt < 30 && minBackoff<<attempt < wr.maxBackoff {
+		delay = minBackoff << attempt
+	}
+
+	half := delay / 2
+	if half <= 0 {
+		return delay
+	}
+
+	return half + time.Duration(rand.Int63n(int64(half)))
+}
+
+// shouldRetry reports whether a request should be sent again after the given response or error.
+func shouldRetry(resp *http.Response, err error, idempotent bool) bool {
+	if err != nil {
+		if errors.Is(err, errRequestCanceled) {
+			return false
+		}
+
+		// A failed dial means the request never reached the server.
+		var opErr *net.OpError
+		if errors.As(err, &opErr) && opErr.Op == "dial" {
+			return true
+		}
+
+		return idempotent
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		// The request was rejected without being processed.
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout, http.StatusInternalServerError:
+		return idempotent
+	case http.StatusOK:
+		// The API reports rate limiting as a GraphQL error, and the request was not processed either.
+		return isRateLimited(resp)
+	default:
+		return false
+	}
+}
+
+// isRateLimited reports whether the response carries a GraphQL error classified as ErrRateLimited. The body is read
+// and replaced, so it can still be read by the caller.
+func isRateLimited(resp *http.Response) bool {
+	if resp.Body == nil || resp.Body == http.NoBody {
+		return false
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err != nil {
+		return false
+	}
+
+	var payload struct {
+		Errors gqlerror.List `json:"errors"`
+	}
+
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return false
+	}
+
+	for _, gqlErr := range payload.Errors {
+		if errorKinds[errorCode(gqlErr)] == ErrRateLimited {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isGraphQLQuery reports whether the request carries a GraphQL query, as opposed to a mutation.
+func isGraphQLQuery(req *http.Request) bool {
+	if req.Method == http.MethodGet {
+		return true
+	}
+
+	if req.GetBody == nil {
+		return false
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return false
+	}
+	defer body.Close()
+
+	var payload struct {
+		Query string `json:"query"`
+	}
+
+	if err := json.NewDecoder(body).Decode(&payload); err != nil {
+		return false
+	}
+
+	return strings.HasPrefix(strings.TrimSpace(payload.Query), "query")
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either a number of seconds or a date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
+
+var errRequestCanceled = errors.New("request canceled while waiting to retry")
+
+func sleepWithContext(req *http.Request, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-req.Context().Done():
+		return errors.Join(errRequestCanceled, req.Context().Err())
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,146 @@
+package client
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_withRetry(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		statuses      []int
+		responses     []string
+		wantAttempts  int
+		wantStatus    int
+		wantRetryWait time.Duration
+		wantBody      string
+	}{
+		{
+			name:         "query retried after server error",
+			body:         `{"query":"query DataPool($id: ID!) { dataPool(id: $id) { id } }"}`,
+			statuses:     []int{http.StatusBadGateway, http.StatusOK},
+			wantAttempts: 2,
+			wantStatus:   http.StatusOK,
+		},
+		{
+			name:         "mutation not retried after server error",
+			body:         `{"query":"mutation DeleteDataPool($id: ID!) { deleteDataPool(id: $id) }"}`,
+			statuses:     []int{http.StatusBadGateway, http.StatusOK},
+			wantAttempts: 1,
+			wantStatus:   http.StatusBadGateway,
+		},
+		{
+			name:          "mutation retried after being rate limited",
+			body:          `{"query":"mutation DeleteDataPool($id: ID!) { deleteDataPool(id: $id) }"}`,
+			statuses:      []int{http.StatusTooManyRequests, http.StatusOK},
+			wantAttempts:  2,
+			wantStatus:    http.StatusOK,
+			wantRetryWait: 5 * time.Second,
+		},
+		{
+			name:         "mutation retried after a rate limited GraphQL error",
+			body:         `{"query":"mutation DeleteDataPool($id: ID!) { deleteDataPool(id: $id) }"}`,
+			statuses:     []int{http.StatusOK, http.StatusOK},
+			responses:    []string{`{"errors":[{"message":"Too many requests","extensions":{"code":"RATE_LIMITED"}}]}`, `{"data":{}}`},
+			wantAttempts: 2,
+			wantStatus:   http.StatusOK,
+			wantBody:     `{"data":{}}`,
+		},
+		{
+			name:         "other GraphQL errors are not retried",
+			body:         `{"query":"query DataPool($id: ID!) { dataPool(id: $id) { id } }"}`,
+			statuses:     []int{http.StatusOK, http.StatusOK},
+			responses:    []string{`{"errors":[{"message":"Invalid input","extensions":{"code":"BAD_USER_INPUT"}}]}`, `{"data":{}}`},
+			wantAttempts: 1,
+			wantStatus:   http.StatusOK,
+			wantBody:     `{"errors":[{"message":"Invalid input","extensions":{"code":"BAD_USER_INPUT"}}]}`,
+		},
+		{
+			name:         "gives up after max retries",
+			body:         `{"query":"query DataPool($id: ID!) { dataPool(id: $id) { id } }"}`,
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable},
+			wantAttempts: 3,
+			wantStatus:   http.StatusServiceUnavailable,
+		},
+		{
+			name:         "client errors are not retried",
+			body:         `{"query":"query DataPool($id: ID!) { dataPool(id: $id) { id } }"}`,
+			statuses:     []int{http.StatusBadRequest, http.StatusOK},
+			wantAttempts: 1,
+			wantStatus:   http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(st *testing.T) {
+			a := assert.New(st)
+
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				buf := new(bytes.Buffer)
+				_, _ = buf.ReadFrom(r.Body)
+				a.Equal(tt.body, buf.String(), "every attempt should send the full body")
+
+				status := tt.statuses[attempts]
+				attempts++
+
+				if status == http.StatusTooManyRequests {
+					w.Header().Set("Retry-After", "5")
+				}
+				w.WriteHeader(status)
+
+				if tt.responses != nil {
+					_, _ = w.Write([]byte(tt.responses[attempts-1]))
+				}
+			}))
+			defer server.Close()
+
+			var waits []time.Duration
+			wr := newWithRetry(2, 30*time.Second, http.DefaultTransport)
+			wr.sleep = func(_ *http.Request, d time.Duration) error {
+				waits = append(waits, d)
+				return nil
+			}
+
+			req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(tt.body))
+			a.NoError(err)
+
+			resp, err := wr.RoundTrip(req)
+			a.NoError(err)
+			defer resp.Body.Close()
+
+			a.Equal(tt.wantStatus, resp.StatusCode)
+			a.Equal(tt.wantAttempts, attempts)
+			a.Len(waits, tt.wantAttempts-1)
+
+			if tt.wantBody != "" {
+				body := new(bytes.Buffer)
+				_, _ = body.ReadFrom(resp.Body)
+				a.Equal(tt.wantBody, body.String(), "the response body should still be readable")
+			}
+
+			if tt.wantRetryWait != 0 {
+				a.Equal(tt.wantRetryWait, waits[0], "Retry-After should be honored")
+			}
+		})
+	}
+}
+
+func Test_withRetryBackoff(t *testing.T) {
+	a := assert.New(t)
+
+	wr := newWithRetry(10, 8*time.Second, http.DefaultTransport)
+
+	for attempt := 0; attempt < 10; attempt++ {
+		delay := wr.backoff(attempt)
+		a.LessOrEqual(delay, 8*time.Second, "backoff should never exceed the maximum")
+		a.GreaterOrEqual(delay, min(minBackoff<<attempt, 8*time.Second)/2)
+	}
+}
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// tokenRefreshWindow is how long before its expiry an access token gets refreshed. It leaves enough room for
+// requests that are already in flight when the token is handed out.
+const tokenRefreshWindow = 2 * time.Minute
+
+// tokenSource caches the OAuth access token for the client credentials and refreshes it before it expires.
+type tokenSource struct {
+	oauthURL string
+	clientId string
+	secret   string
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+
+	// fetch and now are overridden in tests.
+	fetch func(oauthURL string, clientId string, secret string) (*credentials, error)
+	now   func() time.Time
+}
+
+func newTokenSource(httpClient *http.Client, oauthURL string, clientId string, secret string) *tokenSource {
+	return &tokenSource{
+		oauthURL: oauthURL,
+		clientId: clientId,
+		secret:   secret,
+		fetch: func(oauthURL string, clientId string, secret string) (*credentials, error) {
+			return getToken(httpClient, oauthURL, clientId, secret)
+		},
+		now: time.Now,
+	}
+}
+
+// Token returns a valid access token, fetching a new one if there is none cached or the cached one is about to
+// expire. The stale argument is the token a request was rejected with; if it is still the cached token, a new
+// one is fetched even if it has not expired yet.
+func (ts *tokenSource) Token(stale string) (string, error) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if ts.token != "" && ts.token != stale && (ts.expiry.IsZero() || ts.now().Before(ts.expiry.Add(-tokenRefreshWindow))) {
+		return ts.token, nil
+	}
+
+	creds, err := ts.fetch(ts.oauthURL, ts.clientId, ts.secret)
+	if err != nil {
+		return "", err
+	}
+
+	ts.token = creds.AccessToken
+	ts.expiry = time.Time{}
+
+	if creds.ExpiresIn > 0 {
+		ts.expiry = ts.now().Add(time.Duration(creds.ExpiresIn) * time.Second)
+	}
+
+	return ts.token, nil
+}
+
+// withToken sets the Authorization header of every request from a tokenSource. If the API rejects the token
+// with a 401, the token is refreshed and the request is sent once more.
+type withToken struct {
+	source    *tokenSource
+	transport http.RoundTripper
+}
+
+func (wt *withToken) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := wt.source.Token("")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := wt.transport.RoundTrip(authorizedRequest(req, token))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	// The request body has already been consumed, so it can only be retried if it can be rewound.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return resp, nil
+	}
+
+	token, err = wt.source.Token(token)
+	if err != nil {
+		return resp, nil
+	}
+
+	retry := authorizedRequest(req, token)
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+
+		retry.Body = body
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	return wt.transport.RoundTrip(retry)
+}
+
+func authorizedRequest(req *http.Request, token string) *http.Request {
+	r := req.Clone(req.Context())
+	r.Header.Set("Authorization", "Bearer "+token)
+
+	return r
+}
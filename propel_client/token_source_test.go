@@ -0,0 +1,93 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeTokenIssuer struct {
+	issued    int
+	expiresIn int
+}
+
+func (f *fakeTokenIssuer) fetch(_ string, _ string, _ string) (*credentials, error) {
+	f.issued++
+	return &credentials{AccessToken: fmt.Sprintf("token-%d", f.issued), ExpiresIn: f.expiresIn}, nil
+}
+
+func Test_tokenSourceRefreshesBeforeExpiry(t *testing.T) {
+	a := assert.New(t)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	issuer := &fakeTokenIssuer{expiresIn: 3600}
+
+	ts := newTokenSource(http.DefaultClient, "", "", "")
+	ts.fetch = issuer.fetch
+	ts.now = func() time.Time { return now }
+
+	token, err := ts.Token("")
+	a.NoError(err)
+	a.Equal("token-1", token)
+
+	now = now.Add(50 * time.Minute)
+	token, err = ts.Token("")
+	a.NoError(err)
+	a.Equal("token-1", token, "a token that is not about to expire should be reused")
+
+	now = now.Add(9 * time.Minute)
+	token, err = ts.Token("")
+	a.NoError(err)
+	a.Equal("token-2", token, "a token within the refresh window should be replaced")
+
+	token, err = ts.Token("token-1")
+	a.NoError(err)
+	a.Equal("token-2", token, "a token refreshed by another request should not be refreshed again")
+
+	token, err = ts.Token("token-2")
+	a.NoError(err)
+	a.Equal("token-3", token, "a rejected token should be refreshed")
+}
+
+func Test_withTokenRetriesOnUnauthorized(t *testing.T) {
+	a := assert.New(t)
+
+	issuer := &fakeTokenIssuer{expiresIn: 3600}
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		body, _ := io.ReadAll(r.Body)
+		if r.Header.Get("Authorization") != "Bearer token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	ts := newTokenSource(http.DefaultClient, "", "", "")
+	ts.fetch = issuer.fetch
+
+	client := &http.Client{Transport: &withToken{source: ts, transport: http.DefaultTransport}}
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"query":"{}"}`))
+	a.NoError(err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	a.NoError(err)
+
+	a.Equal(http.StatusOK, resp.StatusCode)
+	a.Equal(`{"query":"{}"}`, string(body), "the request body should be replayed on retry")
+	a.Equal(2, requests)
+	a.Equal(2, issuer.issued)
+}